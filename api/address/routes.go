@@ -5,36 +5,59 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getAccountPath  = "/:address"
-	getBalancePath  = "/:address/balance"
-	getUsernamePath = "/:address/username"
-	getKeyPath      = "/:address/key/:key"
-	getESDTTokens   = "/:address/esdt"
-	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getAccountPath       = "/:address"
+	getBalancePath       = "/:address/balance"
+	getUsernamePath      = "/:address/username"
+	getKeyPath           = "/:address/key/:key"
+	getESDTTokens        = "/:address/esdt"
+	getESDTBalance       = "/:address/esdt/:tokenIdentifier"
+	getESDTNFTs          = "/:address/nft"
+	getESDTNFTsOfToken   = "/:address/nft/:tokenIdentifier"
+	getTransactionsPath  = "/:address/transactions"
+	getKeyValuePairsPath = "/:address/keys"
+	getAccountProofPath  = "/:address/proof"
+	getKeyProofPath      = "/:address/key/:key/proof"
 )
 
+const defaultTransactionsPageSize = 20
+
+const defaultKeyValuePairsPageSize = 100
+
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetUsername(address string) (string, error)
 	GetValueForKey(address string, key string) (string, error)
 	GetAccount(address string) (state.UserAccountHandler, error)
+	GetBalanceWithOptions(address string, options api.AccountQueryOptions) (*big.Int, error)
+	GetValueForKeyWithOptions(address string, key string, options api.AccountQueryOptions) (string, error)
+	GetAccountWithOptions(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
+	GetAllESDTNFTs(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error)
+	GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error)
+	GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error)
+	GetAccountProof(address string, options api.AccountQueryOptions) (*api.TrieProof, error)
+	GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
 	IsInterfaceNil() bool
 }
 
-type accountResponse struct {
+// AccountResponse is the account data returned by the api
+type AccountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
 	Balance  string `json:"balance"`
@@ -44,7 +67,8 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
-type esdtTokenData struct {
+// ESDTTokenData is the esdt token data returned by the api
+type ESDTTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
 	Properties      string `json:"properties"`
@@ -58,6 +82,12 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getESDTNFTs, GetESDTNFTs)
+	router.RegisterHandler(http.MethodGet, getESDTNFTsOfToken, GetESDTNFTs)
+	router.RegisterHandler(http.MethodGet, getTransactionsPath, GetTransactions)
+	router.RegisterHandler(http.MethodGet, getKeyValuePairsPath, GetKeyValuePairs)
+	router.RegisterHandler(http.MethodGet, getAccountProofPath, GetAccountProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetKeyProof)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -90,7 +120,7 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	return facade, true
 }
 
-// GetAccount returns an accountResponse containing information
+// GetAccount returns an AccountResponse containing information
 //  about the account correlated with provided address
 func GetAccount(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -98,8 +128,21 @@ func GetAccount(c *gin.Context) {
 		return
 	}
 
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+		)
+		return
+	}
+
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	var acc state.UserAccountHandler
+	if isEmptyAccountQueryOptions(options) {
+		acc, err = facade.GetAccount(addr)
+	} else {
+		acc, err = facade.GetAccountWithOptions(addr, options)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -116,7 +159,7 @@ func GetAccount(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"account": accountResponseFromBaseAccount(addr, code, acc)},
+			Data:  gin.H{"account": AccountResponseFromBaseAccount(addr, code, acc)},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -143,7 +186,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+		)
+		return
+	}
+
+	var balance *big.Int
+	if isEmptyAccountQueryOptions(options) {
+		balance, err = facade.GetBalance(addr)
+	} else {
+		balance, err = facade.GetBalanceWithOptions(addr, options)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -240,7 +296,20 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+		)
+		return
+	}
+
+	var value string
+	if isEmptyAccountQueryOptions(options) {
+		value, err = facade.GetValueForKey(addr, key)
+	} else {
+		value, err = facade.GetValueForKeyWithOptions(addr, key, options)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -309,7 +378,7 @@ func GetESDTBalance(c *gin.Context) {
 		return
 	}
 
-	tokenData := esdtTokenData{
+	tokenData := ESDTTokenData{
 		TokenIdentifier: tokenIdentifier,
 		Balance:         balance,
 		Properties:      freeze,
@@ -368,8 +437,281 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
-func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) accountResponse {
-	return accountResponse{
+// GetESDTNFTs returns the non fungible and semi fungible token instances held by this account. If the token
+// identifier is provided, only the instances of that token are returned
+func GetESDTNFTs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTs.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	nfts, err := facade.GetAllESDTNFTs(addr, c.Param("tokenIdentifier"))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"nfts": nfts},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetTransactions returns a page of the transactions that touched the given address, most recent first.
+// The page is selected using the optional "from" (number of skipped transactions) and "size" query parameters
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	from, err := getQueryParamUint64(c, "from", 0)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	size, err := getQueryParamUint64(c, "size", defaultTransactionsPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	txs, total, err := facade.GetTransactionsByAddress(addr, from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": txs, "total": total}, "", shared.ReturnCodeSuccess)
+}
+
+// GetKeyValuePairs returns a page of the key-value pairs stored in the data trie of the given address, in trie order.
+// The page is selected using the optional "from" (number of skipped pairs) and "size" query parameters
+func GetKeyValuePairs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	from, err := getQueryParamUint64(c, "from", 0)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	size, err := getQueryParamUint64(c, "size", defaultKeyValuePairsPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	page, err := facade.GetKeyValuePairs(addr, from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"pairs": page.Pairs, "hasMore": page.HasMore}, "", shared.ReturnCodeSuccess)
+}
+
+// GetAccountProof returns the Merkle proof of the account in the accounts trie, computed against the root hash of the
+// current block or of the block selected by the optional "blockNonce" and "blockHash" query parameters
+func GetAccountProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+		)
+		return
+	}
+
+	proof, err := facade.GetAccountProof(addr, options)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proof": proof}, "", shared.ReturnCodeSuccess)
+}
+
+// GetKeyProof returns the Merkle proofs of the account in the accounts trie and of the key in the data trie of the
+// account, computed against the root hash of the current block or of the block selected by the optional "blockNonce"
+// and "blockHash" query parameters
+func GetKeyProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()),
+		)
+		return
+	}
+
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+		)
+		return
+	}
+
+	proof, err := facade.GetAccountKeyProof(addr, key, options)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proof": proof}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamUint64(c *gin.Context, name string, defaultValue uint64) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.ParseUint(valueStr, 10, 64)
+}
+
+// getAccountQueryOptions reads the optional "blockNonce" and "blockHash" query parameters selecting the past state
+// the account will be read from
+func getAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
+	options := api.AccountQueryOptions{}
+
+	query := c.Request.URL.Query()
+	blockNonceStr := query.Get("blockNonce")
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return api.AccountQueryOptions{}, fmt.Errorf("%w: blockNonce", errors.ErrInvalidQueryParameter)
+		}
+		options.BlockNonce = core.OptionalUint64{
+			Value:    blockNonce,
+			HasValue: true,
+		}
+	}
+
+	blockHashStr := query.Get("blockHash")
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil || len(blockHash) == 0 {
+			return api.AccountQueryOptions{}, fmt.Errorf("%w: blockHash", errors.ErrInvalidQueryParameter)
+		}
+		options.BlockHash = blockHash
+	}
+
+	if options.BlockNonce.HasValue && len(options.BlockHash) > 0 {
+		return api.AccountQueryOptions{}, errors.ErrBlockNonceAndHashProvided
+	}
+
+	return options, nil
+}
+
+func isEmptyAccountQueryOptions(options api.AccountQueryOptions) bool {
+	return !options.BlockNonce.HasValue && len(options.BlockHash) == 0
+}
+
+// AccountResponseFromBaseAccount creates the account response for the provided account
+func AccountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) AccountResponse {
+	return AccountResponse{
 		Address:  address,
 		Nonce:    account.GetNonce(),
 		Balance:  account.GetBalance().String(),
@@ -18,7 +18,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +77,38 @@ type esdtTokensResponse struct {
 	Code  string
 }
 
+type esdtNFTsResponseData struct {
+	NFTs []*api.ESDTNFTData `json:"nfts"`
+}
+
+type esdtNFTsResponse struct {
+	Data  esdtNFTsResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+type transactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	Total        uint64                              `json:"total"`
+}
+
+type transactionsResponse struct {
+	Data  transactionsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+type keyValuePairsResponseData struct {
+	Pairs   []*api.AccountKeyValuePair `json:"pairs"`
+	HasMore bool                       `json:"hasMore"`
+}
+
+type keyValuePairsResponse struct {
+	Data  keyValuePairsResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -150,6 +184,57 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	assert.Equal(t, "", response.Error)
 }
 
+func TestGetBalance_WithBlockNonceShouldReadPastState(t *testing.T) {
+	t.Parallel()
+
+	amount := big.NewInt(10)
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (i *big.Int, e error) {
+			assert.Fail(t, "should have not read the current state")
+			return nil, nil
+		},
+		GetBalanceWithOptionsCalled: func(_ string, options api.AccountQueryOptions) (*big.Int, error) {
+			assert.True(t, options.BlockNonce.HasValue)
+			assert.Equal(t, uint64(37), options.BlockNonce.Value)
+			return amount, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/balance?blockNonce=37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, amount.String(), getValueForKey(response.Data, "balance"))
+}
+
+func TestGetBalance_InvalidAccountQueryOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	tests := map[string]string{
+		"invalid block nonce": "blockNonce=abc",
+		"invalid block hash":  "blockHash=zz",
+		"both nonce and hash": "blockNonce=1&blockHash=aabb",
+	}
+	for name, query := range tests {
+		req, _ := http.NewRequest("GET", "/address/testAddress/balance?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, name)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBalance.Error()), name)
+	}
+}
+
 func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
@@ -291,6 +376,30 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	assert.Equal(t, testValue, valueForKeyResponseObj.Data.Value)
 }
 
+func TestGetValueForKey_WithBlockHashShouldReadPastState(t *testing.T) {
+	t.Parallel()
+
+	testValue := "value"
+	facade := mock.Facade{
+		GetValueForKeyWithOptionsCalled: func(_ string, key string, options api.AccountQueryOptions) (string, error) {
+			assert.Equal(t, "test", key)
+			assert.Equal(t, []byte{0xaa, 0xbb}, options.BlockHash)
+			return testValue, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/key/test?blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	valueForKeyResponseObj := valueForKeyResponse{}
+	loadResponse(resp.Body, &valueForKeyResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, testValue, valueForKeyResponseObj.Data.Value)
+}
+
 func TestGetUsername_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -470,6 +579,30 @@ func startNodeServerWrongFacade() *gin.Engine {
 	return ws
 }
 
+func TestGetAccount_WithBlockNonceFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("state not available")
+	facade := mock.Facade{
+		GetAccountWithOptionsCalled: func(_ string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+			assert.Equal(t, uint64(0), options.BlockNonce.Value)
+			assert.True(t, options.BlockNonce.HasValue)
+			return nil, errExpected
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errExpected.Error()))
+}
+
 func TestGetESDTBalance_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -595,6 +728,307 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+func TestGetESDTNFTs_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/some/nft", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetESDTNFTs_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAllESDTNFTsCalled: func(_ string, _ string) ([]*api.ESDTNFTData, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/nft", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTsResponseObj := esdtNFTsResponse{}
+	loadResponse(resp.Body, &esdtNFTsResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(esdtNFTsResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetESDTNFTs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testToken := "token"
+	nft := &api.ESDTNFTData{
+		TokenIdentifier: testToken,
+		Nonce:           1,
+		Balance:         "10",
+		Name:            "name",
+		URIs:            []string{"uri"},
+	}
+	facade := mock.Facade{
+		GetAllESDTNFTsCalled: func(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error) {
+			if tokenIdentifier != "" && tokenIdentifier != testToken {
+				return []*api.ESDTNFTData{}, nil
+			}
+			return []*api.ESDTNFTData{nft}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/nft/%s", testAddress, testToken), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTsResponseObj := esdtNFTsResponse{}
+	loadResponse(resp.Body, &esdtNFTsResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*api.ESDTNFTData{nft}, esdtNFTsResponseObj.Data.NFTs)
+
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/address/%s/nft/%s", testAddress, "other"), nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTsResponseObj = esdtNFTsResponse{}
+	loadResponse(resp.Body, &esdtNFTsResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 0, len(esdtNFTsResponseObj.Data.NFTs))
+}
+
+func TestGetTransactions_InvalidQueryParamShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions?size=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+			return nil, 0, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(5), skip)
+			assert.Equal(t, uint64(2), maxSize)
+
+			return []*transaction.ApiTransactionResult{{Hash: "aa"}, {Hash: "bb"}}, 10, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?from=5&size=2", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(10), response.Data.Total)
+	assert.Equal(t, 2, len(response.Data.Transactions))
+	assert.Equal(t, "bb", response.Data.Transactions[1].Hash)
+}
+
+func TestGetKeyValuePairs_InvalidQueryParamShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?from=-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairs.Error()))
+}
+
+func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("invalid page size")
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, _ uint64, _ uint64) (*api.AccountKeyValuePairsPage, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?size=100000", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errExpected.Error()))
+}
+
+func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(0), skip)
+			assert.Equal(t, uint64(100), maxSize)
+
+			return &api.AccountKeyValuePairsPage{
+				Pairs: []*api.AccountKeyValuePair{
+					{Key: "aa", Value: "bb"},
+					{Key: "cc", Value: "dd", ESDT: &api.ESDTKeyValueData{TokenIdentifier: "TKN-0001", Balance: "10"}},
+				},
+				HasMore: true,
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, response.Data.HasMore)
+	assert.Equal(t, 2, len(response.Data.Pairs))
+	assert.Nil(t, response.Data.Pairs[0].ESDT)
+	assert.Equal(t, "10", response.Data.Pairs[1].ESDT.Balance)
+}
+
+func TestGetAccountProof_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("key not found")
+	facade := mock.Facade{
+		GetAccountProofCalled: func(_ string, _ api.AccountQueryOptions) (*api.TrieProof, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, errExpected.Error()))
+}
+
+func TestGetAccountProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.Facade{
+		GetAccountProofCalled: func(address string, options api.AccountQueryOptions) (*api.TrieProof, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(5), options.BlockNonce.Value)
+			return &api.TrieProof{RootHash: "aa", Proof: []string{"bb", "cc"}}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof?blockNonce=5", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Proof *api.TrieProof `json:"proof"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aa", response.Data.Proof.RootHash)
+	assert.Equal(t, []string{"bb", "cc"}, response.Data.Proof.Proof)
+}
+
+func TestGetKeyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountKeyProofCalled: func(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+			assert.Equal(t, "address", address)
+			assert.Equal(t, "aabb", key)
+			assert.False(t, options.BlockNonce.HasValue)
+			return &api.AccountKeyProof{
+				AccountProof: &api.TrieProof{RootHash: "aa"},
+				KeyProof:     &api.TrieProof{RootHash: "bb"},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/key/aabb/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Proof *api.AccountKeyProof `json:"proof"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aa", response.Data.Proof.AccountProof.RootHash)
+	assert.Equal(t, "bb", response.Data.Proof.KeyProof.RootHash)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,6 +1040,12 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/nft", Open: true},
+					{Name: "/:address/nft/:tokenIdentifier", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/keys", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 				},
 			},
 		},
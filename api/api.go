@@ -7,8 +7,11 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/batch"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
@@ -149,6 +152,30 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	hyperblockRoutes := ws.Group("/hyperblock")
+	wrappedHyperblockRouter, err := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, routesConfig)
+	if err == nil {
+		hyperblock.Routes(wrappedHyperblockRouter)
+	}
+
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
+	poolRoutes := ws.Group("/pool")
+	wrappedPoolRouter, err := wrapper.NewRouterWrapper("pool", poolRoutes, routesConfig)
+	if err == nil {
+		transaction.PoolRoutes(wrappedPoolRouter)
+	}
+
+	batchRoutes := ws.Group("/batch")
+	wrappedBatchRouter, err := wrapper.NewRouterWrapper("batch", batchRoutes, routesConfig)
+	if err == nil {
+		batch.Routes(wrappedBatchRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -0,0 +1,359 @@
+package batch
+
+import (
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	batchPath = "/query"
+
+	// MaxRequestsInBatch is the maximum number of requests accepted in a single batch
+	MaxRequestsInBatch = 100
+
+	getTransactionEndpoint = "/transaction/:hash"
+
+	nullParams = "null"
+)
+
+const (
+	// MethodGetBalance is the batch method mapped on the GetBalance facade method
+	MethodGetBalance = "getBalance"
+	// MethodGetAccount is the batch method mapped on the GetAccount facade method
+	MethodGetAccount = "getAccount"
+	// MethodGetESDTBalance is the batch method mapped on the GetESDTBalance facade method
+	MethodGetESDTBalance = "getESDTBalance"
+	// MethodExecuteSCQuery is the batch method mapped on the ExecuteSCQuery facade method
+	MethodExecuteSCQuery = "executeSCQuery"
+	// MethodGetTransaction is the batch method mapped on the GetTransaction facade method
+	MethodGetTransaction = "getTransaction"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetBalance(address string) (*big.Int, error)
+	GetAccount(address string) (state.UserAccountHandler, error)
+	GetCode(account state.UserAccountHandler) []byte
+	GetESDTBalance(address string, key string) (string, string, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// Request represents a single typed request of a batch
+type Request struct {
+	ID     string          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// Response represents the result of a single request of a batch
+type Response struct {
+	ID     string            `json:"id"`
+	Result interface{}       `json:"result"`
+	Error  string            `json:"error"`
+	Code   shared.ReturnCode `json:"code"`
+}
+
+// AddressParams represents the params of the requests targeting an address
+type AddressParams struct {
+	Address string `json:"address"`
+}
+
+// ESDTBalanceParams represents the params of a getESDTBalance request
+type ESDTBalanceParams struct {
+	Address         string `json:"address"`
+	TokenIdentifier string `json:"tokenIdentifier"`
+}
+
+// TransactionParams represents the params of a getTransaction request
+type TransactionParams struct {
+	Hash        string `json:"hash"`
+	WithResults bool   `json:"withResults"`
+}
+
+type requestHandler func(facade FacadeHandler, params json.RawMessage) (interface{}, error)
+
+var requestHandlers = map[string]requestHandler{
+	MethodGetBalance:     getBalance,
+	MethodGetAccount:     getAccount,
+	MethodGetESDTBalance: getESDTBalance,
+	MethodExecuteSCQuery: executeSCQuery,
+	MethodGetTransaction: getTransaction,
+}
+
+// Routes defines batch related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodPost, batchPath, executeBatch)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// executeBatch executes all the requests of a batch and returns the results and errors of each request. Each request
+// of the batch is accounted against the middleware limiters as a separate request
+func executeBatch(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var requests []Request
+	err := c.ShouldBindJSON(&requests)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+	if len(requests) == 0 {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyBatch.Error()))
+		return
+	}
+	if len(requests) > MaxRequestsInBatch {
+		shared.RespondWithValidationError(
+			c,
+			fmt.Sprintf("%s: %s, maximum is %d", errors.ErrValidation.Error(), errors.ErrBatchTooLarge.Error(), MaxRequestsInBatch),
+		)
+		return
+	}
+
+	// the http request itself was already accounted by the middleware limiters
+	err = middleware.AccountAdditionalRequests(c, uint32(len(requests)-1))
+	if err != nil {
+		shared.RespondWith(c, http.StatusTooManyRequests, nil, err.Error(), shared.ReturnCodeSystemBusy)
+		return
+	}
+
+	responses := make([]*Response, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, executeRequest(facade, request))
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"responses": responses}, "", shared.ReturnCodeSuccess)
+}
+
+func executeRequest(facade FacadeHandler, request Request) *Response {
+	response := &Response{
+		ID:   request.ID,
+		Code: shared.ReturnCodeSuccess,
+	}
+
+	handler, ok := requestHandlers[request.Method]
+	if !ok {
+		response.Error = fmt.Sprintf("%s: %s", errors.ErrUnknownBatchMethod.Error(), request.Method)
+		response.Code = shared.ReturnCodeRequestError
+		return response
+	}
+
+	result, err := handler(facade, request.Params)
+	if err != nil {
+		response.Error = err.Error()
+		response.Code = shared.ReturnCodeInternalError
+		if isRequestError(err) {
+			response.Code = shared.ReturnCodeRequestError
+		}
+		if isThrottlingError(err) {
+			response.Code = shared.ReturnCodeSystemBusy
+		}
+		return response
+	}
+
+	response.Result = result
+
+	return response
+}
+
+func getBalance(facade FacadeHandler, params json.RawMessage) (interface{}, error) {
+	addressParams := AddressParams{}
+	err := unmarshalParams(params, &addressParams)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrGetBalance, err)
+	}
+	if len(addressParams.Address) == 0 {
+		return nil, wrapRequestError(errors.ErrGetBalance, errors.ErrEmptyAddress)
+	}
+
+	balance, err := facade.GetBalance(addressParams.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrGetBalance.Error(), err)
+	}
+
+	return gin.H{"balance": balance.String()}, nil
+}
+
+func getAccount(facade FacadeHandler, params json.RawMessage) (interface{}, error) {
+	addressParams := AddressParams{}
+	err := unmarshalParams(params, &addressParams)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrCouldNotGetAccount, err)
+	}
+	if len(addressParams.Address) == 0 {
+		return nil, wrapRequestError(errors.ErrCouldNotGetAccount, errors.ErrEmptyAddress)
+	}
+
+	account, err := facade.GetAccount(addressParams.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrCouldNotGetAccount.Error(), err)
+	}
+
+	code := facade.GetCode(account)
+
+	return gin.H{"account": address.AccountResponseFromBaseAccount(addressParams.Address, code, account)}, nil
+}
+
+func getESDTBalance(facade FacadeHandler, params json.RawMessage) (interface{}, error) {
+	esdtParams := ESDTBalanceParams{}
+	err := unmarshalParams(params, &esdtParams)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrGetESDTBalance, err)
+	}
+	if len(esdtParams.Address) == 0 {
+		return nil, wrapRequestError(errors.ErrGetESDTBalance, errors.ErrEmptyAddress)
+	}
+	if len(esdtParams.TokenIdentifier) == 0 {
+		return nil, wrapRequestError(errors.ErrGetESDTBalance, errors.ErrEmptyKey)
+	}
+
+	balance, properties, err := facade.GetESDTBalance(esdtParams.Address, esdtParams.TokenIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrGetESDTBalance.Error(), err)
+	}
+
+	tokenData := address.ESDTTokenData{
+		TokenIdentifier: esdtParams.TokenIdentifier,
+		Balance:         balance,
+		Properties:      properties,
+	}
+
+	return gin.H{"tokenData": tokenData}, nil
+}
+
+func executeSCQuery(facade FacadeHandler, params json.RawMessage) (interface{}, error) {
+	vmValueRequest := vmValues.VMValueRequest{}
+	err := unmarshalParams(params, &vmValueRequest)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrQueryError, err)
+	}
+
+	scQuery, err := vmValues.CreateSCQuery(facade, &vmValueRequest)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrQueryError, err)
+	}
+
+	vmOutput, err := facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrQueryError.Error(), err)
+	}
+
+	return gin.H{"data": vmOutput}, nil
+}
+
+func getTransaction(facade FacadeHandler, params json.RawMessage) (interface{}, error) {
+	txParams := TransactionParams{}
+	err := unmarshalParams(params, &txParams)
+	if err != nil {
+		return nil, wrapRequestError(errors.ErrGetTransaction, err)
+	}
+	if len(txParams.Hash) == 0 {
+		return nil, wrapRequestError(errors.ErrGetTransaction, errors.ErrValidationEmptyTxHash)
+	}
+
+	endpointThrottler, ok := facade.GetThrottlerForEndpoint(getTransactionEndpoint)
+	if ok {
+		if !endpointThrottler.CanProcess() {
+			return nil, fmt.Errorf("%w for endpoint %s", errors.ErrTooManyRequests, getTransactionEndpoint)
+		}
+
+		endpointThrottler.StartProcessing()
+		defer endpointThrottler.EndProcessing()
+	}
+
+	tx, err := facade.GetTransaction(txParams.Hash, txParams.WithResults)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrGetTransaction.Error(), err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func unmarshalParams(params json.RawMessage, destination interface{}) error {
+	if len(params) == 0 || string(params) == nullParams {
+		return errors.ErrInvalidBatchParams
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidBatchParams, err.Error())
+	}
+
+	return nil
+}
+
+// requestError is an error caused by the provided request, not by the facade processing
+type requestError struct {
+	message string
+}
+
+// Error returns the error message
+func (re *requestError) Error() string {
+	return re.message
+}
+
+func wrapRequestError(scope error, err error) error {
+	return &requestError{
+		message: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+	}
+}
+
+func isRequestError(err error) bool {
+	_, ok := err.(*requestError)
+	return ok
+}
+
+func isThrottlingError(err error) bool {
+	return stdErrors.Is(err, errors.ErrTooManyRequests)
+}
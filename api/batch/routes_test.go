@@ -0,0 +1,300 @@
+package batch_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/batch"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchResponseData struct {
+	Responses []batch.Response `json:"responses"`
+}
+
+type batchResponse struct {
+	Data  batchResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+func TestExecuteBatch_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil, 0)
+
+	resp := doBatchRequest(ws, "[]")
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestExecuteBatch_InvalidJsonShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{}, 0)
+
+	resp := doBatchRequest(ws, "invalid")
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestExecuteBatch_EmptyBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{}, 0)
+
+	resp := doBatchRequest(ws, "[]")
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyBatch.Error()))
+}
+
+func TestExecuteBatch_TooManyRequestsInBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{}, 0)
+
+	requests := make([]batch.Request, batch.MaxRequestsInBatch+1)
+	resp := doBatchRequest(ws, marshalRequests(requests))
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrBatchTooLarge.Error()))
+}
+
+func TestExecuteBatch_ShouldReturnResultsAndErrorsPerRequest(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		BalanceHandler: func(address string) (*big.Int, error) {
+			if address == "bad" {
+				return nil, expectedErr
+			}
+
+			return big.NewInt(37), nil
+		},
+		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			_ = acc.AddToBalance(big.NewInt(100))
+			acc.IncreaseNonce(1)
+
+			return acc, nil
+		},
+		GetESDTBalanceCalled: func(address string, key string) (string, string, error) {
+			return "10", "properties", nil
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+			return &vm.VMOutputApi{ReturnData: [][]byte{query.Arguments[0]}}, nil
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{Hash: hash}, nil
+		},
+	}
+	ws := startNodeServer(facade, 0)
+
+	requests := []batch.Request{
+		{ID: "1", Method: batch.MethodGetBalance, Params: marshalParams(batch.AddressParams{Address: "erd1"})},
+		{ID: "2", Method: batch.MethodGetBalance, Params: marshalParams(batch.AddressParams{Address: "bad"})},
+		{ID: "3", Method: batch.MethodGetAccount, Params: marshalParams(batch.AddressParams{Address: "erd1"})},
+		{ID: "4", Method: batch.MethodGetESDTBalance, Params: marshalParams(batch.ESDTBalanceParams{Address: "erd1", TokenIdentifier: "TKN"})},
+		{ID: "5", Method: batch.MethodExecuteSCQuery, Params: []byte(`{"scAddress":"aaaa","funcName":"get","args":["0102"]}`)},
+		{ID: "6", Method: batch.MethodGetTransaction, Params: marshalParams(batch.TransactionParams{Hash: "abcd"})},
+		{ID: "7", Method: "unknown"},
+		{ID: "8", Method: batch.MethodGetTransaction},
+	}
+	resp := doBatchRequest(ws, marshalRequests(requests))
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	responses := response.Data.Responses
+	require.Equal(t, len(requests), len(responses))
+	for i := range requests {
+		assert.Equal(t, requests[i].ID, responses[i].ID)
+	}
+
+	assert.Equal(t, shared.ReturnCodeSuccess, responses[0].Code)
+	assert.Equal(t, map[string]interface{}{"balance": "37"}, responses[0].Result)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, responses[1].Code)
+	assert.True(t, strings.Contains(responses[1].Error, expectedErr.Error()))
+	assert.Nil(t, responses[1].Result)
+
+	account := responses[2].Result.(map[string]interface{})["account"].(map[string]interface{})
+	assert.Equal(t, "100", account["balance"])
+	assert.Equal(t, float64(1), account["nonce"])
+
+	tokenData := responses[3].Result.(map[string]interface{})["tokenData"].(map[string]interface{})
+	assert.Equal(t, "10", tokenData["balance"])
+	assert.Equal(t, "TKN", tokenData["tokenIdentifier"])
+
+	assert.Equal(t, shared.ReturnCodeSuccess, responses[4].Code)
+	assert.Empty(t, responses[4].Error)
+
+	tx := responses[5].Result.(map[string]interface{})["transaction"].(map[string]interface{})
+	assert.Equal(t, "abcd", tx["hash"])
+
+	assert.Equal(t, shared.ReturnCodeRequestError, responses[6].Code)
+	assert.True(t, strings.Contains(responses[6].Error, apiErrors.ErrUnknownBatchMethod.Error()))
+
+	assert.Equal(t, shared.ReturnCodeRequestError, responses[7].Code)
+	assert.True(t, strings.Contains(responses[7].Error, apiErrors.ErrInvalidBatchParams.Error()))
+}
+
+func TestExecuteBatch_EndpointThrottlerShouldApplyPerRequest(t *testing.T) {
+	t.Parallel()
+
+	numStarted := 0
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return numStarted < 1
+		},
+		StartProcessingCalled: func() {
+			numStarted++
+		},
+	}
+	facade := &mock.Facade{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			return throttler, endpoint == "/transaction/:hash"
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{Hash: hash}, nil
+		},
+	}
+	ws := startNodeServer(facade, 0)
+
+	requests := []batch.Request{
+		{ID: "1", Method: batch.MethodGetTransaction, Params: marshalParams(batch.TransactionParams{Hash: "aa"})},
+		{ID: "2", Method: batch.MethodGetTransaction, Params: marshalParams(batch.TransactionParams{Hash: "bb"})},
+	}
+	resp := doBatchRequest(ws, marshalRequests(requests))
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Data.Responses[0].Code)
+	assert.Equal(t, shared.ReturnCodeSystemBusy, response.Data.Responses[1].Code)
+	assert.True(t, strings.Contains(response.Data.Responses[1].Error, apiErrors.ErrTooManyRequests.Error()))
+}
+
+func TestExecuteBatch_ShouldAccountEachRequestOnSourceThrottler(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		BalanceHandler: func(address string) (*big.Int, error) {
+			return big.NewInt(1), nil
+		},
+	}
+	maxSourceRequests := uint32(3)
+	ws := startNodeServer(facade, maxSourceRequests)
+
+	request := batch.Request{Method: batch.MethodGetBalance, Params: marshalParams(batch.AddressParams{Address: "erd1"})}
+	resp := doBatchRequest(ws, marshalRequests([]batch.Request{request, request, request}))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doBatchRequest(ws, marshalRequests([]batch.Request{request}))
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestExecuteBatch_BatchOverSourceQuotaShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		BalanceHandler: func(address string) (*big.Int, error) {
+			return big.NewInt(1), nil
+		},
+	}
+	maxSourceRequests := uint32(2)
+	ws := startNodeServer(facade, maxSourceRequests)
+
+	request := batch.Request{Method: batch.MethodGetBalance, Params: marshalParams(batch.AddressParams{Address: "erd1"})}
+	resp := doBatchRequest(ws, marshalRequests([]batch.Request{request, request, request}))
+
+	response := batchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.True(t, strings.Contains(response.Error, middleware.ErrTooManyRequests.Error()))
+}
+
+func doBatchRequest(ws *gin.Engine, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", "/batch/query", bytes.NewBuffer([]byte(body)))
+	req.RemoteAddr = "127.0.0.1:8080"
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func marshalParams(params interface{}) json.RawMessage {
+	buff, _ := json.Marshal(params)
+	return buff
+}
+
+func marshalRequests(requests []batch.Request) string {
+	buff, _ := json.Marshal(requests)
+	return string(buff)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler batch.FacadeHandler, maxSourceRequests uint32) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	if maxSourceRequests > 0 {
+		sourceThrottler, _ := middleware.NewSourceThrottler(maxSourceRequests)
+		ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	}
+	batchRoutes := ws.Group("/batch")
+	if handler != nil {
+		batchRoutes.Use(middleware.WithFacade(handler))
+	}
+	batchRoute, _ := wrapper.NewRouterWrapper("batch", batchRoutes, getRoutesConfig())
+	batch.Routes(batchRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"batch": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
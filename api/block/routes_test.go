@@ -272,12 +272,87 @@ func getRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
+					{Name: "/by-round/:round", Open: true},
 				},
 			},
 		},
 	}
 }
 
+// ---- by round
+
+func TestGetBlockByRound_InvalidRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-round/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockRound.Error()))
+}
+
+func TestGetBlockByRound_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetBlockByRoundCalled: func(_ uint64, _ bool) (*api.Block, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-round/39", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByRound_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := api.Block{
+		Nonce: 37,
+		Round: 39,
+	}
+	providedRound := uint64(0)
+	providedWithTxs := false
+	facade := mock.Facade{
+		GetBlockByRoundCalled: func(round uint64, withTxs bool) (*api.Block, error) {
+			providedRound = round
+			providedWithTxs = withTxs
+			return &expectedBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-round/39?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	assert.Equal(t, expectedBlock, response.Data.Block)
+	assert.Equal(t, uint64(39), providedRound)
+	assert.True(t, providedWithTxs)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
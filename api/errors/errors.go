@@ -31,6 +31,18 @@ var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
+// ErrGetESDTNFTs signals an error in getting the esdt NFTs held by a given address
+var ErrGetESDTNFTs = errors.New("get esdt NFTs for account error")
+
+// ErrGetTransactionsByAddress signals an error in getting the transactions of a given address
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
+// ErrGetKeyValuePairs signals an error in getting the key-value pairs of a given address
+var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
+
+// ErrGetProof signals an error in getting the Merkle proof of an account or of a key
+var ErrGetProof = errors.New("get proof error")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -49,6 +61,9 @@ var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 // ErrInvalidBlockNonce signals an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
+// ErrInvalidBlockRound signals an invalid block round was provided
+var ErrInvalidBlockRound = errors.New("invalid block round")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
@@ -61,6 +76,9 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetHyperblock signals an error happening when trying to fetch a hyperblock
+var ErrGetHyperblock = errors.New("getting hyperblock failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -69,3 +87,33 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrSubscribeToEvents signals an error happening when trying to subscribe to the committed blocks events
+var ErrSubscribeToEvents = errors.New("subscribing to events failed")
+
+// ErrEmptyBatch signals that a batch without any request was provided
+var ErrEmptyBatch = errors.New("empty batch")
+
+// ErrBatchTooLarge signals that a batch containing too many requests was provided
+var ErrBatchTooLarge = errors.New("too many requests in batch")
+
+// ErrUnknownBatchMethod signals that a batch request with an unknown method was provided
+var ErrUnknownBatchMethod = errors.New("unknown batch method")
+
+// ErrInvalidBatchParams signals that a batch request with invalid params was provided
+var ErrInvalidBatchParams = errors.New("invalid batch request params")
+
+// ErrBlockNonceAndHashProvided signals that both the block nonce and the block hash were provided for selecting a past state
+var ErrBlockNonceAndHashProvided = errors.New("only one of blockNonce and blockHash can be provided")
+
+// ErrGetStorageUsage signals an error happening when trying to compute the storage usage
+var ErrGetStorageUsage = errors.New("getting storage usage failed")
+
+// ErrCompactStorageUnit signals an error happening when trying to start the compaction of a storage unit
+var ErrCompactStorageUnit = errors.New("compacting storage unit failed")
+
+// ErrGetTransactionsPool signals an error happening when trying to inspect the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
+// ErrGetGasPriceSuggestion signals an error happening when trying to compute the gas price suggestion
+var ErrGetGasPriceSuggestion = errors.New("getting gas price suggestion failed")
@@ -0,0 +1,12 @@
+package events
+
+import "errors"
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrNilSubscription signals that a nil subscription has been provided
+var ErrNilSubscription = errors.New("nil subscription")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"encoding/json"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
+	"github.com/gorilla/websocket"
+)
+
+const disconnectMessage = -1
+
+type eventsSender struct {
+	conn         wsConn
+	subscription *eventsNotifier.Subscription
+	log          logger.Logger
+	chanStop     chan struct{}
+}
+
+// NewEventsSender returns a new component that writes the events received on the subscription to the
+// web socket connection, as JSON text messages
+func NewEventsSender(conn wsConn, subscription *eventsNotifier.Subscription, log logger.Logger) (*eventsSender, error) {
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if subscription == nil {
+		return nil, ErrNilSubscription
+	}
+	if check.IfNil(log) {
+		return nil, ErrNilLogger
+	}
+
+	return &eventsSender{
+		conn:         conn,
+		subscription: subscription,
+		log:          log,
+		chanStop:     make(chan struct{}),
+	}, nil
+}
+
+// StartSendingBlocking will send the subscription events until either the subscription is closed or
+// the connection ends
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.conn.Close()
+	}()
+
+	go es.monitorConnection()
+	es.doSendContinuously()
+}
+
+func (es *eventsSender) monitorConnection() {
+	defer close(es.chanStop)
+
+	for {
+		mt, _, err := es.conn.ReadMessage()
+		if mt == websocket.CloseMessage || mt == disconnectMessage {
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) doSendContinuously() {
+	for {
+		select {
+		case <-es.chanStop:
+			return
+		case event, ok := <-es.subscription.Events():
+			if !ok {
+				es.log.Debug("events subscription closed", "id", es.subscription.ID())
+				return
+			}
+
+			shouldStop := es.sendEvent(event)
+			if shouldStop {
+				return
+			}
+		}
+	}
+}
+
+func (es *eventsSender) sendEvent(event *eventsNotifier.Event) (shouldStop bool) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		es.log.Error("cannot marshal event", "error", err.Error())
+		return false
+	}
+
+	err = es.conn.WriteMessage(websocket.TextMessage, data)
+	if err != nil {
+		isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+		if !isConnectionClosed {
+			es.log.Error("web socket error", "error", err.Error())
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package events_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type notifierHandler interface {
+	NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler)
+	RegisterHandler(handler eventsNotifier.CommittedBlockHandler) error
+	Unsubscribe(subscription *eventsNotifier.Subscription)
+}
+
+// committedBlockHandlerStub signals when a committed block was dispatched, since the handlers are notified after
+// the subscribers
+type committedBlockHandlerStub struct {
+	chDispatched chan struct{}
+}
+
+func (stub *committedBlockHandlerStub) NotifyCommittedBlock(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+	stub.chDispatched <- struct{}{}
+}
+
+func (stub *committedBlockHandlerStub) NotifyRevertedBlock(_ []byte, _ data.HeaderHandler) {
+}
+
+func (stub *committedBlockHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createNotifierAndSubscription(t *testing.T) (notifierHandler, *eventsNotifier.Subscription) {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	notifier, err := eventsNotifier.NewEventsNotifier(eventsNotifier.ArgsEventsNotifier{
+		PubkeyConverter:        converter,
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		TxLogsStorer:           genericmocks.NewStorerMock("TxLogs", 0),
+		SubscriptionBufferSize: 10,
+		NotificationsQueueSize: 10,
+	})
+	require.Nil(t, err)
+
+	subscription, err := notifier.Subscribe(eventsNotifier.SubscriptionFilter{Blocks: true})
+	require.Nil(t, err)
+
+	return notifier, subscription
+}
+
+func createConnStub(readMessageType int) *mock.WsConnStub {
+	conn := &mock.WsConnStub{}
+	conn.SetCloseHandler(func() error {
+		return nil
+	})
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		time.Sleep(time.Millisecond)
+		return readMessageType, nil, nil
+	})
+
+	return conn
+}
+
+func TestNewEventsSender_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, subscription := createNotifierAndSubscription(t)
+
+	es, err := events.NewEventsSender(nil, subscription, &mock.LoggerStub{})
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilWsConn, err)
+
+	es, err = events.NewEventsSender(&mock.WsConnStub{}, nil, &mock.LoggerStub{})
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilSubscription, err)
+
+	es, err = events.NewEventsSender(&mock.WsConnStub{}, subscription, nil)
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilLogger, err)
+}
+
+func TestEventsSender_StartSendingBlockingShouldWriteEventsUntilUnsubscribed(t *testing.T) {
+	t.Parallel()
+
+	notifier, subscription := createNotifierAndSubscription(t)
+	conn := createConnStub(websocket.TextMessage)
+	mutMessages := sync.Mutex{}
+	messages := make([]string, 0)
+	conn.SetWriteMessageHandler(func(messageType int, data []byte) error {
+		mutMessages.Lock()
+		messages = append(messages, string(data))
+		mutMessages.Unlock()
+
+		return nil
+	})
+
+	handler := &committedBlockHandlerStub{chDispatched: make(chan struct{}, 1)}
+	_ = notifier.RegisterHandler(handler)
+
+	es, _ := events.NewEventsSender(conn, subscription, &mock.LoggerStub{})
+	notifier.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 37}, nil)
+	<-handler.chDispatched
+	notifier.Unsubscribe(subscription)
+
+	es.StartSendingBlocking()
+
+	mutMessages.Lock()
+	defer mutMessages.Unlock()
+	require.Equal(t, 1, len(messages))
+	assert.Contains(t, messages[0], `"type":"block"`)
+	assert.Contains(t, messages[0], `"nonce":37`)
+}
+
+func TestEventsSender_StartSendingBlockingShouldStopWhenConnectionCloses(t *testing.T) {
+	t.Parallel()
+
+	_, subscription := createNotifierAndSubscription(t)
+	conn := createConnStub(websocket.CloseMessage)
+
+	es, _ := events.NewEventsSender(conn, subscription, &mock.LoggerStub{})
+
+	chDone := make(chan struct{})
+	go func() {
+		es.StartSendingBlocking()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "sender should have stopped")
+	}
+}
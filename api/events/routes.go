@@ -0,0 +1,178 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	subscribePath     = "/subscribe"
+	subscribeEndpoint = "/events/subscribe"
+	listSeparator     = ","
+)
+
+var log = logger.GetOrCreate("api/events")
+
+// EventsService interface defines methods that can be used from `elrondFacade` context variable
+type EventsService interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	SubscribeToEvents(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error)
+	UnsubscribeFromEvents(subscription *eventsNotifier.Subscription)
+}
+
+// Routes defines events related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(
+		http.MethodGet,
+		subscribePath,
+		middleware.CreateEndpointThrottler(subscribeEndpoint),
+		Subscribe,
+	)
+}
+
+// Subscribe upgrades the connection to a web socket one and streams the committed blocks events selected
+// by the query parameters: blocks, transactions, logs, addresses and identifiers
+func Subscribe(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	filter, err := createSubscriptionFilter(c, facade)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	subscription, err := facade.SubscribeToEvents(filter)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrSubscribeToEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+	defer facade.UnsubscribeFromEvents(subscription)
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("cannot upgrade events subscription connection", "error", err.Error())
+		return
+	}
+
+	sender, err := NewEventsSender(conn, subscription, log)
+	if err != nil {
+		log.Error(err.Error())
+		_ = conn.Close()
+		return
+	}
+
+	sender.StartSendingBlocking()
+}
+
+func createSubscriptionFilter(c *gin.Context, facade EventsService) (eventsNotifier.SubscriptionFilter, error) {
+	filter := eventsNotifier.SubscriptionFilter{}
+
+	var err error
+	filter.Blocks, err = getQueryParamBool(c, "blocks")
+	if err != nil {
+		return filter, err
+	}
+	filter.Transactions, err = getQueryParamBool(c, "transactions")
+	if err != nil {
+		return filter, err
+	}
+	filter.Logs, err = getQueryParamBool(c, "logs")
+	if err != nil {
+		return filter, err
+	}
+
+	for _, address := range getQueryParamList(c, "addresses") {
+		decodedAddress, errDecode := facade.DecodeAddressPubkey(address)
+		if errDecode != nil {
+			return filter, fmt.Errorf("%w for address %s", errDecode, address)
+		}
+
+		filter.Addresses = append(filter.Addresses, decodedAddress)
+	}
+
+	for _, identifier := range getQueryParamList(c, "identifiers") {
+		filter.LogIdentifiers = append(filter.LogIdentifiers, []byte(identifier))
+	}
+
+	return filter, nil
+}
+
+func getQueryParamBool(c *gin.Context, name string) (bool, error) {
+	value := c.Request.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return boolValue, nil
+}
+
+func getQueryParamList(c *gin.Context, name string) []string {
+	value := c.Request.URL.Query().Get(name)
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, listSeparator)
+}
+
+func getFacade(c *gin.Context) (EventsService, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(EventsService)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,147 @@
+package grpcApi
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+func simulationResultsToResponse(results *transaction.SimulationResults) *SimulateTransactionResponse {
+	response := &SimulateTransactionResponse{
+		Status:     string(results.Status),
+		FailReason: results.FailReason,
+	}
+
+	if len(results.ScResults) > 0 {
+		response.ScResults = make(map[string]*ApiSmartContractResult, len(results.ScResults))
+		for hash, scr := range results.ScResults {
+			response.ScResults[hash] = apiSmartContractResultToResponse(scr)
+		}
+	}
+
+	if len(results.Receipts) > 0 {
+		response.Receipts = make(map[string]*ApiReceipt, len(results.Receipts))
+		for hash, receipt := range results.Receipts {
+			response.Receipts[hash] = apiReceiptToResponse(receipt)
+		}
+	}
+
+	return response
+}
+
+func apiTransactionToResponse(tx *transaction.ApiTransactionResult) *ApiTransaction {
+	if tx == nil {
+		return nil
+	}
+
+	response := &ApiTransaction{
+		Type:             tx.Type,
+		Hash:             tx.Hash,
+		Nonce:            tx.Nonce,
+		Round:            tx.Round,
+		Epoch:            tx.Epoch,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		Signature:        tx.Signature,
+		SourceShard:      tx.SourceShard,
+		DestinationShard: tx.DestinationShard,
+		BlockNonce:       tx.BlockNonce,
+		BlockHash:        tx.BlockHash,
+		MiniBlockType:    tx.MiniBlockType,
+		MiniBlockHash:    tx.MiniBlockHash,
+		Status:           string(tx.Status),
+		Receipt:          apiReceiptToResponse(tx.Receipt),
+	}
+
+	for _, scr := range tx.SmartContractResults {
+		response.SmartContractResults = append(response.SmartContractResults, apiSmartContractResultToResponse(scr))
+	}
+
+	return response
+}
+
+func apiSmartContractResultToResponse(scr *transaction.ApiSmartContractResult) *ApiSmartContractResult {
+	if scr == nil {
+		return nil
+	}
+
+	return &ApiSmartContractResult{
+		Hash:           scr.Hash,
+		Nonce:          scr.Nonce,
+		Value:          bigIntToString(scr.Value),
+		Receiver:       scr.RcvAddr,
+		Sender:         scr.SndAddr,
+		Data:           scr.Data,
+		PrevTxHash:     scr.PrevTxHash,
+		OriginalTxHash: scr.OriginalTxHash,
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		ReturnMessage:  scr.ReturnMessage,
+	}
+}
+
+func apiReceiptToResponse(receipt *transaction.ReceiptApi) *ApiReceipt {
+	if receipt == nil {
+		return nil
+	}
+
+	return &ApiReceipt{
+		Value:  bigIntToString(receipt.Value),
+		Sender: receipt.SndAddr,
+		Data:   receipt.Data,
+		TxHash: receipt.TxHash,
+	}
+}
+
+func apiBlockToResponse(block *api.Block) *ApiBlock {
+	if block == nil {
+		return nil
+	}
+
+	response := &ApiBlock{
+		Nonce:         block.Nonce,
+		Round:         block.Round,
+		Hash:          block.Hash,
+		PrevBlockHash: block.PrevBlockHash,
+		Epoch:         block.Epoch,
+		Shard:         block.Shard,
+		NumTxs:        block.NumTxs,
+	}
+
+	for _, notarizedBlock := range block.NotarizedBlocks {
+		response.NotarizedBlocks = append(response.NotarizedBlocks, &ApiNotarizedBlock{
+			Hash:  notarizedBlock.Hash,
+			Nonce: notarizedBlock.Nonce,
+			Shard: notarizedBlock.Shard,
+		})
+	}
+
+	for _, miniBlock := range block.MiniBlocks {
+		apiMiniBlock := &ApiMiniBlock{
+			Hash:             miniBlock.Hash,
+			Type:             miniBlock.Type,
+			SourceShard:      miniBlock.SourceShard,
+			DestinationShard: miniBlock.DestinationShard,
+		}
+		for _, tx := range miniBlock.Transactions {
+			apiMiniBlock.Transactions = append(apiMiniBlock.Transactions, apiTransactionToResponse(tx))
+		}
+
+		response.MiniBlocks = append(response.MiniBlocks, apiMiniBlock)
+	}
+
+	return response
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
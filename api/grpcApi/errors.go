@@ -0,0 +1,21 @@
+package grpcApi
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrEmptyListenAddress signals that an empty listen address has been provided
+var ErrEmptyListenAddress = errors.New("empty listen address")
+
+// ErrInvalidMaxNumRequests signals that a provided number of requests is invalid
+var ErrInvalidMaxNumRequests = errors.New("max number of requests value is invalid")
+
+// ErrInvalidResetInterval signals that an invalid reset interval has been provided
+var ErrInvalidResetInterval = errors.New("invalid reset interval")
+
+// ErrTooManyRequests signals that too many requests were simultaneously received
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrServerAlreadyStarted signals that the server was already started
+var ErrServerAlreadyStarted = errors.New("server already started")
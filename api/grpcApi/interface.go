@@ -0,0 +1,37 @@
+package grpcApi
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// FacadeHandler defines the methods of the node facade used by the gRPC server
+type FacadeHandler interface {
+	GetAccount(address string) (state.UserAccountHandler, error)
+	GetCode(account state.UserAccountHandler) []byte
+	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// SourceThrottler defines the per source requests limiter shared with the REST API
+type SourceThrottler interface {
+	IsQuotaReached(remoteAddr string, numRequests uint32) bool
+	Reset()
+	IsInterfaceNil() bool
+}
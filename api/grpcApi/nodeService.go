@@ -0,0 +1,221 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=plugins=grpc:. nodeService.proto
+
+package grpcApi
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nodeService implements the NodeServiceServer by calling the node facade, following the same steps as the
+// equivalent REST API handlers
+type nodeService struct {
+	facade FacadeHandler
+}
+
+// GetAccount returns the account of the provided address
+func (ns *nodeService) GetAccount(_ context.Context, request *AccountRequest) (*AccountResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrCouldNotGetAccount, apiErrors.ErrEmptyAddress)
+	}
+
+	account, err := ns.facade.GetAccount(request.Address)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrCouldNotGetAccount, err)
+	}
+
+	return &AccountResponse{
+		Address:  request.Address,
+		Nonce:    account.GetNonce(),
+		Balance:  bigIntToString(account.GetBalance()),
+		Username: string(account.GetUserName()),
+		Code:     ns.facade.GetCode(account),
+		CodeHash: account.GetCodeHash(),
+		RootHash: account.GetRootHash(),
+	}, nil
+}
+
+// SendTransaction validates and propagates the provided transaction
+func (ns *nodeService) SendTransaction(_ context.Context, request *TransactionRequest) (*SendTransactionResponse, error) {
+	tx, txHash, err := ns.createTransaction(request)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrTxGenerationFailed, err)
+	}
+
+	err = ns.facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrTxGenerationFailed, err)
+	}
+
+	_, err = ns.facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SendTransactionResponse{
+		TxHash: hex.EncodeToString(txHash),
+	}, nil
+}
+
+// SimulateTransaction simulates the execution of the provided transaction
+func (ns *nodeService) SimulateTransaction(_ context.Context, request *TransactionRequest) (*SimulateTransactionResponse, error) {
+	tx, txHash, err := ns.createTransaction(request)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrTxGenerationFailed, err)
+	}
+
+	err = ns.facade.ValidateTransactionForSimulation(tx)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrTxGenerationFailed, err)
+	}
+
+	simulationResults, err := ns.facade.SimulateTransactionExecution(tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := simulationResultsToResponse(simulationResults)
+	response.Hash = hex.EncodeToString(txHash)
+
+	return response, nil
+}
+
+// ComputeTransactionCost returns the gas units needed by the provided transaction
+func (ns *nodeService) ComputeTransactionCost(_ context.Context, request *TransactionRequest) (*TransactionCostResponse, error) {
+	tx, _, err := ns.createTransaction(request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cost, err := ns.facade.ComputeTransactionGasLimit(tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &TransactionCostResponse{
+		TxGasUnits: cost,
+	}, nil
+}
+
+// GetTransaction returns the transaction with the provided hash
+func (ns *nodeService) GetTransaction(_ context.Context, request *GetTransactionRequest) (*ApiTransaction, error) {
+	tx, err := ns.facade.GetTransaction(request.Hash, request.WithResults)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrGetTransaction, err)
+	}
+
+	return apiTransactionToResponse(tx), nil
+}
+
+// GetBlockByNonce returns the block with the provided nonce
+func (ns *nodeService) GetBlockByNonce(_ context.Context, request *BlockByNonceRequest) (*ApiBlock, error) {
+	block, err := ns.facade.GetBlockByNonce(request.Nonce, request.WithTxs)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrGetBlock, err)
+	}
+
+	return apiBlockToResponse(block), nil
+}
+
+// GetBlockByHash returns the block with the provided hash
+func (ns *nodeService) GetBlockByHash(_ context.Context, request *BlockByHashRequest) (*ApiBlock, error) {
+	if len(request.Hash) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrValidation, apiErrors.ErrValidationEmptyBlockHash)
+	}
+
+	block, err := ns.facade.GetBlockByHash(request.Hash, request.WithTxs)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrGetBlock, err)
+	}
+
+	return apiBlockToResponse(block), nil
+}
+
+// QueryVMValues executes the provided smart contract query
+func (ns *nodeService) QueryVMValues(_ context.Context, request *VMValuesRequest) (*VMValuesResponse, error) {
+	scQuery, err := ns.createSCQuery(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vmOutput, err := ns.facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &VMValuesResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+		GasRefund:     bigIntToString(vmOutput.GasRefund),
+	}, nil
+}
+
+func (ns *nodeService) createTransaction(request *TransactionRequest) (*transaction.Transaction, []byte, error) {
+	return ns.facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.ReceiverUsername,
+		request.Sender,
+		request.SenderUsername,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+}
+
+func (ns *nodeService) createSCQuery(request *VMValuesRequest) (*process.SCQuery, error) {
+	decodedAddress, err := ns.facade.DecodeAddressPubkey(request.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
+	}
+
+	arguments := make([][]byte, len(request.Args))
+	for i, arg := range request.Args {
+		arguments[i], err = hex.DecodeString(arg)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex string: %s", arg, err.Error())
+		}
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress: decodedAddress,
+		FuncName:  request.FuncName,
+		Arguments: arguments,
+	}
+
+	if len(request.Caller) > 0 {
+		scQuery.CallerAddr, err = ns.facade.DecodeAddressPubkey(request.Caller)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(request.Value) > 0 {
+		callValue, ok := big.NewInt(0).SetString(request.Value, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", request.Value)
+		}
+		scQuery.CallValue = callValue
+	}
+
+	return scQuery, nil
+}
+
+func newStatusError(code codes.Code, scope error, err error) error {
+	return status.Error(code, fmt.Sprintf("%s: %s", scope.Error(), err.Error()))
+}
@@ -0,0 +1,137 @@
+package grpcApi
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"google.golang.org/grpc"
+)
+
+var log = logger.GetOrCreate("api/grpcApi")
+
+// ArgsServer holds the arguments needed to create a new gRPC server
+type ArgsServer struct {
+	Facade          FacadeHandler
+	ListenAddress   string
+	AntifloodConfig config.WebServerAntifloodConfig
+}
+
+type server struct {
+	listenAddress string
+	grpcServer    *grpc.Server
+	throttler     *requestsThrottler
+	resetInterval time.Duration
+	mutListener   sync.RWMutex
+	listener      net.Listener
+	cancelFunc    func()
+}
+
+// NewServer creates a new gRPC server that exposes the node facade through the NodeService
+func NewServer(args ArgsServer) (*server, error) {
+	if check.IfNil(args.Facade) {
+		return nil, ErrNilFacade
+	}
+	if len(args.ListenAddress) == 0 {
+		return nil, ErrEmptyListenAddress
+	}
+	if args.AntifloodConfig.SameSourceResetIntervalInSec == 0 {
+		return nil, ErrInvalidResetInterval
+	}
+
+	throttler, err := newRequestsThrottler(
+		args.Facade,
+		args.AntifloodConfig.SimultaneousRequests,
+		args.AntifloodConfig.SameSourceRequests,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(throttler.unaryInterceptor))
+	RegisterNodeServiceServer(grpcServer, &nodeService{facade: args.Facade})
+
+	return &server{
+		listenAddress: args.ListenAddress,
+		grpcServer:    grpcServer,
+		throttler:     throttler,
+		resetInterval: time.Second * time.Duration(args.AntifloodConfig.SameSourceResetIntervalInSec),
+	}, nil
+}
+
+// Start opens the listener and starts serving the requests on a separate go routine
+func (s *server) Start() error {
+	s.mutListener.Lock()
+	defer s.mutListener.Unlock()
+
+	if s.listener != nil {
+		return ErrServerAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	var ctx context.Context
+	ctx, s.cancelFunc = context.WithCancel(context.Background())
+
+	go s.resetSourceLimiter(ctx)
+	go func() {
+		log.Debug("starting gRPC server", "address", listener.Addr().String())
+		errServe := s.grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Debug("gRPC server stopped", "error", errServe.Error())
+		}
+	}()
+
+	return nil
+}
+
+func (s *server) resetSourceLimiter(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(s.resetInterval):
+			log.Trace("calling reset on gRPC source limiter")
+			s.throttler.reset()
+		case <-ctx.Done():
+			log.Debug("closing gRPC server.resetSourceLimiter go routine")
+			return
+		}
+	}
+}
+
+// Address returns the address the server is listening on, or the configured address if the server was not started
+func (s *server) Address() string {
+	s.mutListener.RLock()
+	defer s.mutListener.RUnlock()
+
+	if s.listener == nil {
+		return s.listenAddress
+	}
+
+	return s.listener.Addr().String()
+}
+
+// Close stops the server, closing all the open connections
+func (s *server) Close() error {
+	s.mutListener.Lock()
+	defer s.mutListener.Unlock()
+
+	if s.cancelFunc != nil {
+		s.cancelFunc()
+	}
+	s.grpcServer.Stop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,247 @@
+package grpcApi_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/grpcApi"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createMockArgsServer(facade grpcApi.FacadeHandler) grpcApi.ArgsServer {
+	return grpcApi.ArgsServer{
+		Facade:        facade,
+		ListenAddress: "127.0.0.1:0",
+		AntifloodConfig: config.WebServerAntifloodConfig{
+			SimultaneousRequests:         100,
+			SameSourceRequests:           100,
+			SameSourceResetIntervalInSec: 1,
+		},
+	}
+}
+
+func startServerAndCreateClient(t *testing.T, args grpcApi.ArgsServer) (grpcApi.NodeServiceClient, func()) {
+	server, err := grpcApi.NewServer(args)
+	require.Nil(t, err)
+	err = server.Start()
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, server.Address(), grpc.WithInsecure(), grpc.WithBlock())
+	require.Nil(t, err)
+
+	closeFunc := func() {
+		_ = conn.Close()
+		_ = server.Close()
+	}
+
+	return grpcApi.NewNodeServiceClient(conn), closeFunc
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(nil)
+	server, err := grpcApi.NewServer(args)
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrNilFacade, err)
+
+	args = createMockArgsServer(&mock.Facade{})
+	args.ListenAddress = ""
+	server, err = grpcApi.NewServer(args)
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrEmptyListenAddress, err)
+
+	args = createMockArgsServer(&mock.Facade{})
+	args.AntifloodConfig.SameSourceResetIntervalInSec = 0
+	server, err = grpcApi.NewServer(args)
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrInvalidResetInterval, err)
+
+	args = createMockArgsServer(&mock.Facade{})
+	args.AntifloodConfig.SimultaneousRequests = 0
+	server, err = grpcApi.NewServer(args)
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrInvalidMaxNumRequests, err)
+
+	server, err = grpcApi.NewServer(createMockArgsServer(&mock.Facade{}))
+	assert.False(t, check.IfNil(server))
+	assert.Nil(t, err)
+}
+
+func TestServer_StartTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, _ := grpcApi.NewServer(createMockArgsServer(&mock.Facade{}))
+	err := server.Start()
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	err = server.Start()
+	assert.Equal(t, grpcApi.ErrServerAlreadyStarted, err)
+}
+
+func TestServer_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1address"
+	facade := &mock.Facade{
+		GetAccountHandler: func(addr string) (state.UserAccountHandler, error) {
+			account, _ := state.NewUserAccount([]byte("address"))
+			_ = account.AddToBalance(big.NewInt(100))
+			account.IncreaseNonce(7)
+
+			return account, nil
+		},
+	}
+	client, closeFunc := startServerAndCreateClient(t, createMockArgsServer(facade))
+	defer closeFunc()
+
+	response, err := client.GetAccount(context.Background(), &grpcApi.AccountRequest{Address: address})
+	require.Nil(t, err)
+	assert.Equal(t, address, response.Address)
+	assert.Equal(t, uint64(7), response.Nonce)
+	assert.Equal(t, "100", response.Balance)
+
+	_, err = client.GetAccount(context.Background(), &grpcApi.AccountRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numSent := uint32(0)
+	facade := &mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _ uint32, _ uint32) (*transaction.Transaction, []byte, error) {
+			if nonce == 0 {
+				return nil, nil, expectedErr
+			}
+
+			return &transaction.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			atomic.AddUint32(&numSent, uint32(len(txs)))
+			return uint64(len(txs)), nil
+		},
+	}
+	client, closeFunc := startServerAndCreateClient(t, createMockArgsServer(facade))
+	defer closeFunc()
+
+	response, err := client.SendTransaction(context.Background(), &grpcApi.TransactionRequest{Nonce: 1})
+	require.Nil(t, err)
+	assert.Equal(t, "68617368", response.TxHash)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numSent))
+
+	_, err = client.SendTransaction(context.Background(), &grpcApi.TransactionRequest{Nonce: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), expectedErr.Error())
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numSent))
+}
+
+func TestServer_GetBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{
+				Nonce: nonce,
+				Hash:  "hash",
+				MiniBlocks: []*api.MiniBlock{
+					{
+						Hash:         "mbHash",
+						Transactions: []*transaction.ApiTransactionResult{{Hash: "txHash"}},
+					},
+				},
+			}, nil
+		},
+	}
+	client, closeFunc := startServerAndCreateClient(t, createMockArgsServer(facade))
+	defer closeFunc()
+
+	response, err := client.GetBlockByNonce(context.Background(), &grpcApi.BlockByNonceRequest{Nonce: 5, WithTxs: true})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), response.Nonce)
+	assert.Equal(t, "hash", response.Hash)
+	require.Equal(t, 1, len(response.MiniBlocks))
+	require.Equal(t, 1, len(response.MiniBlocks[0].Transactions))
+	assert.Equal(t, "txHash", response.MiniBlocks[0].Transactions[0].Hash)
+}
+
+func TestServer_EndpointThrottlerShouldRejectRequests(t *testing.T) {
+	t.Parallel()
+
+	numSent := uint32(0)
+	requestedEndpoint := atomic.Value{}
+	facade := &mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _ uint32, _ uint32) (*transaction.Transaction, []byte, error) {
+			return &transaction.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			atomic.AddUint32(&numSent, 1)
+			return 1, nil
+		},
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			requestedEndpoint.Store(endpoint)
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool {
+					return false
+				},
+			}, true
+		},
+	}
+	client, closeFunc := startServerAndCreateClient(t, createMockArgsServer(facade))
+	defer closeFunc()
+
+	_, err := client.SendTransaction(context.Background(), &grpcApi.TransactionRequest{Nonce: 1})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "/transaction/send", requestedEndpoint.Load())
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numSent))
+}
+
+func TestServer_SameSourceQuotaShouldRejectRequests(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+	args := createMockArgsServer(facade)
+	args.AntifloodConfig.SameSourceRequests = 2
+	args.AntifloodConfig.SameSourceResetIntervalInSec = 100
+	client, closeFunc := startServerAndCreateClient(t, args)
+	defer closeFunc()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetBlockByNonce(context.Background(), &grpcApi.BlockByNonceRequest{Nonce: 1})
+		assert.Nil(t, err)
+	}
+
+	_, err := client.GetBlockByNonce(context.Background(), &grpcApi.BlockByNonceRequest{Nonce: 1})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
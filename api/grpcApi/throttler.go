@@ -0,0 +1,122 @@
+package grpcApi
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// methodsEndpoints maps the gRPC methods to the REST API endpoints sharing the same endpoint throttler
+var methodsEndpoints = map[string]string{
+	"/proto.NodeService/SendTransaction":     "/transaction/send",
+	"/proto.NodeService/SimulateTransaction": "/transaction/simulate",
+	"/proto.NodeService/GetTransaction":      "/transaction/:hash",
+}
+
+// requestsThrottler applies on the gRPC requests the same limits the REST API middleware applies on the http
+// requests: a limit on the total number of simultaneous requests, a limit on the number of requests originating from
+// the same source between two resets and the endpoint throttlers defined in the facade
+type requestsThrottler struct {
+	facade               FacadeHandler
+	simultaneousRequests core.Throttler
+	sourceThrottler      SourceThrottler
+}
+
+func newRequestsThrottler(facade FacadeHandler, maxSimultaneousRequests uint32, maxSameSourceRequests uint32) (*requestsThrottler, error) {
+	if maxSimultaneousRequests == 0 || maxSameSourceRequests == 0 {
+		return nil, ErrInvalidMaxNumRequests
+	}
+
+	simultaneousRequests, err := throttler.NewNumGoRoutinesThrottler(int32(maxSimultaneousRequests))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceThrottler, err := middleware.NewSourceThrottler(maxSameSourceRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestsThrottler{
+		facade:               facade,
+		simultaneousRequests: simultaneousRequests,
+		sourceThrottler:      sourceThrottler,
+	}, nil
+}
+
+func (rt *requestsThrottler) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	err := rt.checkSourceQuota(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rt.simultaneousRequests.CanProcess() {
+		return nil, status.Error(codes.ResourceExhausted, ErrTooManyRequests.Error())
+	}
+
+	rt.simultaneousRequests.StartProcessing()
+	defer rt.simultaneousRequests.EndProcessing()
+
+	endpoint, ok := methodsEndpoints[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	endpointThrottler, ok := rt.facade.GetThrottlerForEndpoint(endpoint)
+	if !ok {
+		return handler(ctx, req)
+	}
+	if !endpointThrottler.CanProcess() {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("%s for endpoint %s", ErrTooManyRequests.Error(), endpoint))
+	}
+
+	endpointThrottler.StartProcessing()
+	defer endpointThrottler.EndProcessing()
+
+	return handler(ctx, req)
+}
+
+func (rt *requestsThrottler) checkSourceQuota(ctx context.Context) error {
+	remoteAddr, err := getRemoteAddress(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if rt.sourceThrottler.IsQuotaReached(remoteAddr, 1) {
+		return status.Error(codes.ResourceExhausted, fmt.Sprintf("%s for address %s", ErrTooManyRequests.Error(), remoteAddr))
+	}
+
+	return nil
+}
+
+func getRemoteAddress(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", fmt.Errorf("cannot determine the source of the request")
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String(), nil
+	}
+
+	return host, nil
+}
+
+// reset resets all accumulated counters of the same source limiter
+func (rt *requestsThrottler) reset() {
+	rt.sourceThrottler.Reset()
+}
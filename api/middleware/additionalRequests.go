@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+const additionalRequestsHandlerContextKey = "additionalRequestsHandler"
+
+// additionalRequestsHandler accounts a number of additional requests carried by a single http request against
+// the quota of a middleware limiter, returning an error if the quota does not allow them
+type additionalRequestsHandler func(numRequests uint32) error
+
+// AccountAdditionalRequests accounts the provided number of additional requests carried by the current http request
+// (e.g. batched queries) against the quotas of the middleware limiters that already processed the request
+func AccountAdditionalRequests(c *gin.Context, numRequests uint32) error {
+	if numRequests == 0 {
+		return nil
+	}
+
+	handlerObj, ok := c.Get(additionalRequestsHandlerContextKey)
+	if !ok {
+		return nil
+	}
+
+	handler, ok := handlerObj.(additionalRequestsHandler)
+	if !ok {
+		return nil
+	}
+
+	return handler(numRequests)
+}
+
+// setAdditionalRequestsHandler chains the provided handler with the ones already set on the request context
+func setAdditionalRequestsHandler(c *gin.Context, handler additionalRequestsHandler) {
+	handlerObj, ok := c.Get(additionalRequestsHandlerContextKey)
+	previousHandler, isHandler := handlerObj.(additionalRequestsHandler)
+	if !ok || !isHandler {
+		c.Set(additionalRequestsHandlerContextKey, handler)
+		return
+	}
+
+	chainedHandler := additionalRequestsHandler(func(numRequests uint32) error {
+		err := previousHandler(numRequests)
+		if err != nil {
+			return err
+		}
+
+		return handler(numRequests)
+	})
+	c.Set(additionalRequestsHandlerContextKey, chainedHandler)
+}
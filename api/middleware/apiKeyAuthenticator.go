@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// ApiKeyHeader is the http header holding the API key of a request
+const ApiKeyHeader = "X-Api-Key"
+
+// ApiKeyNameContextKey is the gin context key under which the name of an authenticated API key is stored
+const ApiKeyNameContextKey = "apiKeyName"
+
+const allRoutesWildcard = "*"
+
+type apiKeyInfo struct {
+	name                   string
+	allowedRoutes          []string
+	maxRequestsPerInterval uint32
+}
+
+// apiKeyAuthenticator is a middleware that identifies the callers by the provided API key. Each key has its own
+// route allow-list and requests quota. Protected routes can only be accessed using a key that allows them, while all
+// the other routes remain accessible to anonymous callers as well
+type apiKeyAuthenticator struct {
+	keys            map[string]*apiKeyInfo
+	protectedRoutes []string
+	mutRequests     sync.Mutex
+	keysRequests    map[string]uint32
+}
+
+// NewApiKeyAuthenticator creates a new instance of an apiKeyAuthenticator
+func NewApiKeyAuthenticator(apiKeysConfig config.APIKeysConfig) (*apiKeyAuthenticator, error) {
+	keys := make(map[string]*apiKeyInfo, len(apiKeysConfig.Keys))
+	for _, keyConfig := range apiKeysConfig.Keys {
+		if len(keyConfig.Key) == 0 {
+			return nil, fmt.Errorf("%w for API key named %s", ErrEmptyApiKey, keyConfig.Name)
+		}
+
+		_, exists := keys[keyConfig.Key]
+		if exists {
+			return nil, fmt.Errorf("%w for API key named %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+
+		if keyConfig.MaxRequestsPerInterval > 0 && apiKeysConfig.QuotaResetIntervalInSec == 0 {
+			return nil, fmt.Errorf("%w for API key named %s", ErrInvalidQuotaResetInterval, keyConfig.Name)
+		}
+
+		keys[keyConfig.Key] = &apiKeyInfo{
+			name:                   keyConfig.Name,
+			allowedRoutes:          keyConfig.AllowedRoutes,
+			maxRequestsPerInterval: keyConfig.MaxRequestsPerInterval,
+		}
+	}
+
+	return &apiKeyAuthenticator{
+		keys:            keys,
+		protectedRoutes: apiKeysConfig.ProtectedRoutes,
+		keysRequests:    make(map[string]uint32),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (aka *apiKeyAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		apiKey := c.GetHeader(ApiKeyHeader)
+		if len(apiKey) == 0 {
+			if isRouteMatched(aka.protectedRoutes, route) {
+				abortWithError(c, http.StatusUnauthorized, shared.ReturnCodeRequestError, ErrApiKeyRequired.Error())
+				return
+			}
+
+			c.Next()
+			return
+		}
+
+		keyInfo, ok := aka.keys[apiKey]
+		if !ok {
+			abortWithError(c, http.StatusUnauthorized, shared.ReturnCodeRequestError, ErrInvalidApiKey.Error())
+			return
+		}
+		if !isRouteMatched(keyInfo.allowedRoutes, route) {
+			abortWithError(c, http.StatusForbidden, shared.ReturnCodeRequestError, ErrRouteNotAllowedForApiKey.Error())
+			return
+		}
+		if aka.isQuotaReached(apiKey, keyInfo, 1) {
+			abortWithError(
+				c,
+				http.StatusTooManyRequests,
+				shared.ReturnCodeSystemBusy,
+				fmt.Sprintf("%s for API key %s", ErrTooManyRequests.Error(), keyInfo.name),
+			)
+			return
+		}
+
+		c.Set(ApiKeyNameContextKey, keyInfo.name)
+		setAdditionalRequestsHandler(c, func(numRequests uint32) error {
+			if aka.isQuotaReached(apiKey, keyInfo, numRequests) {
+				return fmt.Errorf("%w for API key %s", ErrTooManyRequests, keyInfo.name)
+			}
+
+			return nil
+		})
+		c.Next()
+	}
+}
+
+func (aka *apiKeyAuthenticator) isQuotaReached(apiKey string, keyInfo *apiKeyInfo, numRequests uint32) bool {
+	if keyInfo.maxRequestsPerInterval == 0 {
+		return false
+	}
+
+	aka.mutRequests.Lock()
+	defer aka.mutRequests.Unlock()
+
+	requests := aka.keysRequests[apiKey]
+	if requests+numRequests > keyInfo.maxRequestsPerInterval {
+		return true
+	}
+	aka.keysRequests[apiKey] += numRequests
+
+	return false
+}
+
+// isRouteMatched returns true if the route matches one of the patterns. A pattern can be the full route, as defined in
+// the routes config (e.g. /address/:address), a prefix ending in /* (e.g. /address/*) or * which matches all routes
+func isRouteMatched(patterns []string, route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		if pattern == allRoutesWildcard || pattern == route {
+			return true
+		}
+
+		prefix := strings.TrimSuffix(pattern, allRoutesWildcard)
+		if prefix != pattern && strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func abortWithError(c *gin.Context, status int, code shared.ReturnCode, message string) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: message,
+			Code:  code,
+		},
+	)
+}
+
+// Reset resets the requests counters of all API keys
+func (aka *apiKeyAuthenticator) Reset() {
+	aka.mutRequests.Lock()
+	aka.keysRequests = make(map[string]uint32)
+	aka.mutRequests.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aka *apiKeyAuthenticator) IsInterfaceNil() bool {
+	return aka == nil
+}
@@ -0,0 +1,205 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminKey    = "admin-key"
+	readOnlyKey = "read-only-key"
+)
+
+func createApiKeysConfig() config.APIKeysConfig {
+	return config.APIKeysConfig{
+		Enabled:                 true,
+		QuotaResetIntervalInSec: 1,
+		ProtectedRoutes:         []string{"/hardfork/trigger", "/node/debug"},
+		Keys: []config.APIKeyConfig{
+			{
+				Name:          "admin",
+				Key:           adminKey,
+				AllowedRoutes: []string{"*"},
+			},
+			{
+				Name:                   "read-only",
+				Key:                    readOnlyKey,
+				AllowedRoutes:          []string{"/address/*"},
+				MaxRequestsPerInterval: 2,
+			},
+		},
+	}
+}
+
+func startNodeServerApiKeyAuthenticator(apiKeysConfig config.APIKeysConfig, maxSourceRequests uint32) (*gin.Engine, reseter) {
+	ws := gin.New()
+	authenticator, _ := middleware.NewApiKeyAuthenticator(apiKeysConfig)
+	sourceThrottler, _ := middleware.NewSourceThrottler(maxSourceRequests)
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"key": c.GetString(middleware.ApiKeyNameContextKey)})
+	}
+	ws.GET("/address/:address/balance", okHandler)
+	ws.GET("/node/debug", okHandler)
+	ws.POST("/hardfork/trigger", okHandler)
+	ws.GET("/node/status", okHandler)
+
+	return ws, authenticator
+}
+
+func doRequest(ws *gin.Engine, method string, path string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	req.RemoteAddr = "127.0.0.1:8080"
+	if len(apiKey) > 0 {
+		req.Header.Set(middleware.ApiKeyHeader, apiKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewApiKeyAuthenticator_EmptyKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createApiKeysConfig()
+	cfg.Keys[1].Key = ""
+	aka, err := middleware.NewApiKeyAuthenticator(cfg)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrEmptyApiKey))
+}
+
+func TestNewApiKeyAuthenticator_DuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createApiKeysConfig()
+	cfg.Keys[1].Key = adminKey
+	aka, err := middleware.NewApiKeyAuthenticator(cfg)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+}
+
+func TestNewApiKeyAuthenticator_QuotaWithoutResetIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createApiKeysConfig()
+	cfg.QuotaResetIntervalInSec = 0
+	aka, err := middleware.NewApiKeyAuthenticator(cfg)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidQuotaResetInterval))
+}
+
+func TestNewApiKeyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	aka, err := middleware.NewApiKeyAuthenticator(createApiKeysConfig())
+
+	assert.False(t, check.IfNil(aka))
+	assert.Nil(t, err)
+}
+
+func TestApiKeyAuthenticator_ProtectedRouteWithoutKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	resp := doRequest(ws, "POST", "/hardfork/trigger", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), middleware.ErrApiKeyRequired.Error())
+
+	resp = doRequest(ws, "GET", "/node/debug", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestApiKeyAuthenticator_OpenRouteWithoutKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	resp := doRequest(ws, "GET", "/node/status", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestApiKeyAuthenticator_InvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	resp := doRequest(ws, "GET", "/node/status", "unknown-key")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), middleware.ErrInvalidApiKey.Error())
+}
+
+func TestApiKeyAuthenticator_RouteNotAllowedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	resp := doRequest(ws, "POST", "/hardfork/trigger", readOnlyKey)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Contains(t, resp.Body.String(), middleware.ErrRouteNotAllowedForApiKey.Error())
+}
+
+func TestApiKeyAuthenticator_AllowedRoutesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	resp := doRequest(ws, "POST", "/hardfork/trigger", adminKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "admin")
+
+	resp = doRequest(ws, "GET", "/address/erd1test/balance", readOnlyKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "read-only")
+}
+
+func TestApiKeyAuthenticator_QuotaReachedShouldErrAndResetShouldAllowAgain(t *testing.T) {
+	t.Parallel()
+
+	ws, resetter := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), 100)
+
+	for i := 0; i < 2; i++ {
+		resp := doRequest(ws, "GET", "/address/erd1test/balance", readOnlyKey)
+		require.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	resp := doRequest(ws, "GET", "/address/erd1test/balance", readOnlyKey)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	resetter.Reset()
+
+	resp = doRequest(ws, "GET", "/address/erd1test/balance", readOnlyKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestApiKeyAuthenticator_AuthenticatedRequestsShouldBypassSourceThrottler(t *testing.T) {
+	t.Parallel()
+
+	maxSourceRequests := uint32(1)
+	ws, _ := startNodeServerApiKeyAuthenticator(createApiKeysConfig(), maxSourceRequests)
+
+	for i := 0; i < 5; i++ {
+		resp := doRequest(ws, "GET", "/node/status", adminKey)
+		require.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	resp := doRequest(ws, "GET", "/node/status", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = doRequest(ws, "GET", "/node/status", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
@@ -7,3 +7,21 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrApiKeyRequired signals that the requested route can only be accessed using an API key
+var ErrApiKeyRequired = errors.New("an API key is required for this route")
+
+// ErrInvalidApiKey signals that the provided API key is not known
+var ErrInvalidApiKey = errors.New("invalid API key")
+
+// ErrRouteNotAllowedForApiKey signals that the provided API key is not allowed to access the requested route
+var ErrRouteNotAllowedForApiKey = errors.New("route not allowed for the provided API key")
+
+// ErrEmptyApiKey signals that an API key was configured with an empty value
+var ErrEmptyApiKey = errors.New("empty API key")
+
+// ErrDuplicatedApiKey signals that the same API key value was configured more than once
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrInvalidQuotaResetInterval signals that an invalid quota reset interval was provided
+var ErrInvalidQuotaResetInterval = errors.New("invalid quota reset interval")
@@ -10,7 +10,8 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// globalThrottler is a middleware limiter used to limit total number of requests originating from the same source
+// sourceThrottler is a middleware limiter used to limit total number of requests originating from the same source.
+// Requests authenticated with an API key are not accounted here as they are subject to the key's own quota
 type sourceThrottler struct {
 	mutRequests    sync.Mutex
 	sourceRequests map[string]uint32
@@ -33,6 +34,11 @@ func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if len(c.GetString(ApiKeyNameContextKey)) > 0 {
+			c.Next()
+			return
+		}
+
 		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
 		if err != nil {
 			c.AbortWithStatusJSON(
@@ -46,13 +52,7 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		st.mutRequests.Lock()
-		requests := st.sourceRequests[remoteAddr]
-		isQuotaReached := requests >= st.maxNumRequests
-		st.sourceRequests[remoteAddr]++
-		st.mutRequests.Unlock()
-
-		if isQuotaReached {
+		if st.IsQuotaReached(remoteAddr, 1) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -64,10 +64,31 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
+		setAdditionalRequestsHandler(c, func(numRequests uint32) error {
+			if st.IsQuotaReached(remoteAddr, numRequests) {
+				return fmt.Errorf("%w for address %s", ErrTooManyRequests, remoteAddr)
+			}
+
+			return nil
+		})
+
 		c.Next()
 	}
 }
 
+// IsQuotaReached accounts the provided number of requests for the source and returns true if the source
+// exceeded its quota
+func (st *sourceThrottler) IsQuotaReached(remoteAddr string, numRequests uint32) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	requests := st.sourceRequests[remoteAddr]
+	isQuotaReached := requests+numRequests > st.maxNumRequests
+	st.sourceRequests[remoteAddr] += numRequests
+
+	return isQuotaReached
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -47,9 +48,29 @@ type Facade struct {
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
 	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
+	GetAllESDTNFTsCalled                    func(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByRoundCalled                   func(round uint64, withTxs bool) (*api.Block, error)
+	GetHyperblockByNonceCalled              func(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHashCalled               func(hash string) (*api.Hyperblock, error)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	GetTransactionStatusCalled              func(hash string) (string, error)
+	GetTransactionsByAddressCalled          func(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error)
+	GetKeyValuePairsCalled                  func(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error)
+	GetAccountProofCalled                   func(address string, options api.AccountQueryOptions) (*api.TrieProof, error)
+	GetAccountKeyProofCalled                func(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
+	SubscribeToEventsCalled                 func(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error)
+	UnsubscribeFromEventsCalled             func(subscription *eventsNotifier.Subscription)
+	GetBalanceWithOptionsCalled             func(address string, options api.AccountQueryOptions) (*big.Int, error)
+	GetValueForKeyWithOptionsCalled         func(address string, key string, options api.AccountQueryOptions) (string, error)
+	GetAccountWithOptionsCalled             func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetStorageUsageCalled                   func() (*api.StorageUsage, error)
+	CompactStorageUnitCalled                func(unitName string) error
+	GetGasPriceSuggestionCalled             func() (*api.GasPriceSuggestion, error)
+	GetTransactionsPoolCalled               func() (*api.TransactionsPool, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*api.TransactionsPoolForSender, error)
+	GetPendingNonceCalled                   func(sender string) (uint64, error)
 }
 
 // GetUsername -
@@ -130,11 +151,47 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetAllESDTNFTs -
+func (f *Facade) GetAllESDTNFTs(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error) {
+	if f.GetAllESDTNFTsCalled != nil {
+		return f.GetAllESDTNFTsCalled(address, tokenIdentifier)
+	}
+
+	return make([]*api.ESDTNFTData, 0), nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
 }
 
+// GetBalanceWithOptions -
+func (f *Facade) GetBalanceWithOptions(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	if f.GetBalanceWithOptionsCalled != nil {
+		return f.GetBalanceWithOptionsCalled(address, options)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetValueForKeyWithOptions -
+func (f *Facade) GetValueForKeyWithOptions(address string, key string, options api.AccountQueryOptions) (string, error) {
+	if f.GetValueForKeyWithOptionsCalled != nil {
+		return f.GetValueForKeyWithOptionsCalled(address, key, options)
+	}
+
+	return "", nil
+}
+
+// GetAccountWithOptions -
+func (f *Facade) GetAccountWithOptions(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	if f.GetAccountWithOptionsCalled != nil {
+		return f.GetAccountWithOptionsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // GetCode -
 func (f *Facade) GetCode(account state.UserAccountHandler) []byte {
 	if f.GetCodeCalled != nil {
@@ -168,6 +225,51 @@ func (f *Facade) GetTransaction(hash string, withResults bool) (*transaction.Api
 	return f.GetTransactionHandler(hash, withResults)
 }
 
+// GetTransactionStatus -
+func (f *Facade) GetTransactionStatus(hash string) (string, error) {
+	if f.GetTransactionStatusCalled != nil {
+		return f.GetTransactionStatusCalled(hash)
+	}
+
+	return "", nil
+}
+
+// GetTransactionsByAddress -
+func (f *Facade) GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, skip, maxSize)
+	}
+
+	return nil, 0, nil
+}
+
+// GetKeyValuePairs -
+func (f *Facade) GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error) {
+	if f.GetKeyValuePairsCalled != nil {
+		return f.GetKeyValuePairsCalled(address, skip, maxSize)
+	}
+
+	return &api.AccountKeyValuePairsPage{}, nil
+}
+
+// GetAccountProof -
+func (f *Facade) GetAccountProof(address string, options api.AccountQueryOptions) (*api.TrieProof, error) {
+	if f.GetAccountProofCalled != nil {
+		return f.GetAccountProofCalled(address, options)
+	}
+
+	return &api.TrieProof{}, nil
+}
+
+// GetAccountKeyProof -
+func (f *Facade) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	if f.GetAccountKeyProofCalled != nil {
+		return f.GetAccountKeyProofCalled(address, key, options)
+	}
+
+	return &api.AccountKeyProof{}, nil
+}
+
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
 func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
 	return f.SimulateTransactionExecutionHandler(tx)
@@ -223,6 +325,22 @@ func (f *Facade) EncodeAddressPubkey(pk []byte) (string, error) {
 	return hex.EncodeToString(pk), nil
 }
 
+// SubscribeToEvents -
+func (f *Facade) SubscribeToEvents(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error) {
+	if f.SubscribeToEventsCalled != nil {
+		return f.SubscribeToEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// UnsubscribeFromEvents -
+func (f *Facade) UnsubscribeFromEvents(subscription *eventsNotifier.Subscription) {
+	if f.UnsubscribeFromEventsCalled != nil {
+		f.UnsubscribeFromEventsCalled(subscription)
+	}
+}
+
 // DecodeAddressPubkey -
 func (f *Facade) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -266,6 +384,87 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// GetBlockByRound -
+func (f *Facade) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
+	if f.GetBlockByRoundCalled != nil {
+		return f.GetBlockByRoundCalled(round, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (f *Facade) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if f.GetHyperblockByNonceCalled != nil {
+		return f.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (f *Facade) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if f.GetHyperblockByHashCalled != nil {
+		return f.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetStorageUsage -
+func (f *Facade) GetStorageUsage() (*api.StorageUsage, error) {
+	if f.GetStorageUsageCalled != nil {
+		return f.GetStorageUsageCalled()
+	}
+
+	return &api.StorageUsage{}, nil
+}
+
+// CompactStorageUnit -
+func (f *Facade) CompactStorageUnit(unitName string) error {
+	if f.CompactStorageUnitCalled != nil {
+		return f.CompactStorageUnitCalled(unitName)
+	}
+
+	return nil
+}
+
+// GetTransactionsPool -
+func (f *Facade) GetTransactionsPool() (*api.TransactionsPool, error) {
+	if f.GetTransactionsPoolCalled != nil {
+		return f.GetTransactionsPoolCalled()
+	}
+
+	return &api.TransactionsPool{}, nil
+}
+
+// GetTransactionsPoolForSender -
+func (f *Facade) GetTransactionsPoolForSender(sender string) (*api.TransactionsPoolForSender, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return &api.TransactionsPoolForSender{}, nil
+}
+
+// GetPendingNonce -
+func (f *Facade) GetPendingNonce(sender string) (uint64, error) {
+	if f.GetPendingNonceCalled != nil {
+		return f.GetPendingNonceCalled(sender)
+	}
+
+	return 0, nil
+}
+
+// GetGasPriceSuggestion -
+func (f *Facade) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	if f.GetGasPriceSuggestionCalled != nil {
+		return f.GetGasPriceSuggestionCalled()
+	}
+
+	return &api.GasPriceSuggestion{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
@@ -1,12 +1,14 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
@@ -16,11 +18,13 @@ const (
 	getStatusPath   = "/status"
 	economicsPath   = "/economics"
 	totalStakedPath = "/total-staked"
+	gasPricePath    = "/gas-price"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
+	GetGasPriceSuggestion() (*api.GasPriceSuggestion, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
@@ -31,6 +35,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
 	router.RegisterHandler(http.MethodGet, totalStakedPath, GetTotalStaked)
+	router.RegisterHandler(http.MethodGet, gasPricePath, GetGasPrice)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -151,3 +156,34 @@ func GetTotalStaked(c *gin.Context) {
 		},
 	)
 }
+
+// GetGasPrice is the endpoint that will return the low, median and high gas prices suggestions, computed from the
+// gas prices of the transactions included in the recent blocks and the transactions pool fullness
+func GetGasPrice(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	suggestion, err := facade.GetGasPriceSuggestion()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestion.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"gasPrice": suggestion},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
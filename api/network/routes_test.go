@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
@@ -210,6 +211,82 @@ func TestTotalStaked_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestGetGasPrice_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest(http.MethodGet, "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestGetGasPrice_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetGasPriceSuggestionCalled: func() (*api.GasPriceSuggestion, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetGasPriceSuggestion.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetGasPrice_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetGasPriceSuggestionCalled: func() (*api.GasPriceSuggestion, error) {
+			return &api.GasPriceSuggestion{
+				Low:          1000000000,
+				Median:       1500000000,
+				High:         3000000000,
+				MinGasPrice:  1000000000,
+				NumBlocks:    20,
+				PoolFullness: 0.25,
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type gasPriceResponse struct {
+		Data struct {
+			GasPrice api.GasPriceSuggestion `json:"gasPrice"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}
+	response := gasPriceResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(1000000000), response.Data.GasPrice.Low)
+	assert.Equal(t, uint64(1500000000), response.Data.GasPrice.Median)
+	assert.Equal(t, uint64(3000000000), response.Data.GasPrice.High)
+	assert.Equal(t, 20, response.Data.GasPrice.NumBlocks)
+	assert.Equal(t, 0.25, response.Data.GasPrice.PoolFullness)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -260,6 +337,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/total-staked", Open: true},
+					{Name: "/gas-price", Open: true},
 				},
 			},
 		},
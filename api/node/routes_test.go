@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -499,6 +500,149 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestStorageUsage_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestStorageUsage_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetStorageUsageCalled: func() (*api.StorageUsage, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetStorageUsage.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStorageUsage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	usage := &api.StorageUsage{
+		TotalBytes: 150,
+		Shards: []*api.ShardStorageUsage{
+			{
+				Shard:      "0",
+				TotalBytes: 150,
+				Epochs: []*api.EpochStorageUsage{
+					{
+						Epoch:      3,
+						TotalBytes: 150,
+						Units:      []*api.UnitStorageUsage{{Unit: "BlockHeaders", Bytes: 150}},
+					},
+				},
+			},
+		},
+	}
+	facade := &mock.Facade{
+		GetStorageUsageCalled: func() (*api.StorageUsage, error) {
+			return usage, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Storage *api.StorageUsage `json:"storage"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, usage, response.Data.Storage)
+}
+
+func TestCompactStorageUnit_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWithFacade(&mock.Facade{})
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer([]byte("invalid")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestCompactStorageUnit_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		CompactStorageUnitCalled: func(unitName string) error {
+			return expectedErr
+		},
+	}
+	jsonStr, _ := json.Marshal(&node.CompactStorageUnitRequest{Unit: "BlockHeaderUnit"})
+
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrCompactStorageUnit.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestCompactStorageUnit_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	compactedUnit := ""
+	facade := &mock.Facade{
+		CompactStorageUnitCalled: func(unitName string) error {
+			compactedUnit = unitName
+			return nil
+		},
+	}
+	jsonStr, _ := json.Marshal(&node.CompactStorageUnitRequest{Unit: "BlockHeaderUnit"})
+
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, "BlockHeaderUnit", compactedUnit)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -559,6 +703,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/storage", Open: true},
+					{Name: "/storage/compact", Open: true},
 				},
 			},
 		},
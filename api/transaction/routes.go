@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
@@ -21,11 +22,17 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	getTransactionStatusEndpoint     = "/transaction/:hash/status"
+	getTransactionsPoolEndpoint      = "/pool/transactions"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionStatusPath         = "/:txhash/status"
+	getTransactionsPoolPath          = "/transactions"
+	queryParamBySender               = "by-sender"
+	queryParamPendingNonce           = "pending-nonce"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -37,8 +44,12 @@ type FacadeHandler interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStatus(hash string) (string, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	GetTransactionsPool() (*api.TransactionsPool, error)
+	GetTransactionsPoolForSender(sender string) (*api.TransactionsPoolForSender, error)
+	GetPendingNonce(sender string) (uint64, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -112,6 +123,23 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
 		GetTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodGet,
+		getTransactionStatusPath,
+		middleware.CreateEndpointThrottler(getTransactionStatusEndpoint),
+		GetTransactionStatus,
+	)
+}
+
+// PoolRoutes defines the transactions pool related routes. They are registered in a separate group because the router
+// does not allow a static path segment next to the :txhash wildcard of the transaction routes
+func PoolRoutes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(
+		http.MethodGet,
+		getTransactionsPoolPath,
+		middleware.CreateEndpointThrottler(getTransactionsPoolEndpoint),
+		GetTransactionsPool,
+	)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -453,6 +481,112 @@ func GetTransaction(c *gin.Context) {
 	)
 }
 
+// GetTransactionsPool returns the number of transactions found in each cache of the transactions pool. If the
+// by-sender query parameter is provided, it returns the pending transactions of that sender along with their nonce
+// gaps or, if pending-nonce is set as well, only the nonce to be used by the sender's next transaction
+func GetTransactionsPool(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	sender := c.Request.URL.Query().Get(queryParamBySender)
+	if sender == "" {
+		pool, err := facade.GetTransactionsPool()
+		if err != nil {
+			respondWithTransactionsPoolError(c, err)
+			return
+		}
+
+		respondWithTransactionsPoolData(c, gin.H{"txPool": pool})
+		return
+	}
+
+	onlyPendingNonce, err := getQueryParamBool(c, queryParamPendingNonce)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	if onlyPendingNonce {
+		nonce, errNonce := facade.GetPendingNonce(sender)
+		if errNonce != nil {
+			respondWithTransactionsPoolError(c, errNonce)
+			return
+		}
+
+		respondWithTransactionsPoolData(c, gin.H{"nonce": nonce})
+		return
+	}
+
+	poolForSender, err := facade.GetTransactionsPoolForSender(sender)
+	if err != nil {
+		respondWithTransactionsPoolError(c, err)
+		return
+	}
+
+	respondWithTransactionsPoolData(c, gin.H{"txPool": poolForSender})
+}
+
+func respondWithTransactionsPoolError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
+
+func respondWithTransactionsPoolData(c *gin.Context, data gin.H) {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  data,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetTransactionStatus returns the status of a transaction identified by the given txhash
+func GetTransactionStatus(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+		)
+		return
+	}
+
+	status, err := facade.GetTransactionStatus(txhash)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": status}, "", shared.ReturnCodeSuccess)
+}
+
 // ComputeTransactionGasLimit returns how many gas units a transaction wil consume
 func ComputeTransactionGasLimit(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -524,6 +658,15 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+func getQueryParamBool(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(valueStr)
+}
+
 func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	withResultsStr := c.Request.URL.Query().Get("withResults")
 	if withResultsStr == "" {
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	tr "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -37,6 +38,16 @@ type transactionResponse struct {
 	Code  string                  `json:"code"`
 }
 
+type transactionStatusResponseData struct {
+	Status string `json:"status"`
+}
+
+type transactionStatusResponse struct {
+	Data  transactionStatusResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
 type sendMultipleTxsResponseData struct {
 	TxsSent   int      `json:"txsSent"`
 	TxsHashes []string `json:"txsHashes"`
@@ -125,6 +136,51 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, txData, txResp.Data)
 }
 
+func TestGetTransactionStatus_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	facade := mock.Facade{
+		GetTransactionStatusCalled: func(txHash string) (string, error) {
+			assert.Equal(t, hash, txHash)
+			return tr.TxStatusPartiallyExecuted.String(), nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/"+hash+"/status", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionStatusResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, tr.TxStatusPartiallyExecuted.String(), response.Data.Status)
+}
+
+func TestGetTransactionStatus_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionStatusCalled: func(_ string) (string, error) {
+			return "", expectedErr
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash/status", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionStatusResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
@@ -781,6 +837,201 @@ func logError(err error) {
 	}
 }
 
+func TestGetTransactionsPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := &api.TransactionsPool{
+		NumTxs:   3,
+		NumBytes: 300,
+		Caches: []*api.TransactionsPoolCache{
+			{CacheID: "0", NumTxs: 2, NumBytes: 200},
+			{CacheID: "1_0", NumTxs: 1, NumBytes: 100},
+		},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*api.TransactionsPool, error) {
+			return expectedPool, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/pool/transactions", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			TxPool *api.TransactionsPool `json:"txPool"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, expectedPool, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*api.TransactionsPool, error) {
+			return nil, expectedErr
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/pool/transactions", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_BySenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sender := "sender"
+	expectedPoolForSender := &api.TransactionsPoolForSender{
+		Sender:       sender,
+		AccountNonce: 5,
+		PendingNonce: 6,
+		Transactions: []*api.PoolTransaction{
+			{Hash: "aa", Nonce: 5, Receiver: "receiver", Value: "10", GasPrice: 10, GasLimit: 50000},
+			{Hash: "bb", Nonce: 8, Receiver: "receiver", Value: "10", GasPrice: 10, GasLimit: 50000},
+		},
+		NonceGaps: []*api.NonceGap{{From: 6, To: 7}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(address string) (*api.TransactionsPoolForSender, error) {
+			assert.Equal(t, sender, address)
+			return expectedPoolForSender, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/pool/transactions?by-sender="+sender, nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			TxPool *api.TransactionsPoolForSender `json:"txPool"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, expectedPoolForSender, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_PendingNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sender := "sender"
+	facade := mock.Facade{
+		GetPendingNonceCalled: func(address string) (uint64, error) {
+			assert.Equal(t, sender, address)
+			return 37, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/pool/transactions?by-sender="+sender+"&pending-nonce=true", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Nonce uint64 `json:"nonce"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, uint64(37), response.Data.Nonce)
+}
+
+func TestGetTransactionsPool_InvalidPendingNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest("GET", "/pool/transactions?by-sender=sender&pending-nonce=maybe", nil)
+	ws := startNodeServer(&mock.Facade{})
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGetTransactionsPool_ErrorWithExceededNumGoRoutines(t *testing.T) {
+	t.Parallel()
+
+	throttlerName := ""
+	facade := mock.Facade{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			throttlerName = endpoint
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool { return false },
+			}, true
+		},
+		GetTransactionsPoolCalled: func() (*api.TransactionsPool, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/pool/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "/pool/transactions", throttlerName)
+}
+
+func TestGetTransactionsPool_RouteClosedShouldNotBeServed(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*api.TransactionsPool, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	routesConfig := getRoutesConfig()
+	poolRoutes := routesConfig.APIPackages["pool"]
+	poolRoutes.Routes = []config.RouteConfig{{Name: "/transactions", Open: false}}
+	routesConfig.APIPackages["pool"] = poolRoutes
+
+	ws := gin.New()
+	ginPoolRoute := ws.Group("/pool")
+	ginPoolRoute.Use(middleware.WithFacade(&facade))
+	poolRoute, _ := wrapper.NewRouterWrapper("pool", ginPoolRoute, routesConfig)
+	transaction.PoolRoutes(poolRoute)
+
+	req, _ := http.NewRequest("GET", "/pool/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
 func startNodeServer(handler transaction.FacadeHandler) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -790,6 +1041,13 @@ func startNodeServer(handler transaction.FacadeHandler) *gin.Engine {
 	}
 	transactionRoute, _ := wrapper.NewRouterWrapper("transaction", ginTransactionRoute, getRoutesConfig())
 	transaction.Routes(transactionRoute)
+
+	ginPoolRoute := ws.Group("/pool")
+	if handler != nil {
+		ginPoolRoute.Use(middleware.WithFacade(handler))
+	}
+	poolRoute, _ := wrapper.NewRouterWrapper("pool", ginPoolRoute, getRoutesConfig())
+	transaction.PoolRoutes(poolRoute)
 	return ws
 }
 
@@ -818,6 +1076,11 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/simulate", Open: true},
 				},
 			},
+			"pool": {
+				Routes: []config.RouteConfig{
+					{Name: "/transactions", Open: true},
+				},
+			},
 		},
 	}
 }
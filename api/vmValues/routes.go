@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -36,6 +37,10 @@ type VMValueRequest struct {
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	// BlockNonce, BlockHash and RootHash optionally select the past state the query will be executed on
+	BlockNonce *uint64 `form:"blockNonce" json:"blockNonce"`
+	BlockHash  string  `form:"blockHash" json:"blockHash"`
+	RootHash   string  `form:"rootHash" json:"rootHash"`
 }
 
 // Routes defines address related routes
@@ -106,7 +111,7 @@ func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
 		return nil, errors.ErrInvalidJSONRequest
 	}
 
-	command, err := createSCQuery(ef, &request)
+	command, err := CreateSCQuery(ef, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +119,8 @@ func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
 	return ef.ExecuteSCQuery(command)
 }
 
-func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
+// CreateSCQuery creates a smart contract query from the provided request
+func CreateSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
 	decodedAddress, err := fh.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
@@ -154,6 +160,27 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		scQuery.CallValue = callValue
 	}
 
+	if request.BlockNonce != nil {
+		scQuery.BlockNonce = core.OptionalUint64{
+			Value:    *request.BlockNonce,
+			HasValue: true,
+		}
+	}
+
+	if len(request.BlockHash) > 0 {
+		scQuery.BlockHash, err = hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+	}
+
+	if len(request.RootHash) > 0 {
+		scQuery.RootHash, err = hex.DecodeString(request.RootHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid root hash: %s", request.RootHash, err.Error())
+		}
+	}
+
 	return scQuery, nil
 }
 
@@ -157,11 +157,39 @@ func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 		Args:      []string{"bad arg"},
 	}
 
-	_, err := createSCQuery(&mock.Facade{}, &request)
+	_, err := CreateSCQuery(&mock.Facade{}, &request)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "'bad arg' is not a valid hex string")
 }
 
+func TestCreateSCQuery_HistoricalStateShouldWork(t *testing.T) {
+	nonce := uint64(37)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &nonce,
+		RootHash:   "abcd",
+	}
+
+	query, err := CreateSCQuery(&mock.Facade{}, &request)
+	require.Nil(t, err)
+	require.True(t, query.BlockNonce.HasValue)
+	require.Equal(t, nonce, query.BlockNonce.Value)
+	require.Equal(t, []byte{0xab, 0xcd}, query.RootHash)
+}
+
+func TestCreateSCQuery_InvalidBlockHashShouldErr(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		BlockHash: "not hex",
+	}
+
+	_, err := CreateSCQuery(&mock.Facade{}, &request)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'not hex' is not a valid block hash")
+}
+
 func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
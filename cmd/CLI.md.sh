@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForTrieInspector
+    generateForDbChecker
+    generateForSnapshotExporter
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForTrieInspector() {
+    HELP="
+# Elrond TrieInspector CLI
+
+The **Elrond Trie Inspector** exposes the following Command Line Interface:
+$(code)
+\$ trieinspector --help
+
+$(./trieinspector/trieinspector --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trieinspector/CLI.md
+}
+
+generateForDbChecker() {
+    HELP="
+# Elrond DbChecker CLI
+
+The **Elrond DB Checker** exposes the following Command Line Interface:
+$(code)
+\$ dbchecker --help
+
+$(./dbchecker/dbchecker --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbchecker/CLI.md
+}
+
+generateForSnapshotExporter() {
+    HELP="
+# Elrond SnapshotExporter CLI
+
+The **Elrond Snapshot Exporter** exposes the following Command Line Interface:
+$(code)
+\$ snapshotexporter --help
+
+$(./snapshotexporter/snapshotexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./snapshotexporter/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
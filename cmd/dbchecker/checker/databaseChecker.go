@@ -0,0 +1,718 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dbchecker/checker")
+
+const epochDirectoryPrefix = "Epoch_"
+const shardDirectoryPrefix = "Shard_"
+const epochStartIdentifierPrefix = "epochStartBlock_"
+
+// ArgsDatabaseChecker holds the arguments needed for creating a new database checker
+type ArgsDatabaseChecker struct {
+	GeneralConfig             config.Config
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	Uint64Converter           typeConverters.Uint64ByteSliceConverter
+	PersisterFactory          storage.PersisterFactory
+	DirectoryReader           storage.DirectoryReaderHandler
+	LatestStorageDataProvider storage.LatestStorageDataProviderHandler
+}
+
+type storageLayout struct {
+	parentDir  string
+	shardID    uint32
+	shardIDStr string
+	epochs     []uint32
+}
+
+type headerInfo struct {
+	nonce              uint64
+	prevHash           []byte
+	miniBlockHeaderIDs [][]byte
+}
+
+type databaseChecker struct {
+	generalConfig             config.Config
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	uint64Converter           typeConverters.Uint64ByteSliceConverter
+	persisterFactory          storage.PersisterFactory
+	directoryReader           storage.DirectoryReaderHandler
+	latestStorageDataProvider storage.LatestStorageDataProviderHandler
+}
+
+// NewDatabaseChecker will return a new instance of databaseChecker
+func NewDatabaseChecker(args ArgsDatabaseChecker) (*databaseChecker, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+	if check.IfNil(args.PersisterFactory) {
+		return nil, ErrNilPersisterFactory
+	}
+	if check.IfNil(args.DirectoryReader) {
+		return nil, ErrNilDirectoryReader
+	}
+	if check.IfNil(args.LatestStorageDataProvider) {
+		return nil, ErrNilLatestStorageDataProvider
+	}
+
+	return &databaseChecker{
+		generalConfig:             args.GeneralConfig,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		uint64Converter:           args.Uint64Converter,
+		persisterFactory:          args.PersisterFactory,
+		directoryReader:           args.DirectoryReader,
+		latestStorageDataProvider: args.LatestStorageDataProvider,
+	}, nil
+}
+
+// Check walks the headers, miniblocks, transactions and nonce-hash units of the shard found in storage and reports
+// the gaps and the dangling references between them, together with the last fully consistent block
+func (dc *databaseChecker) Check() (*Report, error) {
+	layout, err := dc.getStorageLayout()
+	if err != nil {
+		return nil, err
+	}
+
+	headersUnit, err := dc.openEpochsUnit(layout, dc.getHeadersUnitPath(layout.shardID))
+	if err != nil {
+		return nil, err
+	}
+	defer headersUnit.close()
+
+	miniBlocksUnit, err := dc.openEpochsUnit(layout, dc.generalConfig.MiniBlocksStorage.DB.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer miniBlocksUnit.close()
+
+	txsUnit, err := dc.openEpochsUnit(layout, dc.generalConfig.TxStorage.DB.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer txsUnit.close()
+
+	nonceHashUnit, err := dc.openNonceHashUnit(layout)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(nonceHashUnit)
+
+	report := &Report{
+		ShardID: layout.shardID,
+		Epochs:  layout.epochs,
+		Issues:  make([]*Issue, 0),
+	}
+
+	headers, headersByNonce := dc.loadHeaders(layout.shardID, headersUnit, report)
+	if len(headers) == 0 {
+		return nil, ErrNoHeaderFound
+	}
+
+	isConsistentSoFar := true
+	var prevHash []byte
+	for nonce := report.LowestNonce; nonce <= report.HighestNonce; nonce++ {
+		numIssues := len(report.Issues)
+		hash := dc.checkNonce(nonce, prevHash, headers, headersByNonce, nonceHashUnit, miniBlocksUnit, txsUnit, report)
+		prevHash = hash
+
+		isNonceConsistent := len(report.Issues) == numIssues
+		isConsistentSoFar = isConsistentSoFar && isNonceConsistent
+		if isConsistentSoFar {
+			report.LastConsistentNonce = nonce
+			report.LastConsistentHash = hash
+		}
+	}
+
+	dc.checkMappingsAboveHighestNonce(report, nonceHashUnit)
+
+	return report, nil
+}
+
+func (dc *databaseChecker) checkNonce(
+	nonce uint64,
+	prevHash []byte,
+	headers map[string]*headerInfo,
+	headersByNonce map[uint64][][]byte,
+	nonceHashUnit storage.Persister,
+	miniBlocksUnit *epochsUnit,
+	txsUnit *epochsUnit,
+	report *Report,
+) []byte {
+	hash, err := nonceHashUnit.Get(dc.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		hashes := headersByNonce[nonce]
+		if len(hashes) == 0 {
+			report.addIssue(MissingHeader, nonce, nil)
+			return nil
+		}
+
+		for _, headerHash := range hashes {
+			report.addIssue(MissingNonceHashMapping, nonce, headerHash)
+		}
+		return nil
+	}
+
+	hdrInfo, ok := headers[string(hash)]
+	if !ok {
+		report.addIssue(DanglingNonceHashMapping, nonce, hash)
+		return hash
+	}
+	if len(prevHash) > 0 && !bytes.Equal(prevHash, hdrInfo.prevHash) {
+		report.addIssue(BrokenChain, nonce, hash)
+	}
+
+	for _, miniBlockHash := range hdrInfo.miniBlockHeaderIDs {
+		dc.checkMiniBlock(nonce, miniBlockHash, miniBlocksUnit, txsUnit, report)
+	}
+
+	return hash
+}
+
+func (dc *databaseChecker) checkMiniBlock(
+	nonce uint64,
+	miniBlockHash []byte,
+	miniBlocksUnit *epochsUnit,
+	txsUnit *epochsUnit,
+	report *Report,
+) {
+	miniBlockBytes, err := miniBlocksUnit.get(miniBlockHash)
+	if err != nil {
+		report.addIssue(MissingMiniBlock, nonce, miniBlockHash)
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = dc.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		report.addIssue(CorruptedMiniBlock, nonce, miniBlockHash)
+		return
+	}
+
+	// only the transactions of the regular miniblocks are held in the transactions unit
+	if miniBlock.Type != block.TxBlock {
+		return
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		if !txsUnit.has(txHash) {
+			report.addIssue(MissingTransaction, nonce, txHash)
+		}
+	}
+}
+
+func (dc *databaseChecker) checkMappingsAboveHighestNonce(report *Report, nonceHashUnit storage.Persister) {
+	for nonce := report.HighestNonce + 1; ; nonce++ {
+		hash, err := nonceHashUnit.Get(dc.uint64Converter.ToByteSlice(nonce))
+		if err != nil {
+			return
+		}
+
+		report.addIssue(DanglingNonceHashMapping, nonce, hash)
+	}
+}
+
+func (dc *databaseChecker) loadHeaders(shardID uint32, headersUnit *epochsUnit, report *Report) (map[string]*headerInfo, map[uint64][][]byte) {
+	headers := make(map[string]*headerInfo)
+	headersByNonce := make(map[uint64][][]byte)
+
+	headersUnit.rangeKeys(func(key []byte, val []byte) bool {
+		_, alreadyLoaded := headers[string(key)]
+		if alreadyLoaded {
+			return true
+		}
+		// epoch start blocks are also saved under their identifier, besides their hash
+		if bytes.HasPrefix(key, []byte(epochStartIdentifierPrefix)) {
+			return true
+		}
+
+		header, err := dc.unmarshalHeader(shardID, val)
+		if err != nil {
+			report.addIssue(CorruptedHeader, 0, key)
+			return true
+		}
+
+		nonce := header.GetNonce()
+		// the genesis block is recreated at each start and it is not referenced by the nonce-hash unit
+		if nonce == 0 {
+			return true
+		}
+		if len(headers) == 0 || nonce < report.LowestNonce {
+			report.LowestNonce = nonce
+		}
+		if nonce > report.HighestNonce {
+			report.HighestNonce = nonce
+		}
+
+		headers[string(key)] = &headerInfo{
+			nonce:              nonce,
+			prevHash:           header.GetPrevHash(),
+			miniBlockHeaderIDs: header.GetMiniBlockHeadersHashes(),
+		}
+		headersByNonce[nonce] = append(headersByNonce[nonce], key)
+
+		return true
+	})
+	report.NumHeaders = len(headers)
+
+	return headers, headersByNonce
+}
+
+func (dc *databaseChecker) unmarshalHeader(shardID uint32, headerBytes []byte) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := dc.marshalizer.Unmarshal(metaBlock, headerBytes)
+		return metaBlock, err
+	}
+
+	header := &block.Header{}
+	err := dc.marshalizer.Unmarshal(header, headerBytes)
+	return header, err
+}
+
+// TruncateToLastConsistentBlock moves the highest bootstrap round back to the newest round whose last header is not
+// above the last consistent block from the provided report, so that the bootstrap from storage starts from that block.
+// The state tries are first checked to be fully recreatable from the root hashes of that block. After the bootstrap
+// data is moved, the bootstrap rounds and the nonce-hash mappings above the block are removed, while the headers
+// above it are reported as orphaned
+func (dc *databaseChecker) TruncateToLastConsistentBlock(report *Report) (*TruncateResult, error) {
+	if report == nil {
+		return nil, ErrNilReport
+	}
+	if !report.HasConsistentBlock() {
+		return nil, ErrNoConsistentBlock
+	}
+
+	layout, err := dc.getStorageLayout()
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapUnit, err := dc.openEpochsUnit(layout, dc.generalConfig.BootstrapStorage.DB.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer bootstrapUnit.close()
+
+	nonceHashUnit, err := dc.openNonceHashUnit(layout)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(nonceHashUnit)
+
+	highestRound, err := dc.getHighestBootstrapRound(bootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	var bootstrapData *bootstrapStorage.BootstrapData
+	roundsAbove := make([]int64, 0)
+	round := highestRound
+	for round > 0 {
+		bootstrapData, err = dc.getBootstrapData(bootstrapUnit, round)
+		if err != nil {
+			return nil, err
+		}
+
+		if dc.isConsistentBootstrapHeader(bootstrapData.LastHeader, report, nonceHashUnit) {
+			break
+		}
+		if bootstrapData.LastRound >= round {
+			return nil, ErrNoConsistentBootstrapRound
+		}
+
+		roundsAbove = append(roundsAbove, round)
+		round = bootstrapData.LastRound
+	}
+	if round <= 0 {
+		return nil, ErrNoConsistentBootstrapRound
+	}
+
+	result := &TruncateResult{
+		Round:           round,
+		Nonce:           bootstrapData.LastHeader.Nonce,
+		Hash:            bootstrapData.LastHeader.Hash,
+		OrphanedHeaders: make([][]byte, 0),
+	}
+	if round == highestRound {
+		return result, nil
+	}
+
+	headersUnit, err := dc.openEpochsUnit(layout, dc.getHeadersUnitPath(layout.shardID))
+	if err != nil {
+		return nil, err
+	}
+	defer headersUnit.close()
+
+	err = dc.checkStateTries(layout, headersUnit, bootstrapData.LastHeader.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dc.saveHighestBootstrapRound(bootstrapUnit, round)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("bootstrap data truncated", "previous round", highestRound, "round", round)
+
+	result.RemovedBootstrapRounds = dc.removeBootstrapRounds(bootstrapUnit, roundsAbove)
+	result.RemovedNonceHashMappings = dc.removeNonceHashMappingsAbove(nonceHashUnit, result.Nonce, report.HighestNonce)
+	result.OrphanedHeaders = dc.getHeadersAbove(layout.shardID, headersUnit, result.Nonce)
+
+	return result, nil
+}
+
+// checkStateTries verifies that the state tries can be fully recreated from the root hashes of the given header, as
+// the node recreates them when it bootstraps from storage
+func (dc *databaseChecker) checkStateTries(layout *storageLayout, headersUnit *epochsUnit, headerHash []byte) error {
+	headerBytes, err := headersUnit.get(headerHash)
+	if err != nil {
+		return fmt.Errorf("%w for header %s", err, hex.EncodeToString(headerHash))
+	}
+
+	header, err := dc.unmarshalHeader(layout.shardID, headerBytes)
+	if err != nil {
+		return fmt.Errorf("%w for header %s", err, hex.EncodeToString(headerHash))
+	}
+
+	triesConfig := dc.generalConfig.StateTriesConfig
+	err = dc.checkTrie(layout, dc.generalConfig.AccountsTrieStorage.DB.FilePath, header.GetRootHash(), triesConfig.MaxStateTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+	if layout.shardID != core.MetachainShardId {
+		return nil
+	}
+
+	return dc.checkTrie(layout, dc.generalConfig.PeerAccountsTrieStorage.DB.FilePath, header.GetValidatorStatsRootHash(), triesConfig.MaxPeerTrieLevelInMemory)
+}
+
+func (dc *databaseChecker) checkTrie(layout *storageLayout, unitPath string, rootHash []byte, maxTrieLevelInMemory uint) error {
+	persister, err := dc.openStaticPersister(layout, unitPath)
+	if err != nil {
+		return err
+	}
+	defer closePersister(persister)
+
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(persister)
+	if err != nil {
+		return err
+	}
+
+	tr, err := trie.NewTrie(trieStorage, dc.marshalizer, dc.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err == nil {
+		_, err = recreatedTrie.GetAllHashes()
+	}
+	if err != nil {
+		return fmt.Errorf("%w for root hash %s in %s: %s", ErrTrieNotRecreated, hex.EncodeToString(rootHash), unitPath, err.Error())
+	}
+
+	return nil
+}
+
+func (dc *databaseChecker) removeBootstrapRounds(bootstrapUnit *epochsUnit, rounds []int64) int {
+	numRemoved := 0
+	for _, round := range rounds {
+		if bootstrapUnit.remove([]byte(strconv.FormatInt(round, 10))) {
+			numRemoved++
+		}
+	}
+
+	return numRemoved
+}
+
+// removeNonceHashMappingsAbove removes the mappings of the nonces above the provided one, which would otherwise point
+// to blocks the node no longer bootstraps from
+func (dc *databaseChecker) removeNonceHashMappingsAbove(nonceHashUnit storage.Persister, nonce uint64, highestNonce uint64) int {
+	numRemoved := 0
+	for n := nonce + 1; ; n++ {
+		key := dc.uint64Converter.ToByteSlice(n)
+		if nonceHashUnit.Has(key) != nil {
+			if n > highestNonce {
+				return numRemoved
+			}
+			continue
+		}
+
+		err := nonceHashUnit.Remove(key)
+		if err != nil {
+			log.Warn("cannot remove nonce-hash mapping", "nonce", n, "error", err)
+			continue
+		}
+		numRemoved++
+	}
+}
+
+func (dc *databaseChecker) getHeadersAbove(shardID uint32, headersUnit *epochsUnit, nonce uint64) [][]byte {
+	hashes := make([][]byte, 0)
+	headersUnit.rangeKeys(func(key []byte, val []byte) bool {
+		if bytes.HasPrefix(key, []byte(epochStartIdentifierPrefix)) {
+			return true
+		}
+
+		header, err := dc.unmarshalHeader(shardID, val)
+		if err != nil || header.GetNonce() <= nonce {
+			return true
+		}
+
+		hashes = append(hashes, key)
+		return true
+	})
+
+	return hashes
+}
+
+func (dc *databaseChecker) isConsistentBootstrapHeader(
+	headerInfo bootstrapStorage.BootstrapHeaderInfo,
+	report *Report,
+	nonceHashUnit storage.Persister,
+) bool {
+	if headerInfo.Nonce < report.LowestNonce || headerInfo.Nonce > report.LastConsistentNonce {
+		return false
+	}
+
+	hash, err := nonceHashUnit.Get(dc.uint64Converter.ToByteSlice(headerInfo.Nonce))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(hash, headerInfo.Hash)
+}
+
+func (dc *databaseChecker) getHighestBootstrapRound(bootstrapUnit *epochsUnit) (int64, error) {
+	roundBytes, err := bootstrapUnit.get([]byte(core.HighestRoundFromBootStorage))
+	if err != nil {
+		return 0, err
+	}
+
+	roundNum := &bootstrapStorage.RoundNum{}
+	err = dc.marshalizer.Unmarshal(roundNum, roundBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return roundNum.Num, nil
+}
+
+func (dc *databaseChecker) getBootstrapData(bootstrapUnit *epochsUnit, round int64) (*bootstrapStorage.BootstrapData, error) {
+	bootstrapDataBytes, err := bootstrapUnit.get([]byte(strconv.FormatInt(round, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for bootstrap round %d", err, round)
+	}
+
+	bootstrapData := &bootstrapStorage.BootstrapData{}
+	err = dc.marshalizer.Unmarshal(bootstrapData, bootstrapDataBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for bootstrap round %d", err, round)
+	}
+
+	return bootstrapData, nil
+}
+
+// saveHighestBootstrapRound writes the highest round in the newest epoch, also copying there the bootstrap data of
+// the round and its registries, as only the newest epoch is read when the node starts
+func (dc *databaseChecker) saveHighestBootstrapRound(bootstrapUnit *epochsUnit, round int64) error {
+	bootstrapData, err := dc.getBootstrapData(bootstrapUnit, round)
+	if err != nil {
+		return err
+	}
+
+	keysToCopy := [][]byte{
+		[]byte(strconv.FormatInt(round, 10)),
+		append([]byte(core.TriggerRegistryKeyPrefix), bootstrapData.EpochStartTriggerConfigKey...),
+		append([]byte(core.NodesCoordinatorRegistryKeyPrefix), bootstrapData.NodesCoordinatorConfigKey...),
+	}
+
+	newestPersister := bootstrapUnit.newest()
+	for _, key := range keysToCopy {
+		if newestPersister.Has(key) == nil {
+			continue
+		}
+
+		value, errGet := bootstrapUnit.get(key)
+		if errGet != nil {
+			log.Debug("bootstrap entry not found", "key", string(key), "error", errGet)
+			continue
+		}
+
+		err = newestPersister.Put(key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	roundBytes, err := dc.marshalizer.Marshal(&bootstrapStorage.RoundNum{Num: round})
+	if err != nil {
+		return err
+	}
+
+	return newestPersister.Put([]byte(core.HighestRoundFromBootStorage), roundBytes)
+}
+
+func (dc *databaseChecker) getStorageLayout() (*storageLayout, error) {
+	latestData, err := dc.latestStorageDataProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	parentDir, _, err := dc.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	directories, err := dc.directoryReader.ListDirectoriesAsString(parentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIDStr := core.GetShardIDString(latestData.ShardID)
+	epochs := make([]uint32, 0)
+	for _, directory := range directories {
+		if !strings.HasPrefix(directory, epochDirectoryPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(directory, epochDirectoryPrefix), 10, 32)
+		if errParse != nil {
+			log.Warn("cannot parse epoch number from directory name", "directory name", directory)
+			continue
+		}
+
+		shards, errGetShards := dc.latestStorageDataProvider.GetShardsFromDirectory(filepath.Join(parentDir, directory))
+		if errGetShards != nil || !containsString(shards, shardIDStr) {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+	if len(epochs) == 0 {
+		return nil, ErrNoEpochDirectoryFound
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return &storageLayout{
+		parentDir:  parentDir,
+		shardID:    latestData.ShardID,
+		shardIDStr: shardIDStr,
+		epochs:     epochs,
+	}, nil
+}
+
+func (dc *databaseChecker) getHeadersUnitPath(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return dc.generalConfig.MetaBlockStorage.DB.FilePath
+	}
+
+	return dc.generalConfig.BlockHeaderStorage.DB.FilePath
+}
+
+func (dc *databaseChecker) openEpochsUnit(layout *storageLayout, unitPath string) (*epochsUnit, error) {
+	unit := &epochsUnit{
+		persisters: make([]storage.Persister, 0, len(layout.epochs)),
+	}
+
+	for i := len(layout.epochs) - 1; i >= 0; i-- {
+		persisterPath := filepath.Join(
+			layout.parentDir,
+			fmt.Sprintf("%s%d", epochDirectoryPrefix, layout.epochs[i]),
+			shardDirectoryPrefix+layout.shardIDStr,
+			unitPath,
+		)
+
+		persister, err := dc.persisterFactory.Create(persisterPath)
+		if err != nil {
+			unit.close()
+			return nil, fmt.Errorf("%w for path %s", err, persisterPath)
+		}
+
+		unit.persisters = append(unit.persisters, persister)
+	}
+
+	return unit, nil
+}
+
+func (dc *databaseChecker) openNonceHashUnit(layout *storageLayout) (storage.Persister, error) {
+	unitPath := dc.generalConfig.MetaHdrNonceHashStorage.DB.FilePath
+	if layout.shardID != core.MetachainShardId {
+		unitPath = dc.generalConfig.ShardHdrNonceHashStorage.DB.FilePath + layout.shardIDStr
+	}
+
+	return dc.openStaticPersister(layout, unitPath)
+}
+
+func (dc *databaseChecker) openStaticPersister(layout *storageLayout, unitPath string) (storage.Persister, error) {
+	persisterPath := filepath.Join(
+		layout.parentDir,
+		factory.DefaultStaticDbString,
+		shardDirectoryPrefix+layout.shardIDStr,
+		unitPath,
+	)
+
+	persister, err := dc.persisterFactory.Create(persisterPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, persisterPath)
+	}
+
+	return persister, nil
+}
+
+func closePersister(persister storage.Persister) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "error", err)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dc *databaseChecker) IsInterfaceNil() bool {
+	return dc == nil
+}
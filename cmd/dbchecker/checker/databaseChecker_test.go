@@ -0,0 +1,479 @@
+package checker_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/checker"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const parentDir = "db/1"
+
+const maxTrieLevelInMemory = 5
+
+type testStorage struct {
+	marshalizer marshal.Marshalizer
+	persisters  map[string]storage.Persister
+	epochs      []string
+	rootHash    []byte
+}
+
+func newTestStorage(epochs ...uint32) *testStorage {
+	ts := &testStorage{
+		marshalizer: &marshal.GogoProtoMarshalizer{},
+		persisters:  make(map[string]storage.Persister),
+	}
+	for _, epoch := range epochs {
+		ts.epochs = append(ts.epochs, fmt.Sprintf("Epoch_%d", epoch))
+	}
+
+	return ts
+}
+
+func (ts *testStorage) persister(path string) storage.Persister {
+	persister, ok := ts.persisters[path]
+	if !ok {
+		persister = memorydb.New()
+		ts.persisters[path] = persister
+	}
+
+	return persister
+}
+
+func (ts *testStorage) epochUnit(epoch uint32, unit string) storage.Persister {
+	return ts.persister(filepath.Join(parentDir, fmt.Sprintf("Epoch_%d", epoch), "Shard_0", unit))
+}
+
+func (ts *testStorage) nonceHashUnit() storage.Persister {
+	return ts.persister(filepath.Join(parentDir, "Static", "Shard_0", "ShardHdrHashNonce0"))
+}
+
+func (ts *testStorage) accountsTrieUnit() storage.Persister {
+	return ts.persister(filepath.Join(parentDir, "Static", "Shard_0", "AccountsTrie"))
+}
+
+// addAccountsTrie commits a trie in the accounts trie unit, its root hash being set in the next added blocks
+func (ts *testStorage) addAccountsTrie(t *testing.T) {
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(ts.accountsTrieUnit())
+	tr, _ := trie.NewTrie(trieStorage, ts.marshalizer, &blake2b.Blake2b{}, maxTrieLevelInMemory)
+	for i := 0; i < 10; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, tr.Commit())
+
+	ts.rootHash, _ = tr.Root()
+}
+
+// addBlock saves a header holding one miniblock with one transaction, returning the header hash
+func (ts *testStorage) addBlock(epoch uint32, nonce uint64, prevHash []byte) []byte {
+	txHash := []byte(fmt.Sprintf("tx%d", nonce))
+	miniBlockHash := []byte(fmt.Sprintf("mb%d", nonce))
+	headerHash := []byte(fmt.Sprintf("hdr%d", nonce))
+
+	miniBlockBytes, _ := ts.marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock})
+	headerBytes, _ := ts.marshalizer.Marshal(&block.Header{
+		Nonce:            nonce,
+		PrevHash:         prevHash,
+		RootHash:         ts.rootHash,
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniBlockHash}},
+	})
+
+	_ = ts.epochUnit(epoch, "Transactions").Put(txHash, []byte("tx"))
+	_ = ts.epochUnit(epoch, "MiniBlocks").Put(miniBlockHash, miniBlockBytes)
+	_ = ts.epochUnit(epoch, "BlockHeaders").Put(headerHash, headerBytes)
+	_ = ts.nonceHashUnit().Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), headerHash)
+
+	return headerHash
+}
+
+func (ts *testStorage) addBootstrapRound(epoch uint32, round int64, lastRound int64, nonce uint64, hash []byte) {
+	bootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			Nonce: nonce,
+			Hash:  hash,
+		},
+		LastRound:                  lastRound,
+		EpochStartTriggerConfigKey: []byte("trigger"),
+	}
+	bootstrapDataBytes, _ := ts.marshalizer.Marshal(bootstrapData)
+	roundBytes, _ := ts.marshalizer.Marshal(&bootstrapStorage.RoundNum{Num: round})
+
+	unit := ts.epochUnit(epoch, "BootstrapData")
+	_ = unit.Put([]byte(strconv.FormatInt(round, 10)), bootstrapDataBytes)
+	_ = unit.Put([]byte(core.HighestRoundFromBootStorage), roundBytes)
+	_ = unit.Put([]byte(core.TriggerRegistryKeyPrefix+"trigger"), []byte("registry"))
+}
+
+func (ts *testStorage) highestBootstrapRound(epoch uint32) int64 {
+	roundBytes, _ := ts.epochUnit(epoch, "BootstrapData").Get([]byte(core.HighestRoundFromBootStorage))
+	roundNum := &bootstrapStorage.RoundNum{}
+	_ = ts.marshalizer.Unmarshal(roundNum, roundBytes)
+
+	return roundNum.Num
+}
+
+func (ts *testStorage) createArgs() checker.ArgsDatabaseChecker {
+	generalConfig := config.Config{}
+	generalConfig.BlockHeaderStorage.DB.FilePath = "BlockHeaders"
+	generalConfig.MiniBlocksStorage.DB.FilePath = "MiniBlocks"
+	generalConfig.TxStorage.DB.FilePath = "Transactions"
+	generalConfig.BootstrapStorage.DB.FilePath = "BootstrapData"
+	generalConfig.ShardHdrNonceHashStorage.DB.FilePath = "ShardHdrHashNonce"
+	generalConfig.AccountsTrieStorage.DB.FilePath = "AccountsTrie"
+	generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory = maxTrieLevelInMemory
+
+	return checker.ArgsDatabaseChecker{
+		GeneralConfig:   generalConfig,
+		Marshalizer:     ts.marshalizer,
+		Hasher:          &blake2b.Blake2b{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		PersisterFactory: &mock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				return ts.persister(path), nil
+			},
+		},
+		DirectoryReader: &mock.DirectoryReaderStub{
+			ListDirectoriesAsStringCalled: func(directoryPath string) ([]string, error) {
+				return append([]string{"Static"}, ts.epochs...), nil
+			},
+		},
+		LatestStorageDataProvider: &mock.LatestStorageDataProviderStub{
+			GetParentDirAndLastEpochCalled: func() (string, uint32, error) {
+				return parentDir, 0, nil
+			},
+			GetShardsFromDirectoryCalled: func(path string) ([]string, error) {
+				return []string{"0"}, nil
+			},
+		},
+	}
+}
+
+func (ts *testStorage) addChain(epoch uint32, fromNonce uint64, toNonce uint64, prevHash []byte) []byte {
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		prevHash = ts.addBlock(epoch, nonce, prevHash)
+	}
+
+	return prevHash
+}
+
+func TestNewDatabaseChecker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		argsFunc    func() checker.ArgsDatabaseChecker
+		expectedErr error
+	}{
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.Marshalizer = nil
+				return args
+			},
+			expectedErr: checker.ErrNilMarshalizer,
+		},
+		{
+			name: "NilHasher",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.Hasher = nil
+				return args
+			},
+			expectedErr: checker.ErrNilHasher,
+		},
+		{
+			name: "NilUint64Converter",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.Uint64Converter = nil
+				return args
+			},
+			expectedErr: checker.ErrNilUint64Converter,
+		},
+		{
+			name: "NilPersisterFactory",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.PersisterFactory = nil
+				return args
+			},
+			expectedErr: checker.ErrNilPersisterFactory,
+		},
+		{
+			name: "NilDirectoryReader",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.DirectoryReader = nil
+				return args
+			},
+			expectedErr: checker.ErrNilDirectoryReader,
+		},
+		{
+			name: "NilLatestStorageDataProvider",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				args := newTestStorage(0).createArgs()
+				args.LatestStorageDataProvider = nil
+				return args
+			},
+			expectedErr: checker.ErrNilLatestStorageDataProvider,
+		},
+		{
+			name: "All arguments ok",
+			argsFunc: func() checker.ArgsDatabaseChecker {
+				return newTestStorage(0).createArgs()
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		dc, err := checker.NewDatabaseChecker(tt.argsFunc())
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+		assert.Equal(t, tt.expectedErr == nil, dc != nil, tt.name)
+	}
+}
+
+func TestDatabaseChecker_CheckNoEpochDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage()
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	assert.Nil(t, report)
+	assert.Equal(t, checker.ErrNoEpochDirectoryFound, err)
+}
+
+func TestDatabaseChecker_CheckNoHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	assert.Nil(t, report)
+	assert.Equal(t, checker.ErrNoHeaderFound, err)
+}
+
+func TestDatabaseChecker_CheckConsistentStorageAcrossEpochs(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0, 1)
+	lastHash := ts.addChain(0, 1, 3, nil)
+	lastHash = ts.addChain(1, 4, 6, lastHash)
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.True(t, report.IsConsistent())
+	assert.Equal(t, []uint32{0, 1}, report.Epochs)
+	assert.Equal(t, 6, report.NumHeaders)
+	assert.Equal(t, uint64(1), report.LowestNonce)
+	assert.Equal(t, uint64(6), report.HighestNonce)
+	assert.Equal(t, uint64(6), report.LastConsistentNonce)
+	assert.Equal(t, lastHash, report.LastConsistentHash)
+}
+
+func TestDatabaseChecker_CheckShouldReportMissingData(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	_ = ts.addChain(0, 1, 6, nil)
+	_ = ts.epochUnit(0, "MiniBlocks").Remove([]byte("mb3"))
+	_ = ts.epochUnit(0, "Transactions").Remove([]byte("tx5"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(report.Issues))
+	assert.Equal(t, &checker.Issue{Type: checker.MissingMiniBlock, Nonce: 3, Hash: []byte("mb3")}, report.Issues[0])
+	assert.Equal(t, &checker.Issue{Type: checker.MissingTransaction, Nonce: 5, Hash: []byte("tx5")}, report.Issues[1])
+	assert.Equal(t, uint64(2), report.LastConsistentNonce)
+	assert.Equal(t, []byte("hdr2"), report.LastConsistentHash)
+}
+
+func TestDatabaseChecker_CheckShouldReportGapsAndDanglingMappings(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	_ = ts.addChain(0, 1, 5, nil)
+	_ = ts.epochUnit(0, "BlockHeaders").Remove([]byte("hdr3"))
+	_ = ts.nonceHashUnit().Remove(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(4))
+	_ = ts.nonceHashUnit().Put(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(6), []byte("hdr6"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	expectedIssues := []*checker.Issue{
+		{Type: checker.DanglingNonceHashMapping, Nonce: 3, Hash: []byte("hdr3")},
+		{Type: checker.MissingNonceHashMapping, Nonce: 4, Hash: []byte("hdr4")},
+		{Type: checker.DanglingNonceHashMapping, Nonce: 6, Hash: []byte("hdr6")},
+	}
+	assert.Equal(t, expectedIssues, report.Issues)
+	assert.Equal(t, uint64(2), report.LastConsistentNonce)
+}
+
+func TestDatabaseChecker_CheckShouldReportBrokenChain(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	_ = ts.addChain(0, 1, 2, nil)
+	_ = ts.addChain(0, 3, 4, []byte("fork"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.Equal(t, []*checker.Issue{{Type: checker.BrokenChain, Nonce: 3, Hash: []byte("hdr3")}}, report.Issues)
+	assert.Equal(t, uint64(2), report.LastConsistentNonce)
+}
+
+func TestDatabaseChecker_TruncateToLastConsistentBlockInvalidReportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dc, _ := checker.NewDatabaseChecker(newTestStorage(0).createArgs())
+
+	result, err := dc.TruncateToLastConsistentBlock(nil)
+	assert.Nil(t, result)
+	assert.Equal(t, checker.ErrNilReport, err)
+
+	result, err = dc.TruncateToLastConsistentBlock(&checker.Report{})
+	assert.Nil(t, result)
+	assert.Equal(t, checker.ErrNoConsistentBlock, err)
+}
+
+func TestDatabaseChecker_TruncateToLastConsistentBlockShouldMoveTheHighestRound(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0, 1)
+	_ = ts.addChain(0, 1, 3, nil)
+	_ = ts.addChain(1, 4, 6, []byte("hdr3"))
+	for nonce := uint64(1); nonce <= 6; nonce++ {
+		epoch := uint32(0)
+		if nonce > 3 {
+			epoch = 1
+		}
+		round := int64(nonce + 10)
+		ts.addBootstrapRound(epoch, round, round-1, nonce, []byte(fmt.Sprintf("hdr%d", nonce)))
+	}
+	_ = ts.epochUnit(1, "MiniBlocks").Remove([]byte("mb4"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), report.LastConsistentNonce)
+
+	result, err := dc.TruncateToLastConsistentBlock(report)
+	require.Nil(t, err)
+	assert.Equal(t, int64(13), result.Round)
+	assert.Equal(t, uint64(3), result.Nonce)
+	assert.Equal(t, []byte("hdr3"), result.Hash)
+	assert.Equal(t, int64(13), ts.highestBootstrapRound(1))
+	assert.Nil(t, ts.epochUnit(1, "BootstrapData").Has([]byte("13")))
+	assert.Nil(t, ts.epochUnit(1, "BootstrapData").Has([]byte(core.TriggerRegistryKeyPrefix+"trigger")))
+
+	assert.Equal(t, 3, result.RemovedBootstrapRounds)
+	for round := 14; round <= 16; round++ {
+		assert.NotNil(t, ts.epochUnit(1, "BootstrapData").Has([]byte(strconv.Itoa(round))))
+	}
+	assert.Equal(t, 3, result.RemovedNonceHashMappings)
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	for nonce := uint64(1); nonce <= 6; nonce++ {
+		err = ts.nonceHashUnit().Has(converter.ToByteSlice(nonce))
+		assert.Equal(t, nonce <= 3, err == nil)
+	}
+	assert.ElementsMatch(t, [][]byte{[]byte("hdr4"), []byte("hdr5"), []byte("hdr6")}, result.OrphanedHeaders)
+}
+
+func TestDatabaseChecker_TruncateToLastConsistentBlockShouldVerifyTheAccountsTrie(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	ts.addAccountsTrie(t)
+	_ = ts.addChain(0, 1, 3, nil)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		round := int64(nonce + 10)
+		ts.addBootstrapRound(0, round, round-1, nonce, []byte(fmt.Sprintf("hdr%d", nonce)))
+	}
+	_ = ts.epochUnit(0, "MiniBlocks").Remove([]byte("mb3"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), report.LastConsistentNonce)
+
+	t.Run("complete trie should truncate", func(t *testing.T) {
+		result, errTruncate := dc.TruncateToLastConsistentBlock(report)
+		require.Nil(t, errTruncate)
+		assert.Equal(t, int64(12), result.Round)
+		assert.Equal(t, int64(12), ts.highestBootstrapRound(0))
+	})
+	t.Run("missing trie node should not truncate", func(t *testing.T) {
+		ts.addBootstrapRound(0, 13, 12, 3, []byte("hdr3"))
+		var removedKey []byte
+		ts.accountsTrieUnit().RangeKeys(func(key []byte, _ []byte) bool {
+			if bytes.Equal(key, ts.rootHash) {
+				return true
+			}
+			removedKey = key
+			return false
+		})
+		require.Nil(t, ts.accountsTrieUnit().Remove(removedKey))
+
+		result, errTruncate := dc.TruncateToLastConsistentBlock(report)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(errTruncate, checker.ErrTrieNotRecreated))
+		assert.Equal(t, int64(13), ts.highestBootstrapRound(0))
+	})
+}
+
+func TestDatabaseChecker_TruncateToLastConsistentBlockConsistentRoundShouldNotChangeStorage(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	_ = ts.addChain(0, 1, 3, nil)
+	ts.addBootstrapRound(0, 12, 11, 2, []byte("hdr2"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+
+	result, err := dc.TruncateToLastConsistentBlock(report)
+	require.Nil(t, err)
+	assert.Equal(t, int64(12), result.Round)
+	assert.Equal(t, 0, result.RemovedNonceHashMappings)
+	assert.Equal(t, int64(12), ts.highestBootstrapRound(0))
+}
+
+func TestDatabaseChecker_TruncateToLastConsistentBlockNoConsistentRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(0)
+	_ = ts.addChain(0, 1, 3, nil)
+	ts.addBootstrapRound(0, 10, 0, 7, []byte("hdr7"))
+	dc, _ := checker.NewDatabaseChecker(ts.createArgs())
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+
+	result, err := dc.TruncateToLastConsistentBlock(report)
+	assert.Nil(t, result)
+	assert.Equal(t, checker.ErrNoConsistentBootstrapRound, err)
+}
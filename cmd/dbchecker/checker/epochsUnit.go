@@ -0,0 +1,74 @@
+package checker
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// epochsUnit groups the persisters of the same unit from all the stored epochs, the newest epoch being the first one
+type epochsUnit struct {
+	persisters []storage.Persister
+}
+
+func (eu *epochsUnit) get(key []byte) ([]byte, error) {
+	var err error
+	for _, persister := range eu.persisters {
+		var value []byte
+		value, err = persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	if err == nil {
+		err = storage.ErrKeyNotFound
+	}
+
+	return nil, err
+}
+
+func (eu *epochsUnit) has(key []byte) bool {
+	for _, persister := range eu.persisters {
+		if persister.Has(key) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// remove removes the key from all the persisters holding it, returning true if it was found in any of them
+func (eu *epochsUnit) remove(key []byte) bool {
+	found := false
+	for _, persister := range eu.persisters {
+		if persister.Has(key) != nil {
+			continue
+		}
+
+		found = true
+		err := persister.Remove(key)
+		if err != nil {
+			log.Warn("cannot remove key", "key", string(key), "error", err)
+		}
+	}
+
+	return found
+}
+
+func (eu *epochsUnit) rangeKeys(handler func(key []byte, val []byte) bool) {
+	for _, persister := range eu.persisters {
+		persister.RangeKeys(handler)
+	}
+}
+
+func (eu *epochsUnit) newest() storage.Persister {
+	return eu.persisters[0]
+}
+
+func (eu *epochsUnit) close() {
+	for _, persister := range eu.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("cannot close persister", "error", err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package checker
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilPersisterFactory signals that a nil persister factory has been provided
+var ErrNilPersisterFactory = errors.New("nil persister factory")
+
+// ErrNilDirectoryReader signals that a nil directory reader has been provided
+var ErrNilDirectoryReader = errors.New("nil directory reader")
+
+// ErrNilLatestStorageDataProvider signals that a nil latest storage data provider has been provided
+var ErrNilLatestStorageDataProvider = errors.New("nil latest storage data provider")
+
+// ErrNoEpochDirectoryFound signals that no epoch directory has been found for the checked shard
+var ErrNoEpochDirectoryFound = errors.New("no epoch directory found")
+
+// ErrNoHeaderFound signals that no header has been found in the checked headers unit
+var ErrNoHeaderFound = errors.New("no header found")
+
+// ErrNilReport signals that a nil report has been provided
+var ErrNilReport = errors.New("nil report")
+
+// ErrNoConsistentBlock signals that the storage does not hold any fully consistent block
+var ErrNoConsistentBlock = errors.New("no consistent block")
+
+// ErrNoConsistentBootstrapRound signals that no bootstrap round pointing to a consistent block has been found
+var ErrNoConsistentBootstrapRound = errors.New("no bootstrap round pointing to a consistent block")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrTrieNotRecreated signals that a state trie cannot be fully recreated from the root hash of the truncation block
+var ErrTrieNotRecreated = errors.New("state trie cannot be recreated")
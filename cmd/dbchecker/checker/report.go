@@ -0,0 +1,80 @@
+package checker
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// IssueType defines the kind of inconsistency found in storage
+type IssueType string
+
+const (
+	// MissingHeader signals a gap in the chain: no header is known for a nonce
+	MissingHeader IssueType = "missing header"
+	// CorruptedHeader signals a header that could not be decoded
+	CorruptedHeader IssueType = "corrupted header"
+	// MissingNonceHashMapping signals a header not referenced by the nonce-hash unit
+	MissingNonceHashMapping IssueType = "missing nonce-hash mapping"
+	// DanglingNonceHashMapping signals a nonce-hash mapping towards a header that is not stored
+	DanglingNonceHashMapping IssueType = "dangling nonce-hash mapping"
+	// BrokenChain signals a header whose previous hash does not point to the header of the previous nonce
+	BrokenChain IssueType = "broken chain"
+	// MissingMiniBlock signals a miniblock referenced by a header that is not stored
+	MissingMiniBlock IssueType = "missing miniblock"
+	// CorruptedMiniBlock signals a miniblock that could not be decoded
+	CorruptedMiniBlock IssueType = "corrupted miniblock"
+	// MissingTransaction signals a transaction referenced by a miniblock that is not stored
+	MissingTransaction IssueType = "missing transaction"
+)
+
+// Issue holds the information about an inconsistency found in storage
+type Issue struct {
+	Type  IssueType
+	Nonce uint64
+	Hash  []byte
+}
+
+// String returns the human readable form of the issue
+func (i *Issue) String() string {
+	return fmt.Sprintf("%s at nonce %d, hash %s", i.Type, i.Nonce, hex.EncodeToString(i.Hash))
+}
+
+// Report holds the result of a storage check
+type Report struct {
+	ShardID             uint32
+	Epochs              []uint32
+	NumHeaders          int
+	LowestNonce         uint64
+	HighestNonce        uint64
+	LastConsistentNonce uint64
+	LastConsistentHash  []byte
+	Issues              []*Issue
+}
+
+// IsConsistent returns true if no issue has been found
+func (r *Report) IsConsistent() bool {
+	return len(r.Issues) == 0
+}
+
+// HasConsistentBlock returns true if at least the lowest stored block is fully consistent
+func (r *Report) HasConsistentBlock() bool {
+	return len(r.LastConsistentHash) > 0
+}
+
+func (r *Report) addIssue(issueType IssueType, nonce uint64, hash []byte) {
+	r.Issues = append(r.Issues, &Issue{
+		Type:  issueType,
+		Nonce: nonce,
+		Hash:  hash,
+	})
+}
+
+// TruncateResult holds the outcome of a storage truncation
+type TruncateResult struct {
+	Round                    int64
+	Nonce                    uint64
+	Hash                     []byte
+	RemovedBootstrapRounds   int
+	RemovedNonceHashMappings int
+	OrphanedHeaders          [][]byte
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/checker"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	workingDir         string
+	chainID            string
+	nodeConfigFilePath string
+	repair             bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirFlag defines a flag for the node's working directory, the one holding the db directory
+	workingDirFlag = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "This string flag specifies the `directory` where the node's db directory is placed",
+		Value:       ".",
+		Destination: &flagsValues.workingDir,
+	}
+
+	// chainIDFlag defines a flag for the chain ID whose database is checked
+	chainIDFlag = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "This string flag specifies the chain ID, the name of the db subdirectory holding the epochs directories",
+		Value:       "1",
+		Destination: &flagsValues.chainID,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// repairFlag defines a flag for truncating the storage back to the last fully consistent block
+	repairFlag = cli.BoolFlag{
+		Name:        "repair",
+		Usage:       "Boolean option for truncating the bootstrap data back to the last fully consistent block, if issues are found",
+		Destination: &flagsValues.repair,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("dbchecker")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startDbChecker()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond DB Checker App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond db checker application is used to check and repair the consistency of a stopped node's storage"
+	cliApp.Flags = []cli.Flag{
+		workingDirFlag,
+		chainIDFlag,
+		nodeConfigFilePathFlag,
+		repairFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startDbChecker() error {
+	log.Info("db checker application started", "version", cliApp.Version)
+
+	nodeConfig := config.Config{}
+	err := core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(nodeConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return err
+	}
+
+	latestStorageDataProvider, err := nodeFactory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		marshalizer,
+		hasher,
+		nodeConfig,
+		flagsValues.chainID,
+		flagsValues.workingDir,
+		nodeFactory.DefaultDBPath,
+		nodeFactory.DefaultEpochString,
+		nodeFactory.DefaultShardString,
+	)
+	if err != nil {
+		return err
+	}
+
+	// all the checked units are opened with the same serial leveldb configuration, as the node does
+	generalDBConfig := config.DBConfig{
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      30000,
+		MaxOpenFiles:      10,
+	}
+
+	databaseChecker, err := checker.NewDatabaseChecker(checker.ArgsDatabaseChecker{
+		GeneralConfig:             nodeConfig,
+		Marshalizer:               marshalizer,
+		Hasher:                    hasher,
+		Uint64Converter:           uint64ByteSlice.NewBigEndianConverter(),
+		PersisterFactory:          factory.NewPersisterFactory(generalDBConfig),
+		DirectoryReader:           factory.NewDirectoryReader(),
+		LatestStorageDataProvider: latestStorageDataProvider,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := databaseChecker.Check()
+	if err != nil {
+		return err
+	}
+
+	printReport(report)
+	if report.IsConsistent() {
+		return nil
+	}
+
+	if !flagsValues.repair {
+		return fmt.Errorf("storage is inconsistent, found %d issue(s). Use the --%s flag to truncate it back to the last consistent block",
+			len(report.Issues), repairFlag.Name)
+	}
+
+	result, err := databaseChecker.TruncateToLastConsistentBlock(report)
+	if err != nil {
+		return err
+	}
+
+	log.Info("storage truncated, the node will bootstrap from the last consistent block",
+		"round", result.Round,
+		"nonce", result.Nonce,
+		"hash", result.Hash,
+		"removed bootstrap rounds", result.RemovedBootstrapRounds,
+		"removed nonce-hash mappings", result.RemovedNonceHashMappings,
+		"orphaned headers", len(result.OrphanedHeaders),
+	)
+	for _, hash := range result.OrphanedHeaders {
+		log.Info("orphaned header left in storage", "hash", hash)
+	}
+
+	return nil
+}
+
+func printReport(report *checker.Report) {
+	for _, issue := range report.Issues {
+		log.Warn(issue.String())
+	}
+
+	log.Info("storage check finished",
+		"shard", core.GetShardIDString(report.ShardID),
+		"epochs", fmt.Sprintf("%v", report.Epochs),
+		"num headers", report.NumHeaders,
+		"lowest nonce", report.LowestNonce,
+		"highest nonce", report.HighestNonce,
+		"last consistent nonce", report.LastConsistentNonce,
+		"last consistent hash", report.LastConsistentHash,
+		"num issues", len(report.Issues),
+	)
+}
@@ -173,6 +173,7 @@ type processComponentsFactoryArgs struct {
 	uint64Converter           typeConverters.Uint64ByteSliceConverter
 	tpsBenchmark              statistics.TPSBenchmark
 	historyRepo               dblookupext.HistoryRepository
+	eventsNotifier            process.EventsNotifier
 	epochNotifier             process.EpochNotifier
 	txSimulatorProcessorArgs  *txsimulator.ArgsTxSimulator
 	storageReolverImportPath  string
@@ -221,6 +222,7 @@ func NewProcessComponentsFactoryArgs(
 	indexer indexer.Indexer,
 	tpsBenchmark statistics.TPSBenchmark,
 	historyRepo dblookupext.HistoryRepository,
+	eventsNotifier process.EventsNotifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	storageReolverImportPath string,
@@ -268,6 +270,7 @@ func NewProcessComponentsFactoryArgs(
 		indexer:                   indexer,
 		tpsBenchmark:              tpsBenchmark,
 		historyRepo:               historyRepo,
+		eventsNotifier:            eventsNotifier,
 		epochNotifier:             epochNotifier,
 		txSimulatorProcessorArgs:  txSimulatorProcessorArgs,
 		storageReolverImportPath:  storageReolverImportPath,
@@ -717,7 +720,7 @@ func indexGenesisBlocks(args *processComponentsFactoryArgs, genesisBlocks map[ui
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -1442,6 +1445,7 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.eventsNotifier,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
@@ -1478,6 +1482,7 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.eventsNotifier,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
@@ -1514,6 +1519,7 @@ func newShardBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	eventsNotifier process.EventsNotifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
@@ -1527,10 +1533,14 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                  gasSchedule,
+		MapDNSAddresses:              mapDNSAddresses,
+		Marshalizer:                  core.InternalMarshalizer,
+		Accounts:                     stateComponents.AccountsAdapter,
+		EpochNotifier:                epochNotifier,
+		ShardCoordinator:             shardCoordinator,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1611,10 +1621,13 @@ func newShardBlockProcessor(
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:              stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:             shardCoordinator,
+		BuiltInFuncNames:             builtInFuncs.Keys(),
+		ArgumentParser:               parsers.NewCallArgsParser(),
+		EpochNotifier:                epochNotifier,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1657,6 +1670,8 @@ func newShardBlockProcessor(
 		BuiltinEnableEpoch:             config.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      config.GeneralSettings.RepairCallbackEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   config.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:             config.GeneralSettings.ESDTNFTEnableEpoch,
 		BadTxForwarder:                 badTxInterim,
 		EpochNotifier:                  epochNotifier,
 	}
@@ -1793,6 +1808,7 @@ func newShardBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		EventsNotifier:          eventsNotifier,
 		EpochNotifier:           epochNotifier,
 		HeaderIntegrityVerifier: headerIntegrityVerifier,
 	}
@@ -1842,6 +1858,7 @@ func newMetaBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	eventsNotifier process.EventsNotifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
@@ -1850,10 +1867,14 @@ func newMetaBlockProcessor(
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                  gasSchedule,
+		MapDNSAddresses:              make(map[string]struct{}), // no dns for meta
+		Marshalizer:                  core.InternalMarshalizer,
+		Accounts:                     stateComponents.AccountsAdapter,
+		EpochNotifier:                epochNotifier,
+		ShardCoordinator:             shardCoordinator,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1890,6 +1911,7 @@ func newMetaBlockProcessor(
 		ValidatorAccountsDB: stateComponents.PeerAccounts,
 		ChanceComputer:      rater,
 		EpochNotifier:       epochNotifier,
+		ESDTNFTEnableEpoch:  generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
 	if err != nil {
@@ -1931,10 +1953,13 @@ func newMetaBlockProcessor(
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:              stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:             shardCoordinator,
+		BuiltInFuncNames:             builtInFuncs.Keys(),
+		ArgumentParser:               parsers.NewCallArgsParser(),
+		EpochNotifier:                epochNotifier,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1977,6 +2002,8 @@ func newMetaBlockProcessor(
 		BuiltinEnableEpoch:             generalConfig.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      generalConfig.GeneralSettings.RepairCallbackEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:             generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 		BadTxForwarder:                 badTxForwarder,
 		EpochNotifier:                  epochNotifier,
 	}
@@ -2212,6 +2239,7 @@ func newMetaBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		EventsNotifier:          eventsNotifier,
 		EpochNotifier:           epochNotifier,
 	}
 
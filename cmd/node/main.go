@@ -29,10 +29,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/closing"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	dbLookupFactory "github.com/ElrondNetwork/elrond-go/core/dblookupext/factory"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
 	"github.com/ElrondNetwork/elrond-go/core/logging"
+	outportFactory "github.com/ElrondNetwork/elrond-go/core/outport/factory"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
@@ -43,8 +45,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool/persistence"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -57,12 +61,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/gasPriceOracle"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -77,6 +83,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/diskusage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
@@ -372,6 +379,15 @@ VERSION:
 		Name:  "import-db-no-sig-check",
 		Usage: "This flag, if set, will cause the signature checks on headers to be skipped. Can be used only if the import-db was previously set",
 	}
+	// importSnapshotFile defines a flag for the optional epoch start snapshot file the node will bootstrap from
+	importSnapshotFile = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag, if set, will make a node without local storage import the epoch start state tries and headers " +
+			"from the provided snapshot `filepath` and continue from that epoch, instead of syncing them from the network. " +
+			"The snapshot has to hold the current epoch start metablock, which is checked against the network peers, and " +
+			"it is only used if the fast bootstrap is enabled",
+		Value: "",
+	}
 )
 
 // appVersion should be populated at build time using ldflags
@@ -439,6 +455,7 @@ func main() {
 		startInEpoch,
 		importDbDirectory,
 		importDbNoSigCheck,
+		importSnapshotFile,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -983,6 +1000,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		HeaderIntegrityVerifier:    headerIntegrityVerifier,
 		TxSignHasher:               coreComponents.TxSignHasher,
 		EpochNotifier:              epochNotifier,
+		ImportSnapshotFilePath:     ctx.GlobalString(importSnapshotFile.Name),
 	}
 	bootstrapper, err := bootstrap.NewEpochStartBootstrap(epochStartBootstrapArgs)
 	if err != nil {
@@ -1186,8 +1204,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	elasticIndexer, err := createElasticIndexer(
-		externalConfig.ElasticSearchConnector,
+	outportHandler, err := createOutport(
+		externalConfig,
 		coreComponents.InternalMarshalizer,
 		coreComponents.Hasher,
 		nodesCoordinator,
@@ -1250,6 +1268,39 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	argsEventsNotifier := eventsNotifier.ArgsEventsNotifier{
+		PubkeyConverter:        addressPubkeyConverter,
+		Marshalizer:            coreComponents.InternalMarshalizer,
+		TxLogsStorer:           dataComponents.Store.GetStorer(dataRetriever.TxLogsUnit),
+		SubscriptionBufferSize: generalConfig.EventsNotifier.SubscriptionBufferSize,
+		NotificationsQueueSize: generalConfig.EventsNotifier.NotificationsQueueSize,
+	}
+	committedBlocksNotifier, err := eventsNotifier.NewEventsNotifier(argsEventsNotifier)
+	if err != nil {
+		return err
+	}
+
+	var gasPricesOracle node.GasPriceOracle = gasPriceOracle.NewDisabledGasPriceOracle()
+	if generalConfig.GasPriceOracle.Enabled {
+		argsGasPriceOracle := gasPriceOracle.ArgsGasPriceOracle{
+			EconomicsHandler: economicsData,
+			TxPool:           dataComponents.Datapool.Transactions(),
+			ShardCoordinator: shardCoordinator,
+			NumBlocksToTrack: generalConfig.GasPriceOracle.NumBlocksToTrack,
+			PoolCapacity:     generalConfig.TxDataPool.Capacity,
+			PoolSizeInBytes:  generalConfig.TxDataPool.SizeInBytes,
+		}
+		enabledGasPriceOracle, errCreate := gasPriceOracle.NewGasPriceOracle(argsGasPriceOracle)
+		if errCreate != nil {
+			return fmt.Errorf("%w when creating the gas price oracle", errCreate)
+		}
+		err = committedBlocksNotifier.RegisterHandler(enabledGasPriceOracle)
+		if err != nil {
+			return err
+		}
+		gasPricesOracle = enabledGasPriceOracle
+	}
+
 	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: addressPubkeyConverter,
 		ShardCoordinator:       shardCoordinator,
@@ -1302,9 +1353,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		importStartHandler,
 		coreComponents.Uint64ByteSliceConverter,
 		workingDir,
-		elasticIndexer,
+		outportHandler,
 		tpsBenchmark,
 		historyRepository,
+		committedBlocksNotifier,
 		epochNotifier,
 		txSimulatorProcessorArgs,
 		ctx.GlobalString(importDbDirectory.Name),
@@ -1351,11 +1403,52 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return fmt.Errorf("%w when adding nodeShufflerOut in hardForkTrigger", err)
 	}
 
-	if !elasticIndexer.IsNilIndexer() {
-		elasticIndexer.SetTxLogsProcessor(processComponents.TxLogsProcessor)
+	if !outportHandler.IsNilIndexer() {
+		outportHandler.SetTxLogsProcessor(processComponents.TxLogsProcessor)
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
 	}
 
+	storageUsageCollector, err := diskusage.NewCollector(diskusage.ArgsCollector{
+		DatabasePath:     filepath.Join(workingDir, factory.DefaultDBPath, genesisNodesConfig.ChainID),
+		EpochDirPrefix:   factory.DefaultEpochString,
+		ShardDirPrefix:   factory.DefaultShardString,
+		StaticDirName:    factory.DefaultStaticDbString,
+		AppStatusHandler: coreComponents.StatusHandler,
+	})
+	if err != nil {
+		return err
+	}
+	if generalConfig.StorageUsage.Enabled {
+		err = storageUsageCollector.StartCollecting(time.Second * time.Duration(generalConfig.StorageUsage.RefreshIntervalInSec))
+		if err != nil {
+			return fmt.Errorf("%w in section [StorageUsage]", err)
+		}
+	}
+
+	txPoolPersister, err := createTxPoolPersister(
+		generalConfig.TxPoolPersistence,
+		stateComponents,
+		dataComponents,
+		shardCoordinator,
+		whiteListRequest,
+		economicsData,
+		coreComponents.InternalMarshalizer,
+	)
+	if err != nil {
+		return err
+	}
+	numRestoredTxs, err := txPoolPersister.Restore()
+	if err != nil {
+		log.Warn("cannot restore the transactions pool", "error", err)
+	}
+	if generalConfig.TxPoolPersistence.Enabled {
+		log.Info("transactions pool restored", "num transactions", numRestoredTxs)
+		err = txPoolPersister.StartCheckpoints(time.Second * time.Duration(generalConfig.TxPoolPersistence.CheckpointIntervalInSec))
+		if err != nil {
+			return fmt.Errorf("%w in section [TxPoolPersistence]", err)
+		}
+	}
+
 	log.Trace("creating node structure")
 	currentNode, err := createNode(
 		generalConfig,
@@ -1371,13 +1464,14 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		nodesCoordinator,
 		coreComponents,
 		stateComponents,
+		triesComponents.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
 		dataComponents,
 		cryptoComponents,
 		processComponents,
 		networkComponents,
 		ctx.GlobalUint64(bootstrapRoundIndex.Name),
 		version,
-		elasticIndexer,
+		outportHandler,
 		requestedItemsHandler,
 		epochStartNotifier,
 		whiteListRequest,
@@ -1386,6 +1480,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		hardForkTrigger,
 		historyRepository,
 		fallbackHeaderValidator,
+		storageUsageCollector,
+		gasPricesOracle,
 		isInImportMode,
 	)
 	if err != nil {
@@ -1402,7 +1498,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		log.Trace("activating nodesCoordinator's validators indexing")
-		indexValidatorsListIfNeeded(elasticIndexer, nodesCoordinator, processComponents.EpochStartTrigger.Epoch(), log)
+		indexValidatorsListIfNeeded(outportHandler, nodesCoordinator, processComponents.EpochStartTrigger.Epoch(), log)
 	}
 
 	log.Trace("creating api resolver structure")
@@ -1410,6 +1506,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	apiResolver, err := createApiResolver(
 		generalConfig,
 		stateComponents.AccountsAdapter,
+		triesComponents.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
 		stateComponents.PeerAccounts,
 		stateComponents.AddressPubkeyConverter,
 		dataComponents.Store,
@@ -1468,6 +1565,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ApiRoutesConfig: *apiRoutesConfig,
 		AccountsState:   stateComponents.AccountsAdapter,
 		PeerState:       stateComponents.PeerAccounts,
+		EventsNotifier:  committedBlocksNotifier,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -1501,7 +1599,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, outportHandler, committedBlocksNotifier, storageUsageCollector, txPoolPersister, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1560,9 +1658,54 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 	storageConfig.DB.MaxBatchSize = storageConfig.DB.MaxBatchSize * int(alterCoefficient)
 }
 
+func createTxPoolPersister(
+	txPoolPersistenceConfig config.TxPoolPersistenceConfig,
+	stateComponents *mainFactory.StateComponents,
+	dataComponents *mainFactory.DataComponents,
+	shardCoordinator sharding.Coordinator,
+	whiteListRequest process.WhiteListHandler,
+	economicsData process.FeeHandler,
+	marshalizer marshal.Marshalizer,
+) (persistence.PoolPersister, error) {
+	if !txPoolPersistenceConfig.Enabled {
+		return persistence.NewDisabledPoolPersister(), nil
+	}
+
+	txPool, ok := dataComponents.Datapool.Transactions().(persistence.TxPool)
+	if !ok {
+		return nil, fmt.Errorf("%w for the transactions pool persistence", process.ErrWrongTypeAssertion)
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		stateComponents.AccountsAdapter,
+		shardCoordinator,
+		whiteListRequest,
+		stateComponents.AddressPubkeyConverter,
+		core.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return persistence.NewPoolPersister(persistence.ArgsPoolPersister{
+		TxPool:           txPool,
+		Storer:           dataComponents.Store.GetStorer(dataRetriever.TxPoolUnit),
+		Marshalizer:      marshalizer,
+		TxValidator:      txValidator,
+		FeeHandler:       economicsData,
+		ShardCoordinator: shardCoordinator,
+		MaxNumTxs:        txPoolPersistenceConfig.MaxNumTxs,
+		MaxNumBytes:      txPoolPersistenceConfig.MaxNumBytes,
+	})
+}
+
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	outportHandler io.Closer,
+	committedBlocksNotifier io.Closer,
+	storageUsageCollector io.Closer,
+	txPoolPersister io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1572,6 +1715,22 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing outport drivers...")
+	err = outportHandler.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing committed blocks notifier...")
+	err = committedBlocksNotifier.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing storage usage collector...")
+	err = storageUsageCollector.Close()
+	log.LogIfError(err)
+
+	log.Debug("saving the transactions pool...")
+	err = txPoolPersister.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1689,13 +1848,13 @@ func getWorkingDir(ctx *cli.Context, log logger.Logger) string {
 }
 
 func indexValidatorsListIfNeeded(
-	elasticIndexer indexer.Indexer,
+	outportHandler indexer.Indexer,
 	coordinator sharding.NodesCoordinator,
 	epoch uint32,
 	log logger.Logger,
 
 ) {
-	if check.IfNil(elasticIndexer) {
+	if check.IfNil(outportHandler) {
 		return
 	}
 
@@ -1705,7 +1864,7 @@ func indexValidatorsListIfNeeded(
 	}
 
 	if len(validatorsPubKeys) > 0 {
-		elasticIndexer.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+		outportHandler.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
 	}
 }
 
@@ -2008,10 +2167,10 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 	return uint32(val), err
 }
 
-// createElasticIndexer creates a new elasticIndexer where the server listens on the url,
-// authentication for the server is using the username and password
-func createElasticIndexer(
-	elasticSearchConfig config.ElasticSearchConfig,
+// createOutport creates the outport component and subscribes all the enabled drivers: the elasticIndexer, where the server
+// listens on the url and the authentication is done using the username and password, and the newline delimited JSON file driver
+func createOutport(
+	externalConfig *config.ExternalConfig,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -2025,7 +2184,7 @@ func createElasticIndexer(
 	isInImportDBMode bool,
 	elasticSearchTemplatesPath string,
 ) (indexer.Indexer, error) {
-
+	elasticSearchConfig := externalConfig.ElasticSearchConnector
 	indexerFactoryArgs := &indexerFactory.ArgsIndexerFactory{
 		Enabled:                  elasticSearchConfig.Enabled,
 		IndexerCacheSize:         elasticSearchConfig.IndexerCacheSize,
@@ -2050,7 +2209,15 @@ func createElasticIndexer(
 		IsInImportDBMode: isInImportDBMode,
 	}
 
-	return indexerFactory.NewIndexer(indexerFactoryArgs)
+	outportFactoryArgs := &outportFactory.ArgsOutportFactory{
+		ElasticIndexerFactoryArgs: indexerFactoryArgs,
+		FileDriverFactoryArgs: outportFactory.ArgsFileDriverFactory{
+			Enabled:  externalConfig.FileOutportDriver.Enabled,
+			FilePath: externalConfig.FileOutportDriver.FilePath,
+		},
+	}
+
+	return outportFactory.CreateOutport(outportFactoryArgs)
 }
 func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sharding.Coordinator) (uint32, error) {
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -2184,6 +2351,7 @@ func createNode(
 	nodesCoordinator sharding.NodesCoordinator,
 	coreData *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
+	userAccountsTrie data.Trie,
 	data *mainFactory.DataComponents,
 	crypto *mainFactory.CryptoComponents,
 	process *factory.Process,
@@ -2199,6 +2367,8 @@ func createNode(
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository dblookupext.HistoryRepository,
 	fallbackHeaderValidator consensus.FallbackHeaderValidator,
+	storageUsageCollector node.StorageUsageCollector,
+	gasPriceOracle node.GasPriceOracle,
 	isInImportDbMode bool,
 ) (*node.Node, error) {
 	var err error
@@ -2271,6 +2441,7 @@ func createNode(
 		node.WithAddressPubkeyConverter(stateComponents.AddressPubkeyConverter),
 		node.WithValidatorPubkeyConverter(stateComponents.ValidatorPubkeyConverter),
 		node.WithAccountsAdapter(stateComponents.AccountsAdapter),
+		node.WithAccountsTrie(userAccountsTrie),
 		node.WithBlockChain(data.Blkc),
 		node.WithDataStore(data.Store),
 		node.WithRoundDuration(nodesConfig.RoundDuration),
@@ -2314,6 +2485,8 @@ func createNode(
 		node.WithInputAntifloodHandler(network.InputAntifloodHandler),
 		node.WithTxAccumulator(txAccumulator),
 		node.WithHardforkTrigger(hardForkTrigger),
+		node.WithStorageUsageCollector(storageUsageCollector),
+		node.WithGasPriceOracle(gasPriceOracle),
 		node.WithWhiteListHandler(whiteListRequest),
 		node.WithWhiteListHandlerVerified(whiteListerVerifiedTxs),
 		node.WithAddressSignatureSize(config.AddressPubkeyConverter.SignatureLength),
@@ -2425,6 +2598,7 @@ func startStatisticsMonitor(
 func createApiResolver(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2446,7 +2620,7 @@ func createApiResolver(
 ) (facade.ApiResolver, error) {
 	scQueryService, err := createScQueryService(
 		generalConfig,
-		accnts,
+		userAccountsTrie,
 		validatorAccounts,
 		pubkeyConv,
 		storageService,
@@ -2473,16 +2647,23 @@ func createApiResolver(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		epochNotifier,
+		shardCoordinator,
+		generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:              pubkeyConv,
+		ShardCoordinator:             shardCoordinator,
+		BuiltInFuncNames:             builtInFuncs.Keys(),
+		ArgumentParser:               parsers.NewCallArgsParser(),
+		EpochNotifier:                epochNotifier,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -2511,7 +2692,7 @@ func createApiResolver(
 //TODO refactor this code when moving into feat/soft-restart. Maybe use arguments instead of endless parameter lists
 func createScQueryService(
 	generalConfig *config.Config,
-	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2539,7 +2720,7 @@ func createScQueryService(
 	for i := 0; i < numConcurrentVms; i++ {
 		scQueryService, err := createScQueryElement(
 			generalConfig,
-			accnts,
+			userAccountsTrie,
 			validatorAccounts,
 			pubkeyConv,
 			storageService,
@@ -2577,7 +2758,7 @@ func createScQueryService(
 
 func createScQueryElement(
 	generalConfig *config.Config,
-	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2600,10 +2781,19 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	queryAccounts, err := createQueryAccountsAdapter(userAccountsTrie, hasher, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncs, err := createBuiltinFuncs(
 		gasScheduleNotifier,
 		marshalizer,
-		accnts,
+		queryAccounts,
+		epochNotifier,
+		shardCoordinator,
+		generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -2618,7 +2808,7 @@ func createScQueryElement(
 	scStorage := generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           accnts,
+		Accounts:           queryAccounts,
 		PubkeyConv:         pubkeyConv,
 		StorageService:     storageService,
 		BlockChain:         blockChain,
@@ -2646,6 +2836,7 @@ func createScQueryElement(
 			ValidatorAccountsDB: validatorAccounts,
 			ChanceComputer:      rater,
 			EpochNotifier:       epochNotifier,
+			ESDTNFTEnableEpoch:  generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
@@ -2677,19 +2868,58 @@ func createScQueryElement(
 		return nil, err
 	}
 
-	return smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	return smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:              vmContainer,
+		EconomicsFee:             economics,
+		BlockChainHook:           vmFactory.BlockChainHookImpl(),
+		BlockChain:               blockChain,
+		Accounts:                 queryAccounts,
+		AccountsTrie:             userAccountsTrie,
+		StorageService:           storageService,
+		Marshalizer:              marshalizer,
+		Uint64ByteSliceConverter: uint64Converter,
+		ShardID:                  shardCoordinator.SelfId(),
+	})
+}
+
+// createQueryAccountsAdapter creates an accounts adapter dedicated to a sc query service. It shares the trie storage
+// with the processing accounts adapter but its trie can be recreated on any available state without affecting the
+// processing
+func createQueryAccountsAdapter(
+	userAccountsTrie data.Trie,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+) (state.AccountsAdapter, error) {
+	if check.IfNil(userAccountsTrie) {
+		return nil, state.ErrNilTrie
+	}
+
+	queryTrie, err := userAccountsTrie.Recreate(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(queryTrie, hasher, marshalizer, stateFactory.NewAccountCreator())
 }
 
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
+	epochNotifier process.EpochNotifier,
+	shardCoordinator sharding.Coordinator,
+	esdtMultiTransferEnableEpoch uint32,
+	esdtNFTEnableEpoch uint32,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:                  gasScheduleNotifier,
+		MapDNSAddresses:              make(map[string]struct{}),
+		Marshalizer:                  marshalizer,
+		Accounts:                     accnts,
+		EpochNotifier:                epochNotifier,
+		ShardCoordinator:             shardCoordinator,
+		ESDTMultiTransferEnableEpoch: esdtMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           esdtNFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type snapshotExporter interface {
+	Export(w io.Writer) error
+}
+
+type flags struct {
+	dbPath             string
+	nodeConfigFilePath string
+	shard              string
+	numShards          uint
+	epoch              uint
+	outputFilePath     string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPathFlag defines a flag for setting the db path where the node's databases are held in
+	dbPathFlag = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "This string flag specifies the path for the database directory, the chain ID directory",
+		Value:       "db",
+		Destination: &flagsValues.dbPath,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// shardFlag defines a flag for the shard whose state is exported
+	shardFlag = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "This string flag specifies the shard of the exported state. Can be a shard number or metachain",
+		Value:       "0",
+		Destination: &flagsValues.shard,
+	}
+
+	// numShardsFlag defines a flag for the number of shards of the network
+	numShardsFlag = cli.UintFlag{
+		Name:        "num-shards",
+		Usage:       "This uint flag specifies the number of shards of the network, metachain excluded",
+		Value:       3,
+		Destination: &flagsValues.numShards,
+	}
+
+	// epochFlag defines a flag for the epoch whose start is exported
+	epochFlag = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "This uint flag specifies the epoch whose epoch start block and state tries are exported",
+		Destination: &flagsValues.epoch,
+	}
+
+	// outputFilePathFlag defines a flag for the file the snapshot is written to
+	outputFilePathFlag = cli.StringFlag{
+		Name:        "output",
+		Usage:       "This string flag specifies the `filepath` the snapshot is written to",
+		Value:       "snapshot.bin",
+		Destination: &flagsValues.outputFilePath,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("snapshotexporter")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startExport()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond Snapshot Exporter App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond snapshot exporter application is used to export the epoch start data of a stopped node, " +
+		"to be imported by new nodes with the --import-snapshot flag"
+	cliApp.Flags = []cli.Flag{
+		dbPathFlag,
+		nodeConfigFilePathFlag,
+		shardFlag,
+		numShardsFlag,
+		epochFlag,
+		outputFilePathFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startExport() error {
+	log.Info("snapshot exporter application started", "version", cliApp.Version)
+
+	nodeConfig := config.Config{}
+	err := core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(nodeConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	if !core.DoesFileExist(flagsValues.dbPath) {
+		return fmt.Errorf("no db directory found. Path: %s", flagsValues.dbPath)
+	}
+
+	shardID, err := core.ConvertShardIDToUint32(flagsValues.shard)
+	if err != nil {
+		return err
+	}
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(flagsValues.numShards), shardID)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager()
+	if err != nil {
+		return err
+	}
+
+	epoch := uint32(flagsValues.epoch)
+	storageService, err := createStorageService(nodeConfig, shardCoordinator, pathManager, epoch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := storageService.CloseAll()
+		log.LogIfError(errClose)
+	}()
+
+	userAccountsTrie, peerAccountsTrie, err := createTries(nodeConfig, marshalizer, hasher, pathManager, shardID)
+	if err != nil {
+		return err
+	}
+	defer closeTrie(userAccountsTrie)
+	defer closeTrie(peerAccountsTrie)
+
+	exporter, err := snapshot.NewExporter(snapshot.ArgsExporter{
+		Marshalizer:      marshalizer,
+		StorageService:   storageService,
+		UserAccountsTrie: userAccountsTrie,
+		PeerAccountsTrie: peerAccountsTrie,
+		ShardID:          shardID,
+		Epoch:            epoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportToFile(exporter)
+}
+
+func createPathManager() (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		flagsValues.dbPath,
+		fmt.Sprintf("%s_%s", "Epoch", core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", "Shard", core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		flagsValues.dbPath,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", "Shard", core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
+
+func createStorageService(
+	nodeConfig config.Config,
+	shardCoordinator sharding.Coordinator,
+	pathManager *pathmanager.PathManager,
+	epoch uint32,
+) (dataRetriever.StorageService, error) {
+	storageServiceCreator, err := storageFactory.NewStorageServiceFactory(
+		&nodeConfig,
+		shardCoordinator,
+		pathManager,
+		&disabled.EpochStartNotifier{},
+		epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageServiceCreator.CreateForMeta()
+	}
+
+	return storageServiceCreator.CreateForShard()
+}
+
+func createTries(
+	nodeConfig config.Config,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	pathManager *pathmanager.PathManager,
+	shardID uint32,
+) (data.Trie, data.Trie, error) {
+	trieFactoryArgs := factory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   nodeConfig.EvictionWaitingList,
+		SnapshotDbCfg:            nodeConfig.TrieSnapshotDB,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: nodeConfig.TrieStorageManagerConfig,
+	}
+	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// pruning is disabled so that nothing gets removed from the exported databases
+	_, userAccountsTrie, err := trieFactory.Create(
+		nodeConfig.AccountsTrieStorage,
+		core.GetShardIDString(shardID),
+		false,
+		nodeConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, peerAccountsTrie, err := trieFactory.Create(
+		nodeConfig.PeerAccountsTrieStorage,
+		core.GetShardIDString(shardID),
+		false,
+		nodeConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+	)
+	if err != nil {
+		closeTrie(userAccountsTrie)
+		return nil, nil, err
+	}
+
+	return userAccountsTrie, peerAccountsTrie, nil
+}
+
+func exportToFile(exporter snapshotExporter) error {
+	file, err := os.Create(flagsValues.outputFilePath)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	err = exporter.Export(writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Info("snapshot exported",
+		"epoch", flagsValues.epoch,
+		"shard", flagsValues.shard,
+		"file", flagsValues.outputFilePath,
+	)
+
+	return nil
+}
+
+func closeTrie(tr data.Trie) {
+	err := tr.ClosePersister()
+	if err != nil {
+		log.Warn("could not close the trie persister", "error", err)
+	}
+}
@@ -0,0 +1,494 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrieType = "accounts"
+	peerTrieType     = "peer"
+)
+
+type flags struct {
+	dbPath             string
+	nodeConfigFilePath string
+	shard              string
+	trieType           string
+	rootHash           string
+	address            string
+	limit              int
+	withDataTries      bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPathFlag defines a flag for setting the db path where the node's databases are held in
+	dbPathFlag = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "This string flag specifies the path for the database directory, the chain ID directory",
+		Value:       "db",
+		Destination: &flagsValues.dbPath,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// shardFlag defines a flag for the shard whose trie is inspected
+	shardFlag = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "This string flag specifies the shard of the inspected trie. Can be a shard number or metachain",
+		Value:       "0",
+		Destination: &flagsValues.shard,
+	}
+
+	// trieTypeFlag defines a flag for the type of the inspected trie
+	trieTypeFlag = cli.StringFlag{
+		Name:        "trie",
+		Usage:       "This string flag specifies the inspected trie. Can be " + accountsTrieType + " or " + peerTrieType,
+		Value:       accountsTrieType,
+		Destination: &flagsValues.trieType,
+	}
+
+	// rootHashFlag defines a flag for the root hash the trie is loaded from
+	rootHashFlag = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "This string flag specifies the hex encoded root hash the trie is loaded from",
+		Destination: &flagsValues.rootHash,
+	}
+
+	// addressFlag defines a flag for the account whose data trie is dumped
+	addressFlag = cli.StringFlag{
+		Name:        "address",
+		Usage:       "This string flag specifies the address of the account whose data trie is dumped",
+		Destination: &flagsValues.address,
+	}
+
+	// limitFlag defines a flag for the maximum number of listed accounts
+	limitFlag = cli.IntFlag{
+		Name:        "limit",
+		Usage:       "This int flag specifies the maximum number of listed accounts. 0 means no limit",
+		Value:       0,
+		Destination: &flagsValues.limit,
+	}
+
+	// withDataTriesFlag defines a flag for also verifying the accounts' data tries
+	withDataTriesFlag = cli.BoolFlag{
+		Name:        "with-data-tries",
+		Usage:       "Boolean option for also verifying the data tries of all accounts. Only used for the accounts trie",
+		Destination: &flagsValues.withDataTries,
+	}
+
+	flagsValues = &flags{}
+
+	log                    = logger.GetOrCreate("trieinspector")
+	cliApp                 *cli.App
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	nodeConfig             config.Config
+	addressPubKeyConverter core.PubkeyConverter
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond Trie Inspector App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond trie inspector application is used to inspect the state tries of a stopped node's database"
+	cliApp.Flags = []cli.Flag{
+		dbPathFlag,
+		nodeConfigFilePathFlag,
+		shardFlag,
+		trieTypeFlag,
+		rootHashFlag,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "stats",
+			Usage:  "prints the depth and size statistics of the trie",
+			Action: statsAction,
+		},
+		{
+			Name:   "verify",
+			Usage:  "verifies that every node referenced by the trie exists and is valid",
+			Flags:  []cli.Flag{withDataTriesFlag},
+			Action: verifyAction,
+		},
+		{
+			Name:   "accounts",
+			Usage:  "lists the accounts held by the accounts trie",
+			Flags:  []cli.Flag{limitFlag},
+			Action: accountsAction,
+		},
+		{
+			Name:   "account",
+			Usage:  "dumps the data trie of the provided account",
+			Flags:  []cli.Flag{addressFlag},
+			Action: accountAction,
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func statsAction(_ *cli.Context) error {
+	tr, rootHash, err := loadTrie()
+	if err != nil {
+		return err
+	}
+	defer closeTrie(tr)
+
+	result, err := trie.Inspect(context.Background(), rootHash, tr.Database(), marshalizer, hasher, nil)
+	if err != nil {
+		return err
+	}
+
+	printStatistics(rootHash, result)
+
+	return nil
+}
+
+func verifyAction(_ *cli.Context) error {
+	tr, rootHash, err := loadTrie()
+	if err != nil {
+		return err
+	}
+	defer closeTrie(tr)
+
+	verifyDataTries := flagsValues.withDataTries && flagsValues.trieType == accountsTrieType
+	dataTriesRootHashes := make([][]byte, 0)
+	leafHandler := func(value []byte) {
+		if !verifyDataTries {
+			return
+		}
+
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Warn("could not unmarshal account", "error", errUnmarshal)
+			return
+		}
+		if len(account.RootHash) > 0 {
+			dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+		}
+	}
+
+	result, err := trie.Inspect(context.Background(), rootHash, tr.Database(), marshalizer, hasher, leafHandler)
+	if err != nil {
+		return err
+	}
+
+	printStatistics(rootHash, result)
+	isComplete := printIssues(result)
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrieResult, errInspect := trie.Inspect(context.Background(), dataTrieRootHash, tr.Database(), marshalizer, hasher, nil)
+		if errInspect != nil {
+			return errInspect
+		}
+
+		if !dataTrieResult.IsComplete() {
+			fmt.Printf("data trie %s:\n", hex.EncodeToString(dataTrieRootHash))
+			isComplete = printIssues(dataTrieResult) && isComplete
+		}
+	}
+	if verifyDataTries {
+		fmt.Printf("verified data tries: %d\n", len(dataTriesRootHashes))
+	}
+
+	if !isComplete {
+		return fmt.Errorf("trie with root hash %s is incomplete", hex.EncodeToString(rootHash))
+	}
+
+	fmt.Println("trie is complete")
+
+	return nil
+}
+
+func accountsAction(_ *cli.Context) error {
+	if flagsValues.trieType != accountsTrieType {
+		return fmt.Errorf("accounts can only be listed for the %s trie", accountsTrieType)
+	}
+
+	tr, rootHash, err := loadTrie()
+	if err != nil {
+		return err
+	}
+	defer closeTrie(tr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	numAccounts := 0
+	for leaf := range leavesChannel {
+		if flagsValues.limit > 0 && numAccounts >= flagsValues.limit {
+			break
+		}
+
+		account := state.NewEmptyUserAccount()
+		err = marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s nonce: %d balance: %s root hash: %s\n",
+			addressPubKeyConverter.Encode(leaf.Key()),
+			account.Nonce,
+			account.Balance.String(),
+			hex.EncodeToString(account.RootHash),
+		)
+		numAccounts++
+	}
+
+	fmt.Printf("listed accounts: %d\n", numAccounts)
+
+	return nil
+}
+
+func accountAction(_ *cli.Context) error {
+	if flagsValues.trieType != accountsTrieType {
+		return fmt.Errorf("data tries can only be dumped for the %s trie", accountsTrieType)
+	}
+
+	address, err := addressPubKeyConverter.Decode(flagsValues.address)
+	if err != nil {
+		return fmt.Errorf("%w for address %s", err, flagsValues.address)
+	}
+
+	tr, rootHash, err := loadTrie()
+	if err != nil {
+		return err
+	}
+	defer closeTrie(tr)
+
+	accountsTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	accountBytes, err := accountsTrie.Get(address)
+	if err != nil {
+		return err
+	}
+	if len(accountBytes) == 0 {
+		return fmt.Errorf("account %s not found", flagsValues.address)
+	}
+
+	account := state.NewEmptyUserAccount()
+	err = marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("account %s nonce: %d balance: %s data trie root hash: %s\n",
+		flagsValues.address,
+		account.Nonce,
+		account.Balance.String(),
+		hex.EncodeToString(account.RootHash),
+	)
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(account.RootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	numKeys := 0
+	for leaf := range leavesChannel {
+		value := leaf.Value()
+		tailLength := len(leaf.Key()) + len(address)
+		if len(value) >= tailLength {
+			value = value[:len(value)-tailLength]
+		}
+
+		fmt.Printf("%s: %s\n", hex.EncodeToString(leaf.Key()), hex.EncodeToString(value))
+		numKeys++
+	}
+
+	fmt.Printf("dumped keys: %d\n", numKeys)
+
+	return nil
+}
+
+func loadTrie() (data.Trie, []byte, error) {
+	err := core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	marshalizer, err = marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	hasher, err = hasherFactory.NewHasher(nodeConfig.Hasher.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	addressPubKeyConverter, err = stateFactory.NewPubkeyConverter(nodeConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHash, err := hex.DecodeString(flagsValues.rootHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for root hash %s", err, flagsValues.rootHash)
+	}
+	if len(rootHash) == 0 {
+		return nil, nil, fmt.Errorf("no root hash provided, use the --%s flag", rootHashFlag.Name)
+	}
+
+	if !core.DoesFileExist(flagsValues.dbPath) {
+		return nil, nil, fmt.Errorf("no db directory found. Path: %s", flagsValues.dbPath)
+	}
+
+	storageConfig, err := getTrieStorageConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pathTemplateForPruningStorer := filepath.Join(
+		flagsValues.dbPath,
+		fmt.Sprintf("%s_%s", "Epoch", core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", "Shard", core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		flagsValues.dbPath,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", "Shard", core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+	pathManager, err := pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trieFactoryArgs := factory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   nodeConfig.EvictionWaitingList,
+		SnapshotDbCfg:            nodeConfig.TrieSnapshotDB,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: nodeConfig.TrieStorageManagerConfig,
+	}
+	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// pruning is disabled so that nothing gets removed from the inspected database
+	_, tr, err := trieFactory.Create(
+		storageConfig,
+		flagsValues.shard,
+		false,
+		nodeConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tr, rootHash, nil
+}
+
+func getTrieStorageConfig() (config.StorageConfig, error) {
+	switch flagsValues.trieType {
+	case accountsTrieType:
+		return nodeConfig.AccountsTrieStorage, nil
+	case peerTrieType:
+		return nodeConfig.PeerAccountsTrieStorage, nil
+	default:
+		return config.StorageConfig{}, fmt.Errorf("unknown trie type %s", flagsValues.trieType)
+	}
+}
+
+func closeTrie(tr data.Trie) {
+	err := tr.ClosePersister()
+	if err != nil {
+		log.Warn("could not close the trie persister", "error", err)
+	}
+}
+
+func printStatistics(rootHash []byte, result *trie.InspectionResult) {
+	fmt.Printf("root hash: %s\n", hex.EncodeToString(rootHash))
+	fmt.Printf("branch nodes: %d\n", result.NumBranchNodes)
+	fmt.Printf("extension nodes: %d\n", result.NumExtensionNodes)
+	fmt.Printf("leaf nodes: %d\n", result.NumLeafNodes)
+	fmt.Printf("total size: %s\n", core.ConvertBytes(result.TotalSizeInBytes))
+	fmt.Printf("max depth: %d\n", result.MaxDepth)
+}
+
+func printIssues(result *trie.InspectionResult) bool {
+	for _, hash := range result.MissingNodes {
+		fmt.Printf("missing node: %s\n", hex.EncodeToString(hash))
+	}
+	for _, hash := range result.CorruptedNodes {
+		fmt.Printf("corrupted node: %s\n", hex.EncodeToString(hash))
+	}
+
+	return result.IsComplete()
+}
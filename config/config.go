@@ -9,6 +9,11 @@ type CacheConfig struct {
 	SizeInBytes          uint64
 	SizeInBytesPerSender uint32
 	Shards               uint32
+	// MinGasPriceBumpPercentForReplacement is the minimum gas price increase (in percents) a transaction must have
+	// in order to replace a pending transaction with the same sender and nonce. Zero disables the replacement.
+	MinGasPriceBumpPercentForReplacement uint32
+	// SelectionPolicy is the transactions selection policy: "score" (default), "gasPrice" or "roundRobin"
+	SelectionPolicy string
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -154,6 +159,8 @@ type Config struct {
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
+	StorageUsage        StorageUsageConfig
+	TxPoolPersistence   TxPoolPersistenceConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -169,6 +176,20 @@ type Config struct {
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
+	EventsNotifier        EventsNotifierConfig
+	GasPriceOracle        GasPriceOracleConfig
+}
+
+// EventsNotifierConfig will hold settings related to the committed blocks events subscriptions
+type EventsNotifierConfig struct {
+	SubscriptionBufferSize int
+	NotificationsQueueSize int
+}
+
+// GasPriceOracleConfig will hold settings related to the gas prices suggestions
+type GasPriceOracleConfig struct {
+	Enabled          bool
+	NumBlocksToTrack int
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -184,6 +205,21 @@ type StoragePruningConfig struct {
 	NumActivePersisters uint64
 }
 
+// StorageUsageConfig will hold the settings related to the storage disk usage reporting
+type StorageUsageConfig struct {
+	Enabled              bool
+	RefreshIntervalInSec int
+}
+
+// TxPoolPersistenceConfig will hold the settings related to persisting the transactions pool across node restarts
+type TxPoolPersistenceConfig struct {
+	Enabled                 bool
+	CheckpointIntervalInSec int
+	MaxNumTxs               uint32
+	MaxNumBytes             uint64
+	StorageConfig           StorageConfig
+}
+
 // ResourceStatsConfig will hold all resource stats settings
 type ResourceStatsConfig struct {
 	Enabled              bool
@@ -229,6 +265,8 @@ type GeneralSettingsConfig struct {
 	AheadOfTimeGasUsageEnableEpoch         uint32
 	GasPriceModifierEnableEpoch            uint32
 	RepairCallbackEnableEpoch              uint32
+	ESDTMultiTransferEnableEpoch           uint32
+	ESDTNFTEnableEpoch                     uint32
 	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
@@ -254,6 +292,7 @@ type TrieStorageManagerConfig struct {
 	PruningBufferLen   uint32
 	SnapshotsBufferLen uint32
 	MaxSnapshots       uint32
+	NumRetainedRoots   uint32
 }
 
 // EndpointsThrottlersConfig holds a pair of an endpoint and its maximum number of simultaneous go routines
@@ -381,10 +420,12 @@ type HardforkConfig struct {
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
+	TxHashesByAddressIndexEnabled      bool
 	MiniblocksMetadataStorageConfig    StorageConfig
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	TxHashesByAddressStorageConfig     StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -423,9 +464,33 @@ type AntifloodDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
+	GRPC        GRPCConfig
+	APIKeys     APIKeysConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// APIKeysConfig holds the configuration for the optional API keys authentication
+type APIKeysConfig struct {
+	Enabled                 bool
+	QuotaResetIntervalInSec uint32
+	ProtectedRoutes         []string
+	Keys                    []APIKeyConfig
+}
+
+// APIKeyConfig holds the configuration of a single API key
+type APIKeyConfig struct {
+	Name                   string
+	Key                    string
+	AllowedRoutes          []string
+	MaxRequestsPerInterval uint32
+}
+
+// GRPCConfig holds the configuration for the optional gRPC server
+type GRPCConfig struct {
+	Enabled   bool
+	Interface string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
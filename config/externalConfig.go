@@ -3,6 +3,7 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	FileOutportDriver      FileOutportDriverConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -15,3 +16,9 @@ type ElasticSearchConfig struct {
 	Password         string
 	EnabledIndexes   []string
 }
+
+// FileOutportDriverConfig will hold the configuration for the newline delimited JSON file outport driver
+type FileOutportDriverConfig struct {
+	Enabled  bool
+	FilePath string
+}
@@ -223,6 +223,10 @@ func TestTomlExternalParser(t *testing.T) {
 			Username: elasticUsername,
 			Password: elasticPassword,
 		},
+		FileOutportDriver: FileOutportDriverConfig{
+			Enabled:  true,
+			FilePath: "outport.ndjson",
+		},
 	}
 
 	testString := `
@@ -230,7 +234,11 @@ func TestTomlExternalParser(t *testing.T) {
     Enabled = true
     URL = "` + indexerURL + `"
     Username = "` + elasticUsername + `"
-    Password = "` + elasticPassword + `"`
+    Password = "` + elasticPassword + `"
+
+[FileOutportDriver]
+    Enabled = true
+    FilePath = "outport.ndjson"`
 
 	cfg := ExternalConfig{}
 
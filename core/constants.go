@@ -357,6 +357,13 @@ const MetricTotalFees = "erd_total_fees"
 // MetricEpochForEconomicsData holds the epoch for which economics data are computed
 const MetricEpochForEconomicsData = "erd_epoch_for_economics_data"
 
+// MetricStorageTotalSize holds the disk space in bytes used by all the databases of the node
+const MetricStorageTotalSize = "erd_storage_total_size"
+
+// MetricStorageSizePrefix is the prefix of the metrics holding the disk space in bytes used by the databases of the
+// node, per shard, per epoch and per storage unit
+const MetricStorageSizePrefix = "erd_storage_size"
+
 // LastNonceKeyMetricsStorage holds the key used for storing the last nonce for stored metrics
 const LastNonceKeyMetricsStorage = "lastNonce"
 
@@ -463,6 +470,9 @@ const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
 // BuiltInFunctionESDTTransfer is the key for the elrond standard digital token transfer built-in function
 const BuiltInFunctionESDTTransfer = "ESDTTransfer"
 
+// BuiltInFunctionESDTMultiTransfer is the key for the elrond standard digital token multi transfer built-in function
+const BuiltInFunctionESDTMultiTransfer = "ESDTMultiTransfer"
+
 // BuiltInFunctionESDTBurn is the key for the elrond standard digital token burn built-in function
 const BuiltInFunctionESDTBurn = "ESDTBurn"
 
@@ -481,6 +491,42 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionSetESDTRole is the key for the elrond standard digital token set special role built-in function
+const BuiltInFunctionSetESDTRole = "ESDTSetRole"
+
+// BuiltInFunctionUnSetESDTRole is the key for the elrond standard digital token unset special role built-in function
+const BuiltInFunctionUnSetESDTRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// ESDTRoleNFTCreate is the constant string for the local role of create for ESDT tokens
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the constant string for the local role of adding quantity for existing ESDT tokens
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the constant string for the local role of burn for ESDT tokens
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
+// FungibleESDT defines the string for the token type of fungible ESDT
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT defines the string for the token type of non fungible ESDT
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT defines the string for the token type of semi fungible ESDT
+const SemiFungibleESDT = "SemiFungibleESDT"
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
@@ -508,6 +554,18 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// ESDTNFTKeyIdentifier is the key prefix for the instances of non fungible and semi fungible esdt tokens
+const ESDTNFTKeyIdentifier = "nft"
+
+// ESDTNFTNonceLength is the number of bytes used to encode the nonce at the end of the esdt token instance keys
+const ESDTNFTNonceLength = 8
+
+// ESDTRoleIdentifier is the key prefix for esdt role identifier
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key prefix for the latest created nonce of a non fungible esdt token
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -572,3 +630,6 @@ const MaxLeafSize = uint64(1<<18) + uint64(1<<19) //786KB
 
 // MaxUserNameLength represents the maximum number of bytes a UserName can have
 const MaxUserNameLength = 32
+
+// SignalErrorOperation is the identifier of the log event saved for a transaction whose execution failed
+const SignalErrorOperation = "signalError"
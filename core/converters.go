@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
@@ -177,3 +179,30 @@ func ConvertToEvenHexBigInt(value *big.Int) string {
 
 	return str
 }
+
+// ESDTNFTTokenKey returns the data trie key under which the instance with the provided nonce of the token is saved. The
+// nonce is encoded on a fixed number of bytes, so that the keys of different token instances can not collide
+func ESDTNFTTokenKey(tokenID []byte, nonce uint64) []byte {
+	prefix := ElrondProtectedKeyPrefix + ESDTNFTKeyIdentifier
+	key := make([]byte, 0, len(prefix)+len(tokenID)+ESDTNFTNonceLength)
+	key = append(key, prefix...)
+	key = append(key, tokenID...)
+
+	nonceBytes := make([]byte, ESDTNFTNonceLength)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+
+	return append(key, nonceBytes...)
+}
+
+// ParseESDTNFTTokenKey splits a key returned by ESDTNFTTokenKey into the token identifier and the nonce
+func ParseESDTNFTTokenKey(key []byte) ([]byte, uint64, bool) {
+	prefix := []byte(ElrondProtectedKeyPrefix + ESDTNFTKeyIdentifier)
+	if len(key) <= len(prefix)+ESDTNFTNonceLength || !bytes.HasPrefix(key, prefix) {
+		return nil, 0, false
+	}
+
+	tokenIDEnd := len(key) - ESDTNFTNonceLength
+	nonce := binary.BigEndian.Uint64(key[tokenIDEnd:])
+
+	return key[len(prefix):tokenIDEnd], nonce, true
+}
@@ -236,3 +236,28 @@ func TestConvertShardIDToUint32(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, uint32(0), shardID)
 }
+
+func TestESDTNFTTokenKey_ShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	// with a variable width nonce, both keys would end in "TKNN\x01"
+	key1 := core.ESDTNFTTokenKey([]byte("TKN"), 0x4e01)
+	key2 := core.ESDTNFTTokenKey([]byte("TKNN"), 0x01)
+	assert.NotEqual(t, key1, key2)
+	assert.Equal(t, len(core.ElrondProtectedKeyPrefix+core.ESDTNFTKeyIdentifier)+len("TKN")+core.ESDTNFTNonceLength, len(key1))
+}
+
+func TestParseESDTNFTTokenKey(t *testing.T) {
+	t.Parallel()
+
+	tokenID, nonce, ok := core.ParseESDTNFTTokenKey(core.ESDTNFTTokenKey([]byte("TKN-abcdef"), 256))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(256), nonce)
+
+	_, _, ok = core.ParseESDTNFTTokenKey([]byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef"))
+	assert.False(t, ok)
+
+	_, _, ok = core.ParseESDTNFTTokenKey(core.ESDTNFTTokenKey(nil, 1))
+	assert.False(t, ok)
+}
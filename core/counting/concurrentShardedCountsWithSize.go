@@ -60,6 +60,21 @@ func (counts *ConcurrentShardedCountsWithSize) GetTotalSize() int64 {
 	return total
 }
 
+// GetCountsByShard gets a copy of the counts (with sizes) of each shard
+func (counts *ConcurrentShardedCountsWithSize) GetCountsByShard() map[string]ShardCountsWithSize {
+	counts.mutex.RLock()
+	defer counts.mutex.RUnlock()
+
+	result := make(map[string]ShardCountsWithSize, len(counts.byShard))
+	for shardName, item := range counts.byShard {
+		result[shardName] = ShardCountsWithSize{
+			Counter:     item.counter,
+			SizeInBytes: item.sizeInBytes,
+		}
+	}
+	return result
+}
+
 func (counts *ConcurrentShardedCountsWithSize) String() string {
 	var builder strings.Builder
 
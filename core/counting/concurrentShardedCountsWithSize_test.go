@@ -19,6 +19,12 @@ func TestConcurrentShardedCountsWithSize(t *testing.T) {
 
 	require.Equal(t, int64(85), total)
 	require.Equal(t, "Total:85 (4.00 MB); [bar]=43 (3.00 MB); [foo]=42 (1.00 MB); ", asString)
+
+	expectedCountsByShard := map[string]ShardCountsWithSize{
+		"foo": {Counter: 42, SizeInBytes: core.MegabyteSize},
+		"bar": {Counter: 43, SizeInBytes: core.MegabyteSize * 3},
+	}
+	require.Equal(t, expectedCountsByShard, counts.GetCountsByShard())
 }
 
 func TestConcurrentShardedCountsWithSize_ConcurrentReadsAndWrites(t *testing.T) {
@@ -11,4 +11,11 @@ type Counts interface {
 type CountsWithSize interface {
 	Counts
 	GetTotalSize() int64
+	GetCountsByShard() map[string]ShardCountsWithSize
+}
+
+// ShardCountsWithSize holds the number of items and their size in bytes, for a shard
+type ShardCountsWithSize struct {
+	Counter     int64
+	SizeInBytes int64
 }
@@ -16,6 +16,11 @@ func (counts *NullCounts) GetTotalSize() int64 {
 	return -1
 }
 
+// GetCountsByShard returns an empty map
+func (counts *NullCounts) GetCountsByShard() map[string]ShardCountsWithSize {
+	return make(map[string]ShardCountsWithSize)
+}
+
 // String returns a placeholder
 func (counts *NullCounts) String() string {
 	return "counts not applicable"
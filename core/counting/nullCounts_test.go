@@ -14,6 +14,7 @@ func TestNullCounts(t *testing.T) {
 
 	require.Equal(t, int64(-1), total)
 	require.Equal(t, asString, "counts not applicable")
+	require.Len(t, counts.GetCountsByShard(), 0)
 }
 
 func TestNullCounts_IsInterfaceNil(t *testing.T) {
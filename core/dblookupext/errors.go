@@ -8,6 +8,11 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+var errTxHashesByAddressEntryNotFound = errors.New("transaction hash by address entry not found")
+
+// ErrTxHashesByAddressIndexNotEnabled signals that the transactions hashes by address index is not enabled
+var ErrTxHashesByAddressIndexNotEnabled = errors.New("transactions hashes by address index is not enabled")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -64,6 +64,10 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 	}
+	if hpf.dbLookupExtensionsConfig.TxHashesByAddressIndexEnabled {
+		historyRepArgs.TxHashesByAddressStorer = hpf.store.GetStorer(dataRetriever.TxHashesByAddressUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
@@ -29,6 +29,7 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	TxHashesByAddressStorer     storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +40,7 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	txHashesByAddressIndex     *txHashesByAddressIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -62,6 +64,7 @@ type notarizedNotification struct {
 }
 
 // NewHistoryRepository will create a new instance of HistoryRepository
+// The TxHashesByAddressStorer argument is optional: if not provided, the transactions hashes by address index is disabled
 func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyRepository, error) {
 	if check.IfNil(arguments.MiniblocksMetadataStorer) {
 		return nil, core.ErrNilStore
@@ -87,6 +90,11 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var txHashesByAddress *txHashesByAddressIndex
+	if !check.IfNil(arguments.TxHashesByAddressStorer) {
+		txHashesByAddress = newTxHashesByAddressIndex(arguments.TxHashesByAddressStorer, arguments.Marshalizer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -99,6 +107,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		txHashesByAddressIndex:                       txHashesByAddress,
 	}, nil
 }
 
@@ -108,6 +117,7 @@ func (hr *historyRepository) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 ) error {
@@ -144,9 +154,26 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	if hr.txHashesByAddressIndex != nil {
+		hr.txHashesByAddressIndex.saveTransactions(blockHeaderHash, epoch, blockHeader.GetNonce(), txsFromPool, scrResultsFromPool)
+	}
+
 	return nil
 }
 
+// RevertBlock undoes the records of a previously recorded block which was reverted
+// This function is not called on a goroutine, but synchronously instead, right after reverting a block
+func (hr *historyRepository) RevertBlock(blockHeaderHash []byte) {
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	log.Debug("RevertBlock()", "blockHeaderHash", blockHeaderHash)
+
+	if hr.txHashesByAddressIndex != nil {
+		hr.txHashesByAddressIndex.revertTransactions(blockHeaderHash)
+	}
+}
+
 func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniblock *block.MiniBlock, epoch uint32) error {
 	miniblockHash, err := hr.computeMiniblockHash(miniblock)
 	if err != nil {
@@ -427,6 +454,16 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetTxHashesByAddress will return at most maxSize transactions hashes that touched the given address, most recent first,
+// after skipping the first "skip" most recent ones. The total number of recorded transactions for the address is also returned.
+func (hr *historyRepository) GetTxHashesByAddress(address []byte, skip uint64, maxSize uint64) ([]*TxHashByAddressEntry, uint64, error) {
+	if hr.txHashesByAddressIndex == nil {
+		return nil, 0, ErrTxHashesByAddressIndexNotEnabled
+	}
+
+	return hr.txHashesByAddressIndex.getTxHashesByAddress(address, skip, maxSize)
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
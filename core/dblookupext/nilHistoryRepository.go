@@ -13,10 +13,14 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler) error {
 	return nil
 }
 
+// RevertBlock does nothing
+func (nhr *nilHistoryRepository) RevertBlock(_ []byte) {
+}
+
 // OnNotarizedBlocks does nothing
 func (nhr *nilHistoryRepository) OnNotarizedBlocks(_ uint32, _ []data.HeaderHandler, _ [][]byte) {
 }
@@ -41,6 +45,11 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetTxHashesByAddress returns a not enabled error
+func (nhr *nilHistoryRepository) GetTxHashesByAddress(_ []byte, _ uint64, _ uint64) ([]*TxHashByAddressEntry, uint64, error) {
+	return nil, 0, ErrTxHashesByAddressIndexNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -0,0 +1,284 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. txHashesByAddress.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const (
+	metadataKeyPrefix = byte(0)
+	entryKeyPrefix    = byte(1)
+	markerKeyPrefix   = byte(2)
+)
+
+// sizeOfRevertibleBlocksCache is the number of recently recorded blocks whose records can be undone on revert
+const sizeOfRevertibleBlocksCache = 100
+
+// txHashesByAddressIndex keeps, for each address, an append-only list of the transactions hashes that touched it.
+// Three kinds of records live in the same storer:
+// - the metadata record, holding the number of recorded transactions for an address
+// - the entry records, one for each (address, index) pair
+// - the marker records, one for each (address, transaction hash) pair, used to avoid recording a transaction twice
+// The records saved for the recently recorded blocks are remembered, so that they can be undone if a block is reverted.
+type txHashesByAddressIndex struct {
+	marshalizer          marshal.Marshalizer
+	storer               storage.Storer
+	savedRecordsByBlocks storage.Cacher
+}
+
+type savedRecord struct {
+	address []byte
+	txHash  []byte
+}
+
+func newTxHashesByAddressIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *txHashesByAddressIndex {
+	savedRecordsByBlocks, _ := lrucache.NewCache(sizeOfRevertibleBlocksCache)
+
+	return &txHashesByAddressIndex{
+		marshalizer:          marshalizer,
+		storer:               storer,
+		savedRecordsByBlocks: savedRecordsByBlocks,
+	}
+}
+
+// saveTransactions records the transactions of a block, ordered by their nonces, so that the entries of the same
+// block get the same order on all nodes
+func (i *txHashesByAddressIndex) saveTransactions(
+	blockHeaderHash []byte,
+	epoch uint32,
+	blockNonce uint64,
+	txsMaps ...map[string]data.TransactionHandler,
+) {
+	records := make([]*savedRecord, 0)
+	for _, txs := range txsMaps {
+		for _, txHash := range sortTransactionsHashes(txs) {
+			tx := txs[txHash]
+			if i.saveTransactionForAddress(tx.GetSndAddr(), []byte(txHash), epoch, blockNonce) {
+				records = append(records, &savedRecord{address: tx.GetSndAddr(), txHash: []byte(txHash)})
+			}
+			if bytes.Equal(tx.GetSndAddr(), tx.GetRcvAddr()) {
+				continue
+			}
+			if i.saveTransactionForAddress(tx.GetRcvAddr(), []byte(txHash), epoch, blockNonce) {
+				records = append(records, &savedRecord{address: tx.GetRcvAddr(), txHash: []byte(txHash)})
+			}
+		}
+	}
+
+	i.savedRecordsByBlocks.Put(blockHeaderHash, records, 0)
+}
+
+func sortTransactionsHashes(txs map[string]data.TransactionHandler) []string {
+	txsHashes := make([]string, 0, len(txs))
+	for txHash, tx := range txs {
+		if tx == nil || tx.IsInterfaceNil() {
+			continue
+		}
+
+		txsHashes = append(txsHashes, txHash)
+	}
+
+	sort.Slice(txsHashes, func(a, b int) bool {
+		nonceA := txs[txsHashes[a]].GetNonce()
+		nonceB := txs[txsHashes[b]].GetNonce()
+		if nonceA != nonceB {
+			return nonceA < nonceB
+		}
+
+		return txsHashes[a] < txsHashes[b]
+	})
+
+	return txsHashes
+}
+
+// saveTransactionForAddress returns true if a new entry was recorded for the provided address
+func (i *txHashesByAddressIndex) saveTransactionForAddress(address []byte, txHash []byte, epoch uint32, blockNonce uint64) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	markerKey := buildMarkerKey(address, txHash)
+	if i.storer.Has(markerKey) == nil {
+		return false
+	}
+
+	metadata, err := i.getMetadata(address)
+	if err != nil {
+		log.Warn("txHashesByAddressIndex.saveTransactionForAddress() cannot get metadata", "error", err)
+		return false
+	}
+
+	entry := &TxHashByAddressEntry{
+		TxHash:     txHash,
+		Epoch:      epoch,
+		BlockNonce: blockNonce,
+	}
+	err = i.putRecord(buildEntryKey(address, metadata.NumTxs), entry)
+	if err != nil {
+		log.Warn("txHashesByAddressIndex.saveTransactionForAddress() cannot save entry", "error", err)
+		return false
+	}
+
+	metadata.NumTxs++
+	err = i.putRecord(buildMetadataKey(address), metadata)
+	if err != nil {
+		log.Warn("txHashesByAddressIndex.saveTransactionForAddress() cannot save metadata", "error", err)
+		return false
+	}
+
+	err = i.storer.Put(markerKey, []byte{})
+	if err != nil {
+		log.Warn("txHashesByAddressIndex.saveTransactionForAddress() cannot save marker", "error", err)
+	}
+
+	return true
+}
+
+// revertTransactions undoes the records saved for the provided block. Since only the last committed blocks are
+// reverted, their entries are the most recent ones of each address.
+func (i *txHashesByAddressIndex) revertTransactions(blockHeaderHash []byte) {
+	value, ok := i.savedRecordsByBlocks.Get(blockHeaderHash)
+	if !ok {
+		log.Debug("txHashesByAddressIndex.revertTransactions() no records to revert", "blockHeaderHash", blockHeaderHash)
+		return
+	}
+	i.savedRecordsByBlocks.Remove(blockHeaderHash)
+
+	records, ok := value.([]*savedRecord)
+	if !ok {
+		return
+	}
+
+	for index := len(records) - 1; index >= 0; index-- {
+		err := i.revertTransactionForAddress(records[index].address, records[index].txHash)
+		if err != nil {
+			log.Warn("txHashesByAddressIndex.revertTransactions()", "blockHeaderHash", blockHeaderHash, "error", err)
+		}
+	}
+}
+
+func (i *txHashesByAddressIndex) revertTransactionForAddress(address []byte, txHash []byte) error {
+	metadata, err := i.getMetadata(address)
+	if err != nil {
+		return err
+	}
+	if metadata.NumTxs == 0 {
+		return errTxHashesByAddressEntryNotFound
+	}
+
+	entryKey := buildEntryKey(address, metadata.NumTxs-1)
+	rawBytes, err := i.storer.Get(entryKey)
+	if err != nil {
+		return err
+	}
+
+	entry := &TxHashByAddressEntry{}
+	err = i.marshalizer.Unmarshal(entry, rawBytes)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(entry.TxHash, txHash) {
+		return errTxHashesByAddressEntryNotFound
+	}
+
+	metadata.NumTxs--
+	err = i.putRecord(buildMetadataKey(address), metadata)
+	if err != nil {
+		return err
+	}
+
+	err = i.storer.Remove(entryKey)
+	if err != nil {
+		return err
+	}
+
+	return i.storer.Remove(buildMarkerKey(address, txHash))
+}
+
+// getTxHashesByAddress returns at most "maxSize" entries for the provided address, most recent first, skipping the
+// first "skip" most recent ones. The total number of recorded transactions for the address is also returned.
+func (i *txHashesByAddressIndex) getTxHashesByAddress(address []byte, skip uint64, maxSize uint64) ([]*TxHashByAddressEntry, uint64, error) {
+	metadata, err := i.getMetadata(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*TxHashByAddressEntry, 0, maxSize)
+	if skip >= metadata.NumTxs {
+		return entries, metadata.NumTxs, nil
+	}
+
+	lastIndex := metadata.NumTxs - 1 - skip
+	for index := int64(lastIndex); index >= 0 && uint64(len(entries)) < maxSize; index-- {
+		rawBytes, errGet := i.storer.Get(buildEntryKey(address, uint64(index)))
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		entry := &TxHashByAddressEntry{}
+		errGet = i.marshalizer.Unmarshal(entry, rawBytes)
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, metadata.NumTxs, nil
+}
+
+func (i *txHashesByAddressIndex) getMetadata(address []byte) (*TxHashesByAddressMetadata, error) {
+	metadata := &TxHashesByAddressMetadata{}
+	rawBytes, err := i.storer.Get(buildMetadataKey(address))
+	if err != nil {
+		// no transaction recorded yet for this address
+		return metadata, nil
+	}
+
+	err = i.marshalizer.Unmarshal(metadata, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+func (i *txHashesByAddressIndex) putRecord(key []byte, record interface{}) error {
+	rawBytes, err := i.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return i.storer.Put(key, rawBytes)
+}
+
+func buildMetadataKey(address []byte) []byte {
+	key := make([]byte, 0, 1+len(address))
+	key = append(key, metadataKeyPrefix)
+	return append(key, address...)
+}
+
+func buildEntryKey(address []byte, index uint64) []byte {
+	key := make([]byte, 0, 1+len(address)+8)
+	key = append(key, entryKeyPrefix)
+	key = append(key, address...)
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	return append(key, indexBytes...)
+}
+
+func buildMarkerKey(address []byte, txHash []byte) []byte {
+	key := make([]byte, 0, 1+len(address)+len(txHash))
+	key = append(key, markerKeyPrefix)
+	key = append(key, address...)
+	return append(key, txHash...)
+}
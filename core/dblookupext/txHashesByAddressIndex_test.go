@@ -0,0 +1,214 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxHashesByAddressIndex_GetForUnknownAddressShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	entries, total, err := index.getTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Len(t, entries, 0)
+}
+
+func TestTxHashesByAddressIndex_SaveAndGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	index.saveTransactions([]byte("block10"), 1, 10, map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+	index.saveTransactions([]byte("block11"), 1, 11, map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("carol")},
+	})
+	index.saveTransactions([]byte("block12"), 2, 12, map[string]data.TransactionHandler{
+		"scrC": &smartContractResult.SmartContractResult{SndAddr: []byte("carol"), RcvAddr: []byte("alice")},
+	})
+
+	entries, total, err := index.getTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Len(t, entries, 2)
+	require.Equal(t, []byte("scrC"), entries[0].TxHash)
+	require.Equal(t, uint32(2), entries[0].Epoch)
+	require.Equal(t, uint64(12), entries[0].BlockNonce)
+	require.Equal(t, []byte("txA"), entries[1].TxHash)
+
+	entries, total, err = index.getTxHashesByAddress([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []byte("txB"), entries[0].TxHash)
+	require.Equal(t, []byte("txA"), entries[1].TxHash)
+}
+
+func TestTxHashesByAddressIndex_SaveSameTransactionTwiceShouldRecordOnce(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	}
+	index.saveTransactions([]byte("block10"), 1, 10, txs)
+	index.saveTransactions([]byte("block11"), 1, 11, txs)
+
+	entries, total, err := index.getTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(10), entries[0].BlockNonce)
+}
+
+func TestTxHashesByAddressIndex_GetShouldPaginate(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	txHashes := []string{"tx0", "tx1", "tx2", "tx3", "tx4"}
+	for nonce, txHash := range txHashes {
+		index.saveTransactions([]byte(txHash), 0, uint64(nonce), map[string]data.TransactionHandler{
+			txHash: &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		})
+	}
+
+	entries, total, err := index.getTxHashesByAddress([]byte("alice"), 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Len(t, entries, 2)
+	require.Equal(t, []byte("tx3"), entries[0].TxHash)
+	require.Equal(t, []byte("tx2"), entries[1].TxHash)
+
+	entries, _, err = index.getTxHashesByAddress([]byte("alice"), 4, 2)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, []byte("tx0"), entries[0].TxHash)
+
+	entries, _, err = index.getTxHashesByAddress([]byte("alice"), 5, 2)
+	require.Nil(t, err)
+	require.Len(t, entries, 0)
+}
+
+func TestTxHashesByAddressIndex_SaveShouldOrderTheTransactionsOfABlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	index.saveTransactions([]byte("block10"), 1, 10, map[string]data.TransactionHandler{
+		"txC": &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"txA": &transaction.Transaction{Nonce: 9, SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"txB": &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+
+	entries, _, err := index.getTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, []byte("txA"), entries[0].TxHash)
+	require.Equal(t, []byte("txB"), entries[1].TxHash)
+	require.Equal(t, []byte("txC"), entries[2].TxHash)
+}
+
+func TestTxHashesByAddressIndex_RevertShouldUndoTheRecordsOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	index.saveTransactions([]byte("block10"), 1, 10, map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+	txs := map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("carol")},
+	}
+	index.saveTransactions([]byte("block11"), 1, 11, txs)
+
+	index.revertTransactions([]byte("block11"))
+
+	entries, total, err := index.getTxHashesByAddress([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []byte("txA"), entries[0].TxHash)
+
+	_, total, err = index.getTxHashesByAddress([]byte("carol"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+
+	// the transaction is included again, in the block which replaced the reverted one
+	index.saveTransactions([]byte("block11bis"), 2, 11, txs)
+
+	entries, total, err = index.getTxHashesByAddress([]byte("carol"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []byte("txB"), entries[0].TxHash)
+	require.Equal(t, uint32(2), entries[0].Epoch)
+}
+
+func TestTxHashesByAddressIndex_RevertUnknownBlockShouldNotAlterTheIndex(t *testing.T) {
+	t.Parallel()
+
+	index := newTxHashesByAddressIndex(genericmocks.NewStorerMock("TxHashesByAddress", 0), &mock.MarshalizerMock{})
+
+	index.saveTransactions([]byte("block10"), 1, 10, map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+
+	index.revertTransactions([]byte("block11"))
+
+	_, total, err := index.getTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+}
+
+func TestHistoryRepository_GetTxHashesByAddressWhenIndexDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	require.Nil(t, err)
+
+	entries, _, err := repo.GetTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, entries)
+	require.Equal(t, ErrTxHashesByAddressIndexNotEnabled, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldFeedTxHashesByAddressIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(3)
+	args.TxHashesByAddressStorer = genericmocks.NewStorerMock("TxHashesByAddress", 3)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scrB": &smartContractResult.SmartContractResult{SndAddr: []byte("bob"), RcvAddr: []byte("alice"), OriginalTxHash: []byte("txA")},
+	}
+	err = repo.RecordBlock([]byte("fooblock"), &block.Header{Epoch: 3, Nonce: 7}, &block.Body{}, txs, scrs, nil)
+	require.Nil(t, err)
+
+	entries, total, err := repo.GetTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), total)
+	for _, entry := range entries {
+		require.Equal(t, uint32(3), entry.Epoch)
+		require.Equal(t, uint64(7), entry.BlockNonce)
+	}
+
+	repo.RevertBlock([]byte("fooblock"))
+
+	_, total, err = repo.GetTxHashesByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+}
@@ -0,0 +1,76 @@
+package eventsNotifier
+
+const (
+	// BlockEventType is the type of the events emitted for each committed block header
+	BlockEventType = "block"
+	// TransactionEventType is the type of the events emitted for each committed transaction
+	TransactionEventType = "transaction"
+	// LogEventType is the type of the events emitted for each smart contract log event
+	LogEventType = "log"
+	// RevertedBlockEventType is the type of the events emitted for each reverted block header. The events previously
+	// sent for the reverted block, carrying its hash, are not valid anymore
+	RevertedBlockEventType = "revertedBlock"
+	// GapEventType is the type of the events emitted when some blocks could not be notified, so that the
+	// subscribers know they missed events
+	GapEventType = "gap"
+)
+
+// Event is the envelope of every message sent to the subscribers
+type Event struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// BlockEvent holds the information sent to subscribers about a committed block header
+type BlockEvent struct {
+	Hash      string `json:"hash"`
+	PrevHash  string `json:"prevHash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shardID"`
+	TimeStamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+}
+
+// TransactionEvent holds the information sent to subscribers about a committed transaction
+type TransactionEvent struct {
+	Hash       string `json:"hash"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Nonce      uint64 `json:"nonce"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Value      string `json:"value"`
+	Data       []byte `json:"data,omitempty"`
+	GasPrice   uint64 `json:"gasPrice"`
+	GasLimit   uint64 `json:"gasLimit"`
+}
+
+// LogEvent holds the information sent to subscribers about an event generated by a smart contract
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics,omitempty"`
+	Data       []byte   `json:"data,omitempty"`
+}
+
+// GapEvent holds the information sent to subscribers about the block notifications that were dropped
+type GapEvent struct {
+	NumDroppedBlocks uint64 `json:"numDroppedBlocks"`
+	LowestNonce      uint64 `json:"lowestNonce"`
+	HighestNonce     uint64 `json:"highestNonce"`
+}
+
+// SubscriptionFilter selects the events a subscriber is interested in. Empty Addresses or LogIdentifiers
+// slices will not filter out any transaction or log event
+type SubscriptionFilter struct {
+	Blocks         bool
+	Transactions   bool
+	Logs           bool
+	Addresses      [][]byte
+	LogIdentifiers [][]byte
+}
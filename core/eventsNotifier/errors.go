@@ -0,0 +1,24 @@
+package eventsNotifier
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilTxLogsStorer signals that a nil transaction logs storer has been provided
+var ErrNilTxLogsStorer = errors.New("nil transaction logs storer")
+
+// ErrInvalidSubscriptionBufferSize signals that an invalid subscription buffer size has been provided
+var ErrInvalidSubscriptionBufferSize = errors.New("invalid subscription buffer size")
+
+// ErrInvalidNotificationsQueueSize signals that an invalid notifications queue size has been provided
+var ErrInvalidNotificationsQueueSize = errors.New("invalid notifications queue size")
+
+// ErrEmptySubscriptionFilter signals that the provided subscription filter does not select any kind of events
+var ErrEmptySubscriptionFilter = errors.New("subscription filter does not select any events")
+
+// ErrNilCommittedBlockHandler signals that a nil committed block handler has been provided
+var ErrNilCommittedBlockHandler = errors.New("nil committed block handler")
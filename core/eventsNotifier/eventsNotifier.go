@@ -0,0 +1,450 @@
+package eventsNotifier
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("core/eventsNotifier")
+
+// ArgsEventsNotifier holds the arguments needed to create a new events notifier
+type ArgsEventsNotifier struct {
+	PubkeyConverter        core.PubkeyConverter
+	Marshalizer            marshal.Marshalizer
+	TxLogsStorer           storage.Storer
+	SubscriptionBufferSize int
+	NotificationsQueueSize int
+}
+
+type committedBlock struct {
+	headerHash []byte
+	header     data.HeaderHandler
+	txs        map[string]data.TransactionHandler
+	isReverted bool
+	gapBefore  *GapEvent
+}
+
+type eventsNotifier struct {
+	pubkeyConverter        core.PubkeyConverter
+	marshalizer            marshal.Marshalizer
+	txLogsStorer           storage.Storer
+	subscriptionBufferSize int
+	notificationsQueue     chan *committedBlock
+	cancelFunc             func()
+
+	mutQueue      sync.Mutex
+	droppedBlocks *GapEvent
+
+	mutSubscriptions sync.Mutex
+	subscriptions    map[uint64]*Subscription
+	lastID           uint64
+
+	mutHandlers sync.RWMutex
+	handlers    []CommittedBlockHandler
+}
+
+// NewEventsNotifier creates a component that pushes the events of the committed blocks to its subscribers
+func NewEventsNotifier(args ArgsEventsNotifier) (*eventsNotifier, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxLogsStorer) {
+		return nil, ErrNilTxLogsStorer
+	}
+	if args.SubscriptionBufferSize < 1 {
+		return nil, ErrInvalidSubscriptionBufferSize
+	}
+	if args.NotificationsQueueSize < 1 {
+		return nil, ErrInvalidNotificationsQueueSize
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	en := &eventsNotifier{
+		pubkeyConverter:        args.PubkeyConverter,
+		marshalizer:            args.Marshalizer,
+		txLogsStorer:           args.TxLogsStorer,
+		subscriptionBufferSize: args.SubscriptionBufferSize,
+		notificationsQueue:     make(chan *committedBlock, args.NotificationsQueueSize),
+		cancelFunc:             cancelFunc,
+		subscriptions:          make(map[uint64]*Subscription),
+		handlers:               make([]CommittedBlockHandler, 0),
+	}
+
+	go en.processNotifications(ctx)
+
+	return en, nil
+}
+
+// RegisterHandler registers a component which will be notified about every committed block
+func (en *eventsNotifier) RegisterHandler(handler CommittedBlockHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilCommittedBlockHandler
+	}
+
+	en.mutHandlers.Lock()
+	en.handlers = append(en.handlers, handler)
+	en.mutHandlers.Unlock()
+
+	return nil
+}
+
+// Subscribe registers a new subscriber for the events selected by the provided filter
+func (en *eventsNotifier) Subscribe(filter SubscriptionFilter) (*Subscription, error) {
+	if !filter.Blocks && !filter.Transactions && !filter.Logs {
+		return nil, ErrEmptySubscriptionFilter
+	}
+
+	en.mutSubscriptions.Lock()
+	defer en.mutSubscriptions.Unlock()
+
+	en.lastID++
+	subscription := newSubscription(en.lastID, filter, en.subscriptionBufferSize)
+	en.subscriptions[subscription.id] = subscription
+
+	return subscription, nil
+}
+
+// Unsubscribe removes the subscription and closes its events channel
+func (en *eventsNotifier) Unsubscribe(subscription *Subscription) {
+	if subscription == nil {
+		return
+	}
+
+	en.mutSubscriptions.Lock()
+	defer en.mutSubscriptions.Unlock()
+
+	en.removeSubscription(subscription)
+}
+
+func (en *eventsNotifier) removeSubscription(subscription *Subscription) {
+	delete(en.subscriptions, subscription.id)
+	subscription.close()
+}
+
+// HasSubscribers returns true if there is at least one subscriber interested in the committed blocks, so that the
+// caller can skip gathering the data of all the block transactions otherwise
+func (en *eventsNotifier) HasSubscribers() bool {
+	en.mutSubscriptions.Lock()
+	defer en.mutSubscriptions.Unlock()
+
+	return len(en.subscriptions) > 0
+}
+
+// HasHandlers returns true if there is at least one registered handler. The handlers only need the user
+// transactions of the committed blocks
+func (en *eventsNotifier) HasHandlers() bool {
+	en.mutHandlers.RLock()
+	defer en.mutHandlers.RUnlock()
+
+	return len(en.handlers) > 0
+}
+
+// NotifyCommittedBlock queues the committed block so that its events are sent to all interested subscribers and
+// handlers on a separate go routine. The call never blocks: if the queue is full, the block is dropped and the
+// subscribers will receive a gap event before the events of the next queued block
+func (en *eventsNotifier) NotifyCommittedBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+) {
+	if check.IfNil(header) || (!en.HasSubscribers() && !en.HasHandlers()) {
+		return
+	}
+
+	en.enqueue(&committedBlock{headerHash: headerHash, header: header, txs: txs})
+}
+
+// NotifyRevertedBlock queues the reverted block so that the subscribers and the handlers can discard the data
+// received for it. The reverted block goes through the same queue as the committed blocks, so it is seen after its commit
+func (en *eventsNotifier) NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler) {
+	if check.IfNil(header) || (!en.HasSubscribers() && !en.HasHandlers()) {
+		return
+	}
+
+	en.enqueue(&committedBlock{headerHash: headerHash, header: header, isReverted: true})
+}
+
+// enqueue pushes the notification without blocking. The notifications dropped because of a full queue are
+// accumulated and attached to the next notification that fits in the queue
+func (en *eventsNotifier) enqueue(cb *committedBlock) {
+	en.mutQueue.Lock()
+	defer en.mutQueue.Unlock()
+
+	cb.gapBefore = en.droppedBlocks
+	select {
+	case en.notificationsQueue <- cb:
+		en.droppedBlocks = nil
+	default:
+		log.Warn("eventsNotifier: notifications queue is full, dropping block notification",
+			"nonce", cb.header.GetNonce(), "hash", cb.headerHash, "reverted", cb.isReverted)
+		en.recordDroppedBlock(cb.header.GetNonce())
+	}
+}
+
+func (en *eventsNotifier) recordDroppedBlock(nonce uint64) {
+	if en.droppedBlocks == nil {
+		en.droppedBlocks = &GapEvent{LowestNonce: nonce, HighestNonce: nonce}
+	}
+
+	en.droppedBlocks.NumDroppedBlocks++
+	if nonce < en.droppedBlocks.LowestNonce {
+		en.droppedBlocks.LowestNonce = nonce
+	}
+	if nonce > en.droppedBlocks.HighestNonce {
+		en.droppedBlocks.HighestNonce = nonce
+	}
+}
+
+func (en *eventsNotifier) processNotifications(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("eventsNotifier: closing the notifications go routine")
+			return
+		case cb := <-en.notificationsQueue:
+			if cb.gapBefore != nil {
+				en.broadcast(&Event{Type: GapEventType, Data: cb.gapBefore})
+			}
+			if cb.isReverted {
+				blockEvent := en.createBlockEvent(hex.EncodeToString(cb.headerHash), cb.header)
+				en.broadcast(&Event{Type: RevertedBlockEventType, Data: blockEvent})
+				en.notifyHandlersAboutRevert(cb.headerHash, cb.header)
+				continue
+			}
+
+			en.notifySubscriptions(cb.headerHash, cb.header, cb.txs)
+			en.notifyHandlers(cb.headerHash, cb.header, cb.txs)
+		}
+	}
+}
+
+// notifySubscriptions sends the events generated by the committed block to all interested subscribers.
+// Subscribers that can not keep up with the produced events are dropped
+func (en *eventsNotifier) notifySubscriptions(
+	headerHash []byte,
+	header data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+) {
+	en.mutSubscriptions.Lock()
+	defer en.mutSubscriptions.Unlock()
+
+	if len(en.subscriptions) == 0 {
+		return
+	}
+
+	blockHash := hex.EncodeToString(headerHash)
+	blockEvent := en.createBlockEvent(blockHash, header)
+
+	var logs map[string]*transaction.Log
+	if en.hasLogsSubscribers() {
+		logs = en.fetchLogs(txs)
+	}
+
+	for _, subscription := range en.subscriptions {
+		ok := en.notifySubscription(subscription, blockEvent, header.GetNonce(), txs, logs)
+		if !ok {
+			log.Debug("eventsNotifier: dropping slow subscriber", "id", subscription.id)
+			en.removeSubscription(subscription)
+		}
+	}
+}
+
+// broadcast sends the event to all subscribers, as it concerns the events of all kinds. Subscribers that can not
+// keep up with the produced events are dropped
+func (en *eventsNotifier) broadcast(event *Event) {
+	en.mutSubscriptions.Lock()
+	defer en.mutSubscriptions.Unlock()
+
+	for _, subscription := range en.subscriptions {
+		if !subscription.send(event) {
+			log.Debug("eventsNotifier: dropping slow subscriber", "id", subscription.id)
+			en.removeSubscription(subscription)
+		}
+	}
+}
+
+func (en *eventsNotifier) notifyHandlers(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+	en.mutHandlers.RLock()
+	defer en.mutHandlers.RUnlock()
+
+	for _, handler := range en.handlers {
+		handler.NotifyCommittedBlock(headerHash, header, txs)
+	}
+}
+
+func (en *eventsNotifier) notifyHandlersAboutRevert(headerHash []byte, header data.HeaderHandler) {
+	en.mutHandlers.RLock()
+	defer en.mutHandlers.RUnlock()
+
+	for _, handler := range en.handlers {
+		handler.NotifyRevertedBlock(headerHash, header)
+	}
+}
+
+func (en *eventsNotifier) hasLogsSubscribers() bool {
+	for _, subscription := range en.subscriptions {
+		if subscription.filter.Logs {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (en *eventsNotifier) fetchLogs(txs map[string]data.TransactionHandler) map[string]*transaction.Log {
+	logs := make(map[string]*transaction.Log)
+	for txHash := range txs {
+		logBytes, err := en.txLogsStorer.Get([]byte(txHash))
+		if err != nil {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err = en.marshalizer.Unmarshal(txLog, logBytes)
+		if err != nil {
+			log.Debug("eventsNotifier.fetchLogs: cannot unmarshal log", "txHash", []byte(txHash), "error", err)
+			continue
+		}
+
+		logs[txHash] = txLog
+	}
+
+	return logs
+}
+
+func (en *eventsNotifier) notifySubscription(
+	subscription *Subscription,
+	blockEvent *BlockEvent,
+	blockNonce uint64,
+	txs map[string]data.TransactionHandler,
+	logs map[string]*transaction.Log,
+) bool {
+	if subscription.filter.Blocks {
+		if !subscription.send(&Event{Type: BlockEventType, Data: blockEvent}) {
+			return false
+		}
+	}
+
+	if subscription.filter.Transactions {
+		for txHash, tx := range txs {
+			if check.IfNil(tx) || !subscription.matchesAddresses(tx.GetSndAddr(), tx.GetRcvAddr()) {
+				continue
+			}
+
+			txEvent := en.createTransactionEvent(txHash, tx, blockEvent.Hash, blockNonce)
+			if !subscription.send(&Event{Type: TransactionEventType, Data: txEvent}) {
+				return false
+			}
+		}
+	}
+
+	if subscription.filter.Logs {
+		for txHash, txLog := range logs {
+			for _, event := range txLog.Events {
+				if event == nil {
+					continue
+				}
+				if !subscription.matchesAddresses(txLog.Address, event.Address) {
+					continue
+				}
+				if !subscription.matchesIdentifier(event.Identifier) {
+					continue
+				}
+
+				logEvent := en.createLogEvent(txHash, event, blockEvent.Hash, blockNonce)
+				if !subscription.send(&Event{Type: LogEventType, Data: logEvent}) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func (en *eventsNotifier) createBlockEvent(blockHash string, header data.HeaderHandler) *BlockEvent {
+	return &BlockEvent{
+		Hash:      blockHash,
+		PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		ShardID:   header.GetShardID(),
+		TimeStamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func (en *eventsNotifier) createTransactionEvent(
+	txHash string,
+	tx data.TransactionHandler,
+	blockHash string,
+	blockNonce uint64,
+) *TransactionEvent {
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &TransactionEvent{
+		Hash:       hex.EncodeToString([]byte(txHash)),
+		BlockHash:  blockHash,
+		BlockNonce: blockNonce,
+		Nonce:      tx.GetNonce(),
+		Sender:     en.encodeAddress(tx.GetSndAddr()),
+		Receiver:   en.encodeAddress(tx.GetRcvAddr()),
+		Value:      value,
+		Data:       tx.GetData(),
+		GasPrice:   tx.GetGasPrice(),
+		GasLimit:   tx.GetGasLimit(),
+	}
+}
+
+func (en *eventsNotifier) createLogEvent(
+	txHash string,
+	event *transaction.Event,
+	blockHash string,
+	blockNonce uint64,
+) *LogEvent {
+	return &LogEvent{
+		TxHash:     hex.EncodeToString([]byte(txHash)),
+		BlockHash:  blockHash,
+		BlockNonce: blockNonce,
+		Address:    en.encodeAddress(event.Address),
+		Identifier: string(event.Identifier),
+		Topics:     event.Topics,
+		Data:       event.Data,
+	}
+}
+
+func (en *eventsNotifier) encodeAddress(address []byte) string {
+	if len(address) != en.pubkeyConverter.Len() {
+		return ""
+	}
+
+	return en.pubkeyConverter.Encode(address)
+}
+
+// Close stops the notifications go routine. The blocks still queued are not sent anymore
+func (en *eventsNotifier) Close() error {
+	en.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *eventsNotifier) IsInterfaceNil() bool {
+	return en == nil
+}
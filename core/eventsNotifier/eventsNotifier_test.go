@@ -0,0 +1,380 @@
+package eventsNotifier
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsEventsNotifier {
+	return ArgsEventsNotifier{
+		PubkeyConverter:        mock.NewPubkeyConverterMock(4),
+		Marshalizer:            &mock.MarshalizerMock{},
+		TxLogsStorer:           genericmocks.NewStorerMock("TxLogs", 0),
+		SubscriptionBufferSize: 10,
+		NotificationsQueueSize: 10,
+	}
+}
+
+func createCommittedTxs() map[string]data.TransactionHandler {
+	return map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{Nonce: 1, SndAddr: []byte("alic"), RcvAddr: []byte("bob_"), Value: big.NewInt(5)},
+		"tx2": &transaction.Transaction{Nonce: 2, SndAddr: []byte("carl"), RcvAddr: []byte("dave"), Value: big.NewInt(7)},
+	}
+}
+
+type committedBlockHandlerStub struct {
+	notifyCommittedBlockCalled func(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler)
+	notifyRevertedBlockCalled  func(headerHash []byte, header data.HeaderHandler)
+}
+
+func (stub *committedBlockHandlerStub) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+	stub.notifyCommittedBlockCalled(headerHash, header, txs)
+}
+
+func (stub *committedBlockHandlerStub) NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler) {
+	if stub.notifyRevertedBlockCalled != nil {
+		stub.notifyRevertedBlockCalled(headerHash, header)
+	}
+}
+
+func (stub *committedBlockHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// notifyAndWait notifies the committed block and waits until it was dispatched, relying on the handlers being
+// notified after the subscribers
+func notifyAndWait(en *eventsNotifier, headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+	chDispatched := make(chan struct{}, 1)
+	handler := &committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+			chDispatched <- struct{}{}
+		},
+	}
+
+	en.mutHandlers.Lock()
+	en.handlers = append(en.handlers, handler)
+	en.mutHandlers.Unlock()
+
+	en.NotifyCommittedBlock(headerHash, header, txs)
+	<-chDispatched
+
+	en.mutHandlers.Lock()
+	en.handlers = en.handlers[:len(en.handlers)-1]
+	en.mutHandlers.Unlock()
+}
+
+func drainEvents(subscription *Subscription) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewEventsNotifier(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.PubkeyConverter = nil
+	en, err := NewEventsNotifier(args)
+	require.Nil(t, en)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	en, err = NewEventsNotifier(args)
+	require.Nil(t, en)
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs()
+	args.TxLogsStorer = nil
+	en, err = NewEventsNotifier(args)
+	require.Nil(t, en)
+	require.Equal(t, ErrNilTxLogsStorer, err)
+
+	args = createMockArgs()
+	args.SubscriptionBufferSize = 0
+	en, err = NewEventsNotifier(args)
+	require.Nil(t, en)
+	require.Equal(t, ErrInvalidSubscriptionBufferSize, err)
+
+	args = createMockArgs()
+	args.NotificationsQueueSize = 0
+	en, err = NewEventsNotifier(args)
+	require.Nil(t, en)
+	require.Equal(t, ErrInvalidNotificationsQueueSize, err)
+
+	en, err = NewEventsNotifier(createMockArgs())
+	require.Nil(t, err)
+	require.False(t, en.IsInterfaceNil())
+}
+
+func TestEventsNotifier_SubscribeWithEmptyFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+	subscription, err := en.Subscribe(SubscriptionFilter{})
+	require.Nil(t, subscription)
+	require.Equal(t, ErrEmptySubscriptionFilter, err)
+}
+
+func TestEventsNotifier_NotifyCommittedBlockFiltersEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	marshalizer := args.Marshalizer
+	txLog := &transaction.Log{
+		Address: []byte("scsc"),
+		Events: []*transaction.Event{
+			{Address: []byte("scsc"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+			{Address: []byte("scsc"), Identifier: []byte("other")},
+		},
+	}
+	logBytes, _ := marshalizer.Marshal(txLog)
+	_ = args.TxLogsStorer.Put([]byte("tx2"), logBytes)
+
+	en, _ := NewEventsNotifier(args)
+	blocksSubscription, _ := en.Subscribe(SubscriptionFilter{Blocks: true})
+	txsSubscription, _ := en.Subscribe(SubscriptionFilter{Transactions: true, Addresses: [][]byte{[]byte("bob_")}})
+	logsSubscription, _ := en.Subscribe(SubscriptionFilter{Logs: true, LogIdentifiers: [][]byte{[]byte("transfer")}})
+
+	header := &block.Header{Nonce: 3, Round: 4, TxCount: 2}
+	notifyAndWait(en, []byte("hash"), header, createCommittedTxs())
+
+	events := drainEvents(blocksSubscription)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, BlockEventType, events[0].Type)
+	blockEvent := events[0].Data.(*BlockEvent)
+	require.Equal(t, uint64(3), blockEvent.Nonce)
+	require.Equal(t, "68617368", blockEvent.Hash)
+
+	events = drainEvents(txsSubscription)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, TransactionEventType, events[0].Type)
+	txEvent := events[0].Data.(*TransactionEvent)
+	require.Equal(t, "616c6963", txEvent.Sender)
+	require.Equal(t, "5", txEvent.Value)
+	require.Equal(t, uint64(3), txEvent.BlockNonce)
+
+	events = drainEvents(logsSubscription)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, LogEventType, events[0].Type)
+	logEvent := events[0].Data.(*LogEvent)
+	require.Equal(t, "transfer", logEvent.Identifier)
+	require.Equal(t, "747832", logEvent.TxHash)
+}
+
+func TestEventsNotifier_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SubscriptionBufferSize = 1
+	en, _ := NewEventsNotifier(args)
+	subscription, _ := en.Subscribe(SubscriptionFilter{Blocks: true, Transactions: true})
+
+	notifyAndWait(en, []byte("hash"), &block.Header{}, createCommittedTxs())
+
+	require.False(t, en.HasSubscribers())
+	events := drainEvents(subscription)
+	require.Equal(t, 1, len(events))
+	_, ok := <-subscription.Events()
+	require.False(t, ok)
+}
+
+func TestEventsNotifier_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+	subscription, _ := en.Subscribe(SubscriptionFilter{Blocks: true})
+
+	en.Unsubscribe(subscription)
+	en.Unsubscribe(subscription)
+	en.NotifyCommittedBlock([]byte("hash"), &block.Header{}, nil)
+
+	_, ok := <-subscription.Events()
+	require.False(t, ok)
+	require.False(t, en.HasSubscribers())
+}
+
+func TestEventsNotifier_RegisterHandler(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+
+	err := en.RegisterHandler(nil)
+	require.Equal(t, ErrNilCommittedBlockHandler, err)
+
+	var notifiedHeader data.HeaderHandler
+	var notifiedTxs map[string]data.TransactionHandler
+	chNotified := make(chan struct{}, 1)
+	err = en.RegisterHandler(&committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(_ []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+			notifiedHeader = header
+			notifiedTxs = txs
+			chNotified <- struct{}{}
+		},
+	})
+	require.Nil(t, err)
+	require.True(t, en.HasHandlers())
+	require.False(t, en.HasSubscribers())
+
+	header := &block.Header{Nonce: 3}
+	txs := createCommittedTxs()
+	en.NotifyCommittedBlock([]byte("hash"), header, txs)
+	<-chNotified
+	require.Equal(t, header, notifiedHeader)
+	require.Equal(t, txs, notifiedTxs)
+}
+
+func TestEventsNotifier_NotifyRevertedBlockShouldNotifyTheHandlersAfterTheCommit(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+	en.NotifyRevertedBlock([]byte("hash"), &block.Header{Nonce: 3})
+	require.Equal(t, 0, len(en.notificationsQueue))
+
+	notifications := make(chan string, 2)
+	_ = en.RegisterHandler(&committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(headerHash []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+			notifications <- "committed " + string(headerHash)
+		},
+		notifyRevertedBlockCalled: func(headerHash []byte, _ data.HeaderHandler) {
+			notifications <- "reverted " + string(headerHash)
+		},
+	})
+
+	en.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 3}, createCommittedTxs())
+	en.NotifyRevertedBlock([]byte("hash"), &block.Header{Nonce: 3})
+	en.NotifyRevertedBlock([]byte("hash"), nil)
+	require.Equal(t, "committed hash", <-notifications)
+	require.Equal(t, "reverted hash", <-notifications)
+
+	_ = en.Close()
+}
+
+func TestEventsNotifier_NotifyRevertedBlockShouldNotifyTheSubscribers(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+	subscription, _ := en.Subscribe(SubscriptionFilter{Transactions: true})
+
+	chReverted := make(chan struct{}, 1)
+	_ = en.RegisterHandler(&committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {},
+		notifyRevertedBlockCalled: func(_ []byte, _ data.HeaderHandler) {
+			chReverted <- struct{}{}
+		},
+	})
+
+	en.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 3}, createCommittedTxs())
+	en.NotifyRevertedBlock([]byte("hash"), &block.Header{Nonce: 3})
+	<-chReverted
+
+	events := drainEvents(subscription)
+	require.Equal(t, 3, len(events))
+	require.Equal(t, TransactionEventType, events[0].Type)
+	require.Equal(t, TransactionEventType, events[1].Type)
+	require.Equal(t, RevertedBlockEventType, events[2].Type)
+	revertedBlock := events[2].Data.(*BlockEvent)
+	require.Equal(t, hex.EncodeToString([]byte("hash")), revertedBlock.Hash)
+	require.Equal(t, uint64(3), revertedBlock.Nonce)
+
+	_ = en.Close()
+}
+
+func TestEventsNotifier_NotifyCommittedBlockWithoutSubscribersShouldNotQueue(t *testing.T) {
+	t.Parallel()
+
+	en, _ := NewEventsNotifier(createMockArgs())
+	_ = en.Close()
+	require.False(t, en.HasSubscribers())
+
+	en.NotifyCommittedBlock([]byte("hash"), &block.Header{}, createCommittedTxs())
+	require.Equal(t, 0, len(en.notificationsQueue))
+}
+
+func TestEventsNotifier_NotifyCommittedBlockWithFullQueueShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.NotificationsQueueSize = 1
+	en, _ := NewEventsNotifier(args)
+
+	chRelease := make(chan struct{})
+	chStarted := make(chan struct{}, 1)
+	_ = en.RegisterHandler(&committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+			select {
+			case chStarted <- struct{}{}:
+			default:
+			}
+			<-chRelease
+		},
+	})
+
+	// the first block keeps the slow handler busy, the second one fills the queue, the third one is dropped
+	en.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, nil)
+	<-chStarted
+	en.NotifyCommittedBlock([]byte("hash2"), &block.Header{Nonce: 2}, nil)
+	en.NotifyCommittedBlock([]byte("hash3"), &block.Header{Nonce: 3}, nil)
+	require.Equal(t, 1, len(en.notificationsQueue))
+
+	close(chRelease)
+	_ = en.Close()
+}
+
+func TestEventsNotifier_DroppedBlocksShouldBeSignaledToTheSubscribers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.NotificationsQueueSize = 1
+	en, _ := NewEventsNotifier(args)
+	subscription, _ := en.Subscribe(SubscriptionFilter{Blocks: true})
+
+	chRelease := make(chan struct{})
+	chDispatched := make(chan uint64, 10)
+	_ = en.RegisterHandler(&committedBlockHandlerStub{
+		notifyCommittedBlockCalled: func(_ []byte, header data.HeaderHandler, _ map[string]data.TransactionHandler) {
+			chDispatched <- header.GetNonce()
+			<-chRelease
+		},
+	})
+
+	// the first block keeps the slow handler busy, the second one fills the queue, the next ones are dropped
+	en.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, nil)
+	require.Equal(t, uint64(1), <-chDispatched)
+	en.NotifyCommittedBlock([]byte("hash2"), &block.Header{Nonce: 2}, nil)
+	en.NotifyCommittedBlock([]byte("hash3"), &block.Header{Nonce: 3}, nil)
+	en.NotifyRevertedBlock([]byte("hash3"), &block.Header{Nonce: 3})
+	en.NotifyCommittedBlock([]byte("hash4"), &block.Header{Nonce: 4}, nil)
+
+	close(chRelease)
+	require.Equal(t, uint64(2), <-chDispatched)
+	en.NotifyCommittedBlock([]byte("hash5"), &block.Header{Nonce: 5}, nil)
+	require.Equal(t, uint64(5), <-chDispatched)
+
+	events := drainEvents(subscription)
+	require.Equal(t, 4, len(events))
+	require.Equal(t, uint64(1), events[0].Data.(*BlockEvent).Nonce)
+	require.Equal(t, uint64(2), events[1].Data.(*BlockEvent).Nonce)
+	require.Equal(t, GapEventType, events[2].Type)
+	require.Equal(t, &GapEvent{NumDroppedBlocks: 3, LowestNonce: 3, HighestNonce: 4}, events[2].Data)
+	require.Equal(t, uint64(5), events[3].Data.(*BlockEvent).Nonce)
+
+	_ = en.Close()
+}
@@ -0,0 +1,13 @@
+package eventsNotifier
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// CommittedBlockHandler defines a node component which needs to process every committed and reverted block, unlike
+// the subscribers which can be dropped if they can not keep up. Handlers are called on the notifier's go routine, in
+// the order the blocks were committed or reverted. The transactions provided with a committed block are only guaranteed
+// to contain its user transactions
+type CommittedBlockHandler interface {
+	NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler)
+	NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,83 @@
+package eventsNotifier
+
+import "sync"
+
+// Subscription holds the events channel of a subscriber
+type Subscription struct {
+	id          uint64
+	events      chan *Event
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+	filter      SubscriptionFilter
+	closeOnce   sync.Once
+}
+
+func newSubscription(id uint64, filter SubscriptionFilter, bufferSize int) *Subscription {
+	return &Subscription{
+		id:          id,
+		events:      make(chan *Event, bufferSize),
+		addresses:   sliceToSet(filter.Addresses),
+		identifiers: sliceToSet(filter.LogIdentifiers),
+		filter:      filter,
+	}
+}
+
+// ID returns the subscription identifier
+func (s *Subscription) ID() uint64 {
+	return s.id
+}
+
+// Events returns the channel on which the events are sent. The channel is closed when the subscription
+// is cancelled or when the subscriber could not keep up with the produced events
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+func (s *Subscription) matchesAddresses(addresses ...[]byte) bool {
+	if len(s.addresses) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		_, found := s.addresses[string(address)]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Subscription) matchesIdentifier(identifier []byte) bool {
+	if len(s.identifiers) == 0 {
+		return true
+	}
+
+	_, found := s.identifiers[string(identifier)]
+	return found
+}
+
+// send will try to push the event without blocking, returning false if the buffer is full
+func (s *Subscription) send(event *Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) close() {
+	s.closeOnce.Do(func() {
+		close(s.events)
+	})
+}
+
+func sliceToSet(values [][]byte) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[string(value)] = struct{}{}
+	}
+
+	return set
+}
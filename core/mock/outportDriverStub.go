@@ -0,0 +1,83 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// OutportDriverStub -
+type OutportDriverStub struct {
+	SaveBlockCalled             func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64, notarizedHeadersHashes []string, headerHash []byte)
+	RevertIndexedBlockCalled    func(header data.HeaderHandler, body data.BodyHandler)
+	SaveRoundsInfoCalled        func(roundsInfos []workItems.RoundInfo)
+	UpdateTPSCalled             func(tpsBenchmark statistics.TPSBenchmark)
+	SaveValidatorsPubKeysCalled func(validatorsPubKeys map[uint32][][]byte, epoch uint32)
+	SaveValidatorsRatingCalled  func(indexID string, infoRating []workItems.ValidatorRatingInfo)
+	SaveAccountsCalled          func(acc []state.UserAccountHandler)
+	CloseCalled                 func() error
+}
+
+// SaveBlock -
+func (ods *OutportDriverStub) SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64, notarizedHeadersHashes []string, headerHash []byte) {
+	if ods.SaveBlockCalled != nil {
+		ods.SaveBlockCalled(body, header, txPool, signersIndexes, notarizedHeadersHashes, headerHash)
+	}
+}
+
+// RevertIndexedBlock -
+func (ods *OutportDriverStub) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if ods.RevertIndexedBlockCalled != nil {
+		ods.RevertIndexedBlockCalled(header, body)
+	}
+}
+
+// SaveRoundsInfo -
+func (ods *OutportDriverStub) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	if ods.SaveRoundsInfoCalled != nil {
+		ods.SaveRoundsInfoCalled(roundsInfos)
+	}
+}
+
+// UpdateTPS -
+func (ods *OutportDriverStub) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if ods.UpdateTPSCalled != nil {
+		ods.UpdateTPSCalled(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys -
+func (ods *OutportDriverStub) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	if ods.SaveValidatorsPubKeysCalled != nil {
+		ods.SaveValidatorsPubKeysCalled(validatorsPubKeys, epoch)
+	}
+}
+
+// SaveValidatorsRating -
+func (ods *OutportDriverStub) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	if ods.SaveValidatorsRatingCalled != nil {
+		ods.SaveValidatorsRatingCalled(indexID, infoRating)
+	}
+}
+
+// SaveAccounts -
+func (ods *OutportDriverStub) SaveAccounts(acc []state.UserAccountHandler) {
+	if ods.SaveAccountsCalled != nil {
+		ods.SaveAccountsCalled(acc)
+	}
+}
+
+// Close -
+func (ods *OutportDriverStub) Close() error {
+	if ods.CloseCalled != nil {
+		return ods.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ods *OutportDriverStub) IsInterfaceNil() bool {
+	return ods == nil
+}
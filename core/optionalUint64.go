@@ -0,0 +1,7 @@
+package core
+
+// OptionalUint64 holds a uint64 value that might not be set
+type OptionalUint64 struct {
+	Value    uint64
+	HasValue bool
+}
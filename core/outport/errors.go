@@ -0,0 +1,12 @@
+package outport
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilArgsOutportFactory signals that nil arguments have been provided to the outport factory
+var ErrNilArgsOutportFactory = errors.New("nil args outport factory")
+
+// ErrEmptyFilePath signals that an empty file path has been provided for the file driver
+var ErrEmptyFilePath = errors.New("empty file path for the outport file driver")
@@ -0,0 +1,84 @@
+package factory
+
+import (
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	"github.com/ElrondNetwork/elrond-go/core/outport/ndjson"
+)
+
+// ArgsFileDriverFactory holds the arguments needed to create the newline delimited JSON file driver
+type ArgsFileDriverFactory struct {
+	Enabled  bool
+	FilePath string
+}
+
+// ArgsOutportFactory holds the arguments needed to create the outport and all its drivers
+type ArgsOutportFactory struct {
+	ElasticIndexerFactoryArgs *indexerFactory.ArgsIndexerFactory
+	FileDriverFactoryArgs     ArgsFileDriverFactory
+}
+
+// CreateOutport will create a new outport and will subscribe all the enabled drivers
+func CreateOutport(args *ArgsOutportFactory) (outport.OutportHandler, error) {
+	if args == nil || args.ElasticIndexerFactoryArgs == nil {
+		return nil, outport.ErrNilArgsOutportFactory
+	}
+
+	outportHandler := outport.NewOutport()
+
+	err := createAndSubscribeElasticDriver(outportHandler, args.ElasticIndexerFactoryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createAndSubscribeFileDriver(outportHandler, args)
+	if err != nil {
+		_ = outportHandler.Close()
+		return nil, err
+	}
+
+	return outportHandler, nil
+}
+
+func createAndSubscribeElasticDriver(outportHandler outport.OutportHandler, args *indexerFactory.ArgsIndexerFactory) error {
+	elasticIndexer, err := indexerFactory.NewIndexer(args)
+	if err != nil {
+		return err
+	}
+	if elasticIndexer.IsNilIndexer() {
+		return nil
+	}
+
+	return outportHandler.SubscribeDriver(elasticIndexer)
+}
+
+func createAndSubscribeFileDriver(outportHandler outport.OutportHandler, args *ArgsOutportFactory) error {
+	if !args.FileDriverFactoryArgs.Enabled {
+		return nil
+	}
+	if len(args.FileDriverFactoryArgs.FilePath) == 0 {
+		return outport.ErrEmptyFilePath
+	}
+
+	file, err := os.OpenFile(args.FileDriverFactoryArgs.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	fileDriver, err := ndjson.NewDriver(ndjson.ArgsDriver{
+		Writer:                   file,
+		Marshalizer:              args.ElasticIndexerFactoryArgs.Marshalizer,
+		Hasher:                   args.ElasticIndexerFactoryArgs.Hasher,
+		AddressPubkeyConverter:   args.ElasticIndexerFactoryArgs.AddressPubkeyConverter,
+		ValidatorPubkeyConverter: args.ElasticIndexerFactoryArgs.ValidatorPubkeyConverter,
+	})
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return outportHandler.SubscribeDriver(fileDriver)
+}
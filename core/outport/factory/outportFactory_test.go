@@ -0,0 +1,105 @@
+package factory
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsOutportFactory() *ArgsOutportFactory {
+	return &ArgsOutportFactory{
+		ElasticIndexerFactoryArgs: &indexerFactory.ArgsIndexerFactory{
+			Enabled:                  false,
+			IndexerCacheSize:         100,
+			Url:                      "url",
+			Marshalizer:              &mock.MarshalizerMock{},
+			Hasher:                   &mock.HasherMock{},
+			EpochStartNotifier:       &mock.EpochStartNotifierStub{},
+			NodesCoordinator:         &mock.NodesCoordinatorMock{},
+			AddressPubkeyConverter:   &mock.PubkeyConverterMock{},
+			ValidatorPubkeyConverter: &mock.PubkeyConverterMock{},
+			Options:                  &indexer.Options{},
+			AccountsDB:               &mock.AccountsStub{},
+			TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
+			ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		},
+	}
+}
+
+func TestCreateOutport_NilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	outportHandler, err := CreateOutport(nil)
+	assert.Nil(t, outportHandler)
+	assert.Equal(t, outport.ErrNilArgsOutportFactory, err)
+}
+
+func TestCreateOutport_InvalidElasticArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOutportFactory()
+	args.ElasticIndexerFactoryArgs.Marshalizer = nil
+
+	outportHandler, err := CreateOutport(args)
+	assert.Nil(t, outportHandler)
+	assert.NotNil(t, err)
+}
+
+func TestCreateOutport_NoDriverEnabledShouldReturnOutportWithoutDrivers(t *testing.T) {
+	t.Parallel()
+
+	outportHandler, err := CreateOutport(createMockArgsOutportFactory())
+	require.Nil(t, err)
+	assert.False(t, outportHandler.HasDrivers())
+	assert.True(t, outportHandler.IsNilIndexer())
+}
+
+func TestCreateOutport_FileDriverEmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOutportFactory()
+	args.FileDriverFactoryArgs.Enabled = true
+
+	outportHandler, err := CreateOutport(args)
+	assert.Nil(t, outportHandler)
+	assert.Equal(t, outport.ErrEmptyFilePath, err)
+}
+
+func TestCreateOutport_FileDriverShouldWriteInFile(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "outport")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "outport.ndjson")
+	args := createMockArgsOutportFactory()
+	args.FileDriverFactoryArgs = ArgsFileDriverFactory{
+		Enabled:  true,
+		FilePath: filePath,
+	}
+
+	outportHandler, err := CreateOutport(args)
+	require.Nil(t, err)
+	assert.True(t, outportHandler.HasDrivers())
+
+	outportHandler.SaveBlock(&block.Body{}, &block.Header{Nonce: 1}, nil, nil, nil, []byte("hash"))
+	err = outportHandler.Close()
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	assert.Contains(t, string(content), `"type":"block"`)
+}
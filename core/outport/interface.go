@@ -0,0 +1,31 @@
+package outport
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// Driver defines the behavior of a component able to export the node's committed data to an external sink.
+// Elasticsearch is one such sink, but it could as well be a file, a message queue or a relational database
+type Driver interface {
+	SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler,
+		signersIndexes []uint64, notarizedHeadersHashes []string, headerHash []byte)
+	RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler)
+	SaveRoundsInfo(roundsInfos []workItems.RoundInfo)
+	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
+	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32)
+	SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo)
+	SaveAccounts(acc []state.UserAccountHandler)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// OutportHandler is the indexer implementation that fans out all the received data to the subscribed drivers
+type OutportHandler interface {
+	indexer.Indexer
+	SubscribeDriver(driver Driver) error
+	HasDrivers() bool
+}
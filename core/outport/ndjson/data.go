@@ -0,0 +1,105 @@
+package ndjson
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+)
+
+const (
+	// BlockRecordType is the type of the record written when a block is saved
+	BlockRecordType = "block"
+	// RevertBlockRecordType is the type of the record written when a block is reverted
+	RevertBlockRecordType = "revertBlock"
+	// RoundsRecordType is the type of the record written when rounds information is saved
+	RoundsRecordType = "rounds"
+	// TPSRecordType is the type of the record written when the tps benchmark is updated
+	TPSRecordType = "tps"
+	// ValidatorsPubKeysRecordType is the type of the record written when the validators public keys are saved
+	ValidatorsPubKeysRecordType = "validatorsPubKeys"
+	// ValidatorsRatingRecordType is the type of the record written when the validators rating is saved
+	ValidatorsRatingRecordType = "validatorsRating"
+	// AccountsRecordType is the type of the record written when accounts are saved
+	AccountsRecordType = "accounts"
+)
+
+// Record is the structure written on each line of the output
+type Record struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// Block holds the exported data of a committed block
+type Block struct {
+	Hash                   string         `json:"hash"`
+	Nonce                  uint64         `json:"nonce"`
+	Round                  uint64         `json:"round"`
+	Epoch                  uint32         `json:"epoch"`
+	ShardID                uint32         `json:"shardId"`
+	TimeStamp              uint64         `json:"timestamp"`
+	PrevHash               string         `json:"prevHash"`
+	StateRootHash          string         `json:"stateRootHash"`
+	SignersIndexes         []uint64       `json:"signersIndexes"`
+	NotarizedHeadersHashes []string       `json:"notarizedBlocksHashes,omitempty"`
+	MiniBlocks             []*MiniBlock   `json:"miniBlocks,omitempty"`
+	Transactions           []*Transaction `json:"transactions,omitempty"`
+}
+
+// MiniBlock holds the exported data of a miniblock
+type MiniBlock struct {
+	Hash            string   `json:"hash"`
+	Type            string   `json:"type"`
+	SenderShardID   uint32   `json:"senderShard"`
+	ReceiverShardID uint32   `json:"receiverShard"`
+	TxHashes        []string `json:"txHashes"`
+}
+
+// Transaction holds the exported data of a transaction
+type Transaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Value    string `json:"value"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+// RevertedBlock holds the exported data of a reverted block
+type RevertedBlock struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	ShardID uint32 `json:"shardId"`
+}
+
+// TPS holds the exported data of the tps benchmark
+type TPS struct {
+	ActiveNodes           uint32  `json:"activeNodes"`
+	BlockNumber           uint64  `json:"blockNumber"`
+	RoundNumber           uint64  `json:"roundNumber"`
+	RoundTime             uint64  `json:"roundTime"`
+	LastBlockTxCount      uint32  `json:"lastBlockTxCount"`
+	TotalProcessedTxCount string  `json:"totalProcessedTxCount"`
+	LiveTPS               float64 `json:"liveTPS"`
+	PeakTPS               float64 `json:"peakTPS"`
+	NrOfShards            uint32  `json:"nrOfShards"`
+}
+
+// ValidatorsPubKeys holds the exported public keys of the validators, grouped by shard
+type ValidatorsPubKeys struct {
+	Epoch      uint32              `json:"epoch"`
+	PublicKeys map[uint32][]string `json:"publicKeys"`
+}
+
+// ValidatorsRating holds the exported validators rating
+type ValidatorsRating struct {
+	IndexID    string                          `json:"indexId"`
+	InfoRating []workItems.ValidatorRatingInfo `json:"infoRating"`
+}
+
+// Account holds the exported data of an account
+type Account struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+}
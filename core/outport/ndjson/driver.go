@@ -0,0 +1,298 @@
+package ndjson
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("core/outport/ndjson")
+
+// ArgsDriver holds the arguments needed to create a newline delimited JSON driver
+type ArgsDriver struct {
+	Writer                   io.Writer
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+}
+
+type driver struct {
+	mutWriter                sync.Mutex
+	writer                   io.Writer
+	encoder                  *json.Encoder
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// NewDriver creates a new outport driver that writes every received item as a JSON object on a separate line.
+// If the provided writer is also an io.Closer, it will be closed when the driver is closed
+func NewDriver(args ArgsDriver) (*driver, error) {
+	if args.Writer == nil {
+		return nil, ErrNilWriter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &driver{
+		writer:                   args.Writer,
+		encoder:                  json.NewEncoder(args.Writer),
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		addressPubkeyConverter:   args.AddressPubkeyConverter,
+		validatorPubkeyConverter: args.ValidatorPubkeyConverter,
+	}, nil
+}
+
+// SaveBlock writes the committed block together with its miniblocks and transactions
+func (d *driver) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	if check.IfNil(header) {
+		return
+	}
+
+	exportedBlock := &Block{
+		Hash:                   hex.EncodeToString(headerHash),
+		Nonce:                  header.GetNonce(),
+		Round:                  header.GetRound(),
+		Epoch:                  header.GetEpoch(),
+		ShardID:                header.GetShardID(),
+		TimeStamp:              header.GetTimeStamp(),
+		PrevHash:               hex.EncodeToString(header.GetPrevHash()),
+		StateRootHash:          hex.EncodeToString(header.GetRootHash()),
+		SignersIndexes:         signersIndexes,
+		NotarizedHeadersHashes: notarizedHeadersHashes,
+		MiniBlocks:             d.prepareMiniBlocks(body),
+		Transactions:           d.prepareTransactions(txPool),
+	}
+
+	d.write(BlockRecordType, exportedBlock)
+}
+
+func (d *driver) prepareMiniBlocks(body data.BodyHandler) []*MiniBlock {
+	blockBody, ok := body.(*block.Body)
+	if !ok || blockBody == nil {
+		return nil
+	}
+
+	miniBlocks := make([]*MiniBlock, 0, len(blockBody.MiniBlocks))
+	for _, mb := range blockBody.MiniBlocks {
+		if mb == nil {
+			continue
+		}
+
+		mbHash, err := core.CalculateHash(d.marshalizer, d.hasher, mb)
+		if err != nil {
+			log.Warn("ndjson driver: cannot compute miniblock hash", "error", err.Error())
+			continue
+		}
+
+		txHashes := make([]string, 0, len(mb.TxHashes))
+		for _, txHash := range mb.TxHashes {
+			txHashes = append(txHashes, hex.EncodeToString(txHash))
+		}
+
+		miniBlocks = append(miniBlocks, &MiniBlock{
+			Hash:            hex.EncodeToString(mbHash),
+			Type:            mb.Type.String(),
+			SenderShardID:   mb.SenderShardID,
+			ReceiverShardID: mb.ReceiverShardID,
+			TxHashes:        txHashes,
+		})
+	}
+
+	return miniBlocks
+}
+
+func (d *driver) prepareTransactions(txPool map[string]data.TransactionHandler) []*Transaction {
+	txs := make([]*Transaction, 0, len(txPool))
+	for txHash, tx := range txPool {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		txs = append(txs, &Transaction{
+			Hash:     hex.EncodeToString([]byte(txHash)),
+			Nonce:    tx.GetNonce(),
+			Value:    bigIntToString(tx.GetValue()),
+			Sender:   d.encodeAddress(tx.GetSndAddr()),
+			Receiver: d.encodeAddress(tx.GetRcvAddr()),
+			GasPrice: tx.GetGasPrice(),
+			GasLimit: tx.GetGasLimit(),
+			Data:     tx.GetData(),
+		})
+	}
+
+	// the transactions pool is a map, so the output is sorted in order to be deterministic
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Hash < txs[j].Hash
+	})
+
+	return txs
+}
+
+// RevertIndexedBlock writes a record signaling that the provided block was reverted
+func (d *driver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(d.marshalizer, d.hasher, header)
+	if err != nil {
+		log.Warn("ndjson driver: cannot compute header hash", "error", err.Error())
+		return
+	}
+
+	d.write(RevertBlockRecordType, &RevertedBlock{
+		Hash:    hex.EncodeToString(headerHash),
+		Nonce:   header.GetNonce(),
+		Round:   header.GetRound(),
+		ShardID: header.GetShardID(),
+	})
+}
+
+// SaveRoundsInfo writes the provided rounds information
+func (d *driver) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	d.write(RoundsRecordType, roundsInfos)
+}
+
+// UpdateTPS writes the current state of the tps benchmark
+func (d *driver) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if check.IfNil(tpsBenchmark) {
+		return
+	}
+
+	d.write(TPSRecordType, &TPS{
+		ActiveNodes:           tpsBenchmark.ActiveNodes(),
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: bigIntToString(tpsBenchmark.TotalProcessedTxCount()),
+		LiveTPS:               tpsBenchmark.LiveTPS(),
+		PeakTPS:               tpsBenchmark.PeakTPS(),
+		NrOfShards:            tpsBenchmark.NrOfShards(),
+	})
+}
+
+// SaveValidatorsPubKeys writes the validators public keys of the provided epoch
+func (d *driver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	encodedPubKeys := make(map[uint32][]string, len(validatorsPubKeys))
+	for shardID, pubKeys := range validatorsPubKeys {
+		encodedShardPubKeys := make([]string, 0, len(pubKeys))
+		for _, pubKey := range pubKeys {
+			encodedShardPubKeys = append(encodedShardPubKeys, d.validatorPubkeyConverter.Encode(pubKey))
+		}
+		encodedPubKeys[shardID] = encodedShardPubKeys
+	}
+
+	d.write(ValidatorsPubKeysRecordType, &ValidatorsPubKeys{
+		Epoch:      epoch,
+		PublicKeys: encodedPubKeys,
+	})
+}
+
+// SaveValidatorsRating writes the provided validators rating
+func (d *driver) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	d.write(ValidatorsRatingRecordType, &ValidatorsRating{
+		IndexID:    indexID,
+		InfoRating: infoRating,
+	})
+}
+
+// SaveAccounts writes the provided accounts
+func (d *driver) SaveAccounts(acc []state.UserAccountHandler) {
+	accounts := make([]*Account, 0, len(acc))
+	for _, userAccount := range acc {
+		if check.IfNil(userAccount) {
+			continue
+		}
+
+		accounts = append(accounts, &Account{
+			Address: d.encodeAddress(userAccount.AddressBytes()),
+			Nonce:   userAccount.GetNonce(),
+			Balance: bigIntToString(userAccount.GetBalance()),
+		})
+	}
+
+	d.write(AccountsRecordType, accounts)
+}
+
+func (d *driver) write(recordType string, recordData interface{}) {
+	d.mutWriter.Lock()
+	defer d.mutWriter.Unlock()
+
+	err := d.encoder.Encode(&Record{
+		Type: recordType,
+		Data: recordData,
+	})
+	if err != nil {
+		log.Warn("ndjson driver: cannot write record", "type", recordType, "error", err.Error())
+	}
+}
+
+func (d *driver) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return d.addressPubkeyConverter.Encode(address)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// Close will close the underlying writer, if possible
+func (d *driver) Close() error {
+	d.mutWriter.Lock()
+	defer d.mutWriter.Unlock()
+
+	closer, ok := d.writer.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return closer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *driver) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,187 @@
+package ndjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closableBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (cb *closableBuffer) Close() error {
+	cb.closed = true
+	return nil
+}
+
+func createMockArgsDriver(writer *closableBuffer) ArgsDriver {
+	return ArgsDriver{
+		Writer:                   writer,
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(96),
+	}
+}
+
+type rawRecord struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+func readRecords(t *testing.T, buff *closableBuffer) []*rawRecord {
+	records := make([]*rawRecord, 0)
+	scanner := bufio.NewScanner(&buff.Buffer)
+	for scanner.Scan() {
+		record := &rawRecord{}
+		err := json.Unmarshal(scanner.Bytes(), record)
+		require.Nil(t, err)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestNewDriver(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDriver(&closableBuffer{})
+	args.Writer = nil
+	d, err := NewDriver(args)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrNilWriter, err)
+
+	args = createMockArgsDriver(&closableBuffer{})
+	args.Marshalizer = nil
+	d, err = NewDriver(args)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsDriver(&closableBuffer{})
+	args.Hasher = nil
+	d, err = NewDriver(args)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgsDriver(&closableBuffer{})
+	args.AddressPubkeyConverter = nil
+	d, err = NewDriver(args)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgsDriver(&closableBuffer{})
+	args.ValidatorPubkeyConverter = nil
+	d, err = NewDriver(args)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	d, err = NewDriver(createMockArgsDriver(&closableBuffer{}))
+	assert.False(t, check.IfNil(d))
+	assert.Nil(t, err)
+}
+
+func TestDriver_SaveBlockShouldWriteOneLine(t *testing.T) {
+	t.Parallel()
+
+	buff := &closableBuffer{}
+	d, _ := NewDriver(createMockArgsDriver(buff))
+
+	header := &block.Header{
+		Nonce:    10,
+		Round:    11,
+		Epoch:    2,
+		ShardID:  1,
+		PrevHash: []byte("prev"),
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{[]byte("tx1"), []byte("tx2")},
+				SenderShardID:   1,
+				ReceiverShardID: 0,
+				Type:            block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		"tx2": &transaction.Transaction{Nonce: 2, Value: big.NewInt(20), SndAddr: []byte("snd"), RcvAddr: []byte("rcv")},
+		"tx1": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("snd"), RcvAddr: []byte("rcv")},
+	}
+
+	d.SaveBlock(body, header, txPool, []uint64{0, 1}, nil, []byte("hash"))
+
+	records := readRecords(t, buff)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, BlockRecordType, records[0].Type)
+
+	exportedBlock := &Block{}
+	err := json.Unmarshal(records[0].Data, exportedBlock)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), exportedBlock.Hash)
+	assert.Equal(t, uint64(10), exportedBlock.Nonce)
+	assert.Equal(t, uint64(11), exportedBlock.Round)
+	assert.Equal(t, uint32(2), exportedBlock.Epoch)
+	assert.Equal(t, uint32(1), exportedBlock.ShardID)
+	assert.Equal(t, []uint64{0, 1}, exportedBlock.SignersIndexes)
+	require.Equal(t, 1, len(exportedBlock.MiniBlocks))
+	assert.Equal(t, block.TxBlock.String(), exportedBlock.MiniBlocks[0].Type)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("tx1")), hex.EncodeToString([]byte("tx2"))}, exportedBlock.MiniBlocks[0].TxHashes)
+	require.Equal(t, 2, len(exportedBlock.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), exportedBlock.Transactions[0].Hash)
+	assert.Equal(t, "10", exportedBlock.Transactions[0].Value)
+	assert.Equal(t, hex.EncodeToString([]byte("snd")), exportedBlock.Transactions[0].Sender)
+	assert.Equal(t, hex.EncodeToString([]byte("rcv")), exportedBlock.Transactions[0].Receiver)
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), exportedBlock.Transactions[1].Hash)
+}
+
+func TestDriver_AllMethodsShouldWriteRecords(t *testing.T) {
+	t.Parallel()
+
+	buff := &closableBuffer{}
+	d, _ := NewDriver(createMockArgsDriver(buff))
+
+	d.RevertIndexedBlock(&block.Header{Nonce: 5}, &block.Body{})
+	d.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7}})
+	d.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk0")}}, 3)
+	d.SaveValidatorsRating("0_3", []workItems.ValidatorRatingInfo{{PublicKey: "pk0", Rating: 50}})
+	d.SaveAccounts(nil)
+
+	records := readRecords(t, buff)
+	require.Equal(t, 5, len(records))
+	assert.Equal(t, RevertBlockRecordType, records[0].Type)
+	assert.Equal(t, RoundsRecordType, records[1].Type)
+	assert.Equal(t, ValidatorsPubKeysRecordType, records[2].Type)
+	assert.Equal(t, ValidatorsRatingRecordType, records[3].Type)
+	assert.Equal(t, AccountsRecordType, records[4].Type)
+
+	validatorsPubKeys := &ValidatorsPubKeys{}
+	err := json.Unmarshal(records[2].Data, validatorsPubKeys)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(3), validatorsPubKeys.Epoch)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("pk0"))}, validatorsPubKeys.PublicKeys[0])
+}
+
+func TestDriver_CloseShouldCloseTheWriter(t *testing.T) {
+	t.Parallel()
+
+	buff := &closableBuffer{}
+	d, _ := NewDriver(createMockArgsDriver(buff))
+
+	err := d.Close()
+	assert.Nil(t, err)
+	assert.True(t, buff.closed)
+}
@@ -0,0 +1,172 @@
+package outport
+
+import (
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("core/outport")
+
+// txLogsProcessorSetter is implemented by the drivers that need the transaction logs processor (e.g. Elasticsearch)
+type txLogsProcessorSetter interface {
+	SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase)
+}
+
+type outport struct {
+	mutDrivers sync.RWMutex
+	drivers    []Driver
+}
+
+// NewOutport will create a new outport component with no drivers subscribed
+func NewOutport() *outport {
+	return &outport{
+		drivers: make([]Driver, 0),
+	}
+}
+
+// SubscribeDriver adds a new driver that will receive all the data pushed in the outport
+func (o *outport) SubscribeDriver(driver Driver) error {
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+
+	o.mutDrivers.Lock()
+	o.drivers = append(o.drivers, driver)
+	o.mutDrivers.Unlock()
+
+	return nil
+}
+
+// HasDrivers returns true if at least one driver is subscribed
+func (o *outport) HasDrivers() bool {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	return len(o.drivers) > 0
+}
+
+// SetTxLogsProcessor will propagate the transaction logs processor to the drivers that require it
+func (o *outport) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		setter, ok := driver.(txLogsProcessorSetter)
+		if !ok {
+			continue
+		}
+
+		setter.SetTxLogsProcessor(txLogsProc)
+	}
+}
+
+// SaveBlock will push the committed block to all drivers
+func (o *outport) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.SaveBlock(body, header, txPool, signersIndexes, notarizedHeadersHashes, headerHash)
+	}
+}
+
+// RevertIndexedBlock will push the reverted block to all drivers
+func (o *outport) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.RevertIndexedBlock(header, body)
+	}
+}
+
+// SaveRoundsInfo will push the rounds information to all drivers
+func (o *outport) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.SaveRoundsInfo(roundsInfos)
+	}
+}
+
+// UpdateTPS will push the tps benchmark to all drivers
+func (o *outport) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.UpdateTPS(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys will push the validators public keys to all drivers
+func (o *outport) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	}
+}
+
+// SaveValidatorsRating will push the validators rating to all drivers
+func (o *outport) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.SaveValidatorsRating(indexID, infoRating)
+	}
+}
+
+// SaveAccounts will push the accounts to all drivers
+func (o *outport) SaveAccounts(acc []state.UserAccountHandler) {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	for _, driver := range o.drivers {
+		driver.SaveAccounts(acc)
+	}
+}
+
+// Close will close all the subscribed drivers
+func (o *outport) Close() error {
+	o.mutDrivers.RLock()
+	defer o.mutDrivers.RUnlock()
+
+	var lastError error
+	for _, driver := range o.drivers {
+		err := driver.Close()
+		if err != nil {
+			log.Error("error closing outport driver", "error", err.Error())
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+// IsNilIndexer returns true if no driver is subscribed, so the callers can skip preparing the data to be exported
+func (o *outport) IsNilIndexer() bool {
+	return !o.HasDrivers()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (o *outport) IsInterfaceNil() bool {
+	return o == nil
+}
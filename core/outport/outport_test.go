@@ -0,0 +1,126 @@
+package outport
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+type driverWithTxLogsProcessor struct {
+	mock.OutportDriverStub
+	txLogsProc process.TransactionLogProcessorDatabase
+}
+
+func (d *driverWithTxLogsProcessor) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	d.txLogsProc = txLogsProc
+}
+
+func TestOutport_SubscribeDriverNilDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	o := NewOutport()
+
+	err := o.SubscribeDriver(nil)
+	assert.Equal(t, ErrNilDriver, err)
+	assert.False(t, o.HasDrivers())
+	assert.True(t, o.IsNilIndexer())
+}
+
+func TestOutport_SubscribeDriverShouldWork(t *testing.T) {
+	t.Parallel()
+
+	o := NewOutport()
+
+	err := o.SubscribeDriver(&mock.OutportDriverStub{})
+	assert.Nil(t, err)
+	assert.True(t, o.HasDrivers())
+	assert.False(t, o.IsNilIndexer())
+}
+
+func TestOutport_ShouldFanOutToAllDrivers(t *testing.T) {
+	t.Parallel()
+
+	numDrivers := 3
+	numCalls := make(map[string]int)
+	o := NewOutport()
+	for i := 0; i < numDrivers; i++ {
+		_ = o.SubscribeDriver(&mock.OutportDriverStub{
+			SaveBlockCalled: func(_ data.BodyHandler, _ data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+				numCalls["SaveBlock"]++
+			},
+			RevertIndexedBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler) {
+				numCalls["RevertIndexedBlock"]++
+			},
+			SaveRoundsInfoCalled: func(_ []workItems.RoundInfo) {
+				numCalls["SaveRoundsInfo"]++
+			},
+			SaveValidatorsPubKeysCalled: func(_ map[uint32][][]byte, _ uint32) {
+				numCalls["SaveValidatorsPubKeys"]++
+			},
+			SaveValidatorsRatingCalled: func(_ string, _ []workItems.ValidatorRatingInfo) {
+				numCalls["SaveValidatorsRating"]++
+			},
+			SaveAccountsCalled: func(_ []state.UserAccountHandler) {
+				numCalls["SaveAccounts"]++
+			},
+		})
+	}
+
+	o.SaveBlock(&block.Body{}, &block.Header{}, nil, nil, nil, []byte("hash"))
+	o.RevertIndexedBlock(&block.Header{}, &block.Body{})
+	o.SaveRoundsInfo([]workItems.RoundInfo{{Index: 1}})
+	o.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk")}}, 1)
+	o.SaveValidatorsRating("0_1", nil)
+	o.SaveAccounts(nil)
+
+	assert.Equal(t, 6, len(numCalls))
+	for method, calls := range numCalls {
+		assert.Equal(t, numDrivers, calls, method)
+	}
+}
+
+func TestOutport_SetTxLogsProcessorShouldPropagateOnlyToDriversThatNeedIt(t *testing.T) {
+	t.Parallel()
+
+	driver := &driverWithTxLogsProcessor{}
+	o := NewOutport()
+	_ = o.SubscribeDriver(&mock.OutportDriverStub{})
+	_ = o.SubscribeDriver(driver)
+
+	txLogsProc := disabled.NewNilTxLogsProcessor()
+	o.SetTxLogsProcessor(txLogsProc)
+
+	assert.True(t, driver.txLogsProc == txLogsProc)
+}
+
+func TestOutport_CloseShouldCloseAllDrivers(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numClosed := 0
+	o := NewOutport()
+	_ = o.SubscribeDriver(&mock.OutportDriverStub{
+		CloseCalled: func() error {
+			numClosed++
+			return expectedErr
+		},
+	})
+	_ = o.SubscribeDriver(&mock.OutportDriverStub{
+		CloseCalled: func() error {
+			numClosed++
+			return nil
+		},
+	})
+
+	err := o.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numClosed)
+}
@@ -0,0 +1,22 @@
+package api
+
+// AccountKeyValuePair holds a hex encoded key-value pair from the data trie of an account. The ESDT field is set
+// only for the keys holding ESDT balances
+type AccountKeyValuePair struct {
+	Key   string            `json:"key"`
+	Value string            `json:"value"`
+	ESDT  *ESDTKeyValueData `json:"esdt,omitempty"`
+}
+
+// ESDTKeyValueData holds the ESDT balance decoded from an ESDT-prefixed key-value pair
+type ESDTKeyValueData struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Balance         string `json:"balance"`
+	Properties      string `json:"properties"`
+}
+
+// AccountKeyValuePairsPage holds a page of the key-value pairs from the data trie of an account, in trie order
+type AccountKeyValuePairsPage struct {
+	Pairs   []*AccountKeyValuePair `json:"pairs"`
+	HasMore bool                   `json:"hasMore"`
+}
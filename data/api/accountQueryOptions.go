@@ -0,0 +1,10 @@
+package api
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// AccountQueryOptions holds the options used to select the state an account is read from. When none of the
+// options is set, the account is read from the current state
+type AccountQueryOptions struct {
+	BlockNonce core.OptionalUint64
+	BlockHash  []byte
+}
@@ -30,3 +30,19 @@ type MiniBlock struct {
 	DestinationShard uint32                              `json:"destinationShard"`
 	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
 }
+
+// Hyperblock holds a metablock together with the transactions of all the shard blocks it notarizes.
+// Partial is set when some of the notarized miniblocks are not available in the node's storage, in which
+// case their hashes are listed in MissingMiniBlocks and their transactions are not included
+type Hyperblock struct {
+	Nonce             uint64                              `json:"nonce"`
+	Round             uint64                              `json:"round"`
+	Hash              string                              `json:"hash"`
+	PrevBlockHash     string                              `json:"prevBlockHash"`
+	Epoch             uint32                              `json:"epoch"`
+	NumTxs            uint32                              `json:"numTxs"`
+	ShardBlocks       []*NotarizedBlock                   `json:"shardBlocks"`
+	Transactions      []*transaction.ApiTransactionResult `json:"transactions"`
+	Partial           bool                                `json:"partial"`
+	MissingMiniBlocks []string                            `json:"missingMiniBlocks,omitempty"`
+}
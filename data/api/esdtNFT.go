@@ -0,0 +1,16 @@
+package api
+
+// ESDTNFTData holds an instance of a non fungible or semi fungible ESDT token held by an account, along with the
+// metadata saved at its creation
+type ESDTNFTData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Nonce           uint64   `json:"nonce"`
+	Type            string   `json:"type"`
+	Balance         string   `json:"balance"`
+	Name            string   `json:"name"`
+	Creator         string   `json:"creator"`
+	Royalties       uint32   `json:"royalties"`
+	Hash            string   `json:"hash"`
+	Attributes      string   `json:"attributes"`
+	URIs            []string `json:"uris"`
+}
@@ -0,0 +1,14 @@
+package api
+
+// GasPriceSuggestion holds the gas prices suggested for a transaction to be included in a block with a low, medium or
+// high priority, as computed from the gas prices of the recently committed transactions and the transactions pool
+// fullness (0 for an empty pool, 1 for a full pool)
+type GasPriceSuggestion struct {
+	Low             uint64  `json:"low"`
+	Median          uint64  `json:"median"`
+	High            uint64  `json:"high"`
+	MinGasPrice     uint64  `json:"minGasPrice"`
+	NumBlocks       int     `json:"numBlocks"`
+	NumTransactions int     `json:"numTransactions"`
+	PoolFullness    float64 `json:"poolFullness"`
+}
@@ -0,0 +1,32 @@
+package api
+
+// StorageUsage holds the disk space used by the node's databases, split per shard, per epoch and per storage unit
+type StorageUsage struct {
+	Timestamp  int64                `json:"timestamp"`
+	TotalBytes uint64               `json:"totalBytes"`
+	OtherBytes uint64               `json:"otherBytes"`
+	Shards     []*ShardStorageUsage `json:"shards"`
+}
+
+// ShardStorageUsage holds the disk space used by the databases of a shard. The static storage units are the ones
+// which are not split by epochs
+type ShardStorageUsage struct {
+	Shard       string               `json:"shard"`
+	TotalBytes  uint64               `json:"totalBytes"`
+	StaticBytes uint64               `json:"staticBytes"`
+	Static      []*UnitStorageUsage  `json:"static"`
+	Epochs      []*EpochStorageUsage `json:"epochs"`
+}
+
+// EpochStorageUsage holds the disk space used by the storage units of an epoch
+type EpochStorageUsage struct {
+	Epoch      uint32              `json:"epoch"`
+	TotalBytes uint64              `json:"totalBytes"`
+	Units      []*UnitStorageUsage `json:"units"`
+}
+
+// UnitStorageUsage holds the disk space used by a storage unit directory
+type UnitStorageUsage struct {
+	Unit  string `json:"unit"`
+	Bytes uint64 `json:"bytes"`
+}
@@ -0,0 +1,44 @@
+package api
+
+// TransactionsPool holds the number of transactions (and their size) found in each cache of the transactions pool
+type TransactionsPool struct {
+	NumTxs   int64                    `json:"numTxs"`
+	NumBytes int64                    `json:"numBytes"`
+	Caches   []*TransactionsPoolCache `json:"caches"`
+}
+
+// TransactionsPoolCache holds the number of transactions (and their size) found in a cache of the transactions pool.
+// The cache identifier is either a shard ID, for the transactions sent from that shard, or a source_destination
+// shards pair
+type TransactionsPoolCache struct {
+	CacheID  string `json:"cacheId"`
+	NumTxs   int64  `json:"numTxs"`
+	NumBytes int64  `json:"numBytes"`
+}
+
+// TransactionsPoolForSender holds the pending transactions of a sender, sorted by nonce, along with the nonce gaps
+// which prevent them from being executed
+type TransactionsPoolForSender struct {
+	Sender       string             `json:"sender"`
+	AccountNonce uint64             `json:"accountNonce"`
+	PendingNonce uint64             `json:"pendingNonce"`
+	Transactions []*PoolTransaction `json:"transactions"`
+	NonceGaps    []*NonceGap        `json:"nonceGaps"`
+}
+
+// PoolTransaction holds the main fields of a transaction found in the transactions pool
+type PoolTransaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+// NonceGap holds an interval of nonces (both ends included) missing from the pending transactions of a sender
+type NonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
@@ -0,0 +1,17 @@
+package api
+
+// TrieProof holds a hex encoded Merkle proof of a key, as the encoded trie nodes from the root node to the leaf node,
+// along with the root hash it was computed against and the raw value stored in the leaf node
+type TrieProof struct {
+	RootHash string   `json:"rootHash"`
+	Key      string   `json:"key"`
+	Value    string   `json:"value"`
+	Proof    []string `json:"proof"`
+}
+
+// AccountKeyProof holds the proof of an account in the accounts trie along with the proof of a key in the data trie
+// of that account. The key proof is computed against the data trie root hash stored in the proven account
+type AccountKeyProof struct {
+	AccountProof *TrieProof `json:"accountProof"`
+	KeyProof     *TrieProof `json:"keyProof"`
+}
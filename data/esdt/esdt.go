@@ -1,7 +1,11 @@
 //go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. esdt.proto
 package esdt
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
 
 // New returns a new batch from given buffers
 func New() *ESDigitalToken {
@@ -9,3 +13,28 @@ func New() *ESDigitalToken {
 		Value: big.NewInt(0),
 	}
 }
+
+// ESDTType defines the possible types in case of ESDT tokens
+type ESDTType uint32
+
+const (
+	// Fungible defines the token type for ESDT fungible tokens
+	Fungible ESDTType = iota
+	// NonFungible defines the token type for ESDT non fungible tokens
+	NonFungible
+	// SemiFungible defines the token type for ESDT semi fungible tokens
+	SemiFungible
+)
+
+// String will convert number type in string
+func (t ESDTType) String() string {
+	switch t {
+	case Fungible:
+		return core.FungibleESDT
+	case NonFungible:
+		return core.NonFungibleESDT
+	case SemiFungible:
+		return core.SemiFungibleESDT
+	}
+	return "Unknown"
+}
@@ -9,8 +9,10 @@ import (
 type TxStatus string
 
 const (
-	// TxStatusPending = received and maybe executed on source shard, but not on destination shard
+	// TxStatusPending = received, but not yet executed (the transaction is still in the pool)
 	TxStatusPending TxStatus = "pending"
+	// TxStatusPartiallyExecuted = executed on source shard, but not (yet) on destination shard
+	TxStatusPartiallyExecuted TxStatus = "partially-executed"
 	// TxStatusSuccess = received and executed
 	TxStatusSuccess TxStatus = "success"
 	// TxStatusFail = received and executed with error
@@ -33,6 +35,7 @@ type StatusComputer struct {
 	Receiver             []byte
 	TransactionData      []byte
 	SelfShard            uint32
+	HasSignalError       bool
 }
 
 // ComputeStatusWhenInStorageKnowingMiniblock computes the transaction status for a historical transaction
@@ -41,10 +44,14 @@ func (params *StatusComputer) ComputeStatusWhenInStorageKnowingMiniblock() TxSta
 		return TxStatusInvalid
 	}
 	if params.IsMiniblockFinalized || params.isDestinationMe() || params.isContractDeploy() {
+		if params.HasSignalError {
+			return TxStatusFail
+		}
+
 		return TxStatusSuccess
 	}
 
-	return TxStatusPending
+	return TxStatusPartiallyExecuted
 }
 
 // ComputeStatusWhenInStorageNotKnowingMiniblock computes the transaction status when transaction is in current epoch's storage
@@ -56,7 +63,7 @@ func (params *StatusComputer) ComputeStatusWhenInStorageNotKnowingMiniblock() Tx
 	}
 
 	// At least partially executed (since in source's storage)
-	return TxStatusPending
+	return TxStatusPartiallyExecuted
 }
 
 func (params *StatusComputer) isMiniblockInvalid() bool {
@@ -34,7 +34,7 @@ func TestStatusComputer_ComputeStatusWhenInStorageKnowingMiniblock(t *testing.T)
 		DestinationShard: 13,
 		SelfShard:        12,
 	}
-	require.Equal(t, TxStatusPending, computer.ComputeStatusWhenInStorageKnowingMiniblock())
+	require.Equal(t, TxStatusPartiallyExecuted, computer.ComputeStatusWhenInStorageKnowingMiniblock())
 
 	// Cross, at source, but knowing that it has been fully notarized (through DatabaseLookupExtensions)
 	computer = &StatusComputer{
@@ -72,6 +72,26 @@ func TestStatusComputer_ComputeStatusWhenInStorageKnowingMiniblock(t *testing.T)
 		SelfShard:        13,
 	}
 	require.Equal(t, TxStatusSuccess, computer.ComputeStatusWhenInStorageKnowingMiniblock())
+
+	// Intra-shard, with an error signaled by the processor
+	computer = &StatusComputer{
+		MiniblockType:    block.TxBlock,
+		SourceShard:      12,
+		DestinationShard: 12,
+		SelfShard:        12,
+		HasSignalError:   true,
+	}
+	require.Equal(t, TxStatusFail, computer.ComputeStatusWhenInStorageKnowingMiniblock())
+
+	// Cross-shard, at source, not yet executed at destination: the signaled error is not yet known
+	computer = &StatusComputer{
+		MiniblockType:    block.TxBlock,
+		SourceShard:      12,
+		DestinationShard: 13,
+		SelfShard:        12,
+		HasSignalError:   true,
+	}
+	require.Equal(t, TxStatusPartiallyExecuted, computer.ComputeStatusWhenInStorageKnowingMiniblock())
 }
 
 func TestStatusComputer_ComputeStatusWhenInStorageNotKnowingMiniblock(t *testing.T) {
@@ -89,7 +109,7 @@ func TestStatusComputer_ComputeStatusWhenInStorageNotKnowingMiniblock(t *testing
 		DestinationShard: 13,
 		SelfShard:        12,
 	}
-	require.Equal(t, TxStatusPending, computer.ComputeStatusWhenInStorageNotKnowingMiniblock())
+	require.Equal(t, TxStatusPartiallyExecuted, computer.ComputeStatusWhenInStorageNotKnowingMiniblock())
 
 	// Cross, destination me
 	computer = &StatusComputer{
@@ -96,3 +96,9 @@ var ErrContextClosing = errors.New("context closing")
 
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
+
+// ErrKeyNotFound signals that the given key was not found in the trie
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrInvalidProof signals that the provided Merkle proof is not valid
+var ErrInvalidProof = errors.New("invalid proof")
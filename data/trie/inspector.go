@@ -0,0 +1,116 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// InspectionResult holds the statistics and the integrity issues found while walking a trie from its root hash
+type InspectionResult struct {
+	NumBranchNodes    uint64
+	NumExtensionNodes uint64
+	NumLeafNodes      uint64
+	TotalSizeInBytes  uint64
+	MaxDepth          uint32
+	MissingNodes      [][]byte
+	CorruptedNodes    [][]byte
+}
+
+// IsComplete returns true if all the nodes referenced by the trie were found and were valid
+func (ir *InspectionResult) IsComplete() bool {
+	return len(ir.MissingNodes) == 0 && len(ir.CorruptedNodes) == 0
+}
+
+type inspectedNode struct {
+	hash  []byte
+	depth uint32
+}
+
+// Inspect walks all the trie nodes reachable from the provided root hash, reading them directly from the database.
+// A node that can not be read is reported as missing, while a node that can not be decoded or that does not hash to
+// its reference is reported as corrupted. The walk continues after such issues, skipping the unreachable subtries.
+// The value of each found leaf is passed to the optional leaf handler
+func Inspect(
+	ctx context.Context,
+	rootHash []byte,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	leafHandler func(value []byte),
+) (*InspectionResult, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	result := &InspectionResult{
+		MissingNodes:   make([][]byte, 0),
+		CorruptedNodes: make([][]byte, 0),
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return result, nil
+	}
+
+	stack := []inspectedNode{{hash: rootHash, depth: 1}}
+	for len(stack) > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ErrContextClosing
+		default:
+		}
+
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := db.Get(current.hash)
+		if err != nil {
+			result.MissingNodes = append(result.MissingNodes, current.hash)
+			continue
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil || !bytes.Equal(hasher.Compute(string(encodedNode)), current.hash) {
+			result.CorruptedNodes = append(result.CorruptedNodes, current.hash)
+			continue
+		}
+
+		result.TotalSizeInBytes += uint64(len(encodedNode))
+		if current.depth > result.MaxDepth {
+			result.MaxDepth = current.depth
+		}
+
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			result.NumLeafNodes++
+			if leafHandler != nil {
+				leafHandler(n.Value)
+			}
+		case *extensionNode:
+			result.NumExtensionNodes++
+			stack = append(stack, inspectedNode{hash: n.EncodedChild, depth: current.depth + 1})
+		case *branchNode:
+			result.NumBranchNodes++
+			for i := len(n.EncodedChildren) - 1; i >= 0; i-- {
+				if len(n.EncodedChildren[i]) == 0 {
+					continue
+				}
+				stack = append(stack, inspectedNode{hash: n.EncodedChildren[i], depth: current.depth + 1})
+			}
+		}
+	}
+
+	return result, nil
+}
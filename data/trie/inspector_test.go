@@ -0,0 +1,131 @@
+package trie_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+	db := mock.NewMemDbMock()
+
+	_, err := trie.Inspect(context.Background(), []byte("root"), nil, marshalizer, hasher, nil)
+	assert.Equal(t, trie.ErrNilDatabase, err)
+
+	_, err = trie.Inspect(context.Background(), []byte("root"), db, nil, hasher, nil)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	_, err = trie.Inspect(context.Background(), []byte("root"), db, marshalizer, nil, nil)
+	assert.Equal(t, trie.ErrNilHasher, err)
+
+	_, err = trie.Inspect(nil, []byte("root"), db, marshalizer, hasher, nil)
+	assert.Equal(t, trie.ErrNilContext, err)
+}
+
+func TestInspect_EmptyTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	result, err := trie.Inspect(
+		context.Background(),
+		trie.EmptyTrieHash,
+		mock.NewMemDbMock(),
+		&mock.ProtobufMarshalizerMock{},
+		&mock.KeccakMock{},
+		nil,
+	)
+	require.Nil(t, err)
+	assert.True(t, result.IsComplete())
+	assert.Equal(t, uint64(0), result.NumLeafNodes)
+}
+
+func TestInspect_CompleteTrieShouldCountAllNodes(t *testing.T) {
+	t.Parallel()
+
+	numValues := 200
+	tr, _ := initTrieMultipleValues(numValues)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	numHandledLeaves := 0
+	result, err := trie.Inspect(
+		context.Background(),
+		rootHash,
+		tr.Database(),
+		&mock.ProtobufMarshalizerMock{},
+		&mock.KeccakMock{},
+		func(_ []byte) {
+			numHandledLeaves++
+		},
+	)
+	require.Nil(t, err)
+	assert.True(t, result.IsComplete())
+	assert.Equal(t, uint64(numValues), result.NumLeafNodes)
+	assert.Equal(t, numValues, numHandledLeaves)
+	assert.True(t, result.NumBranchNodes > 0)
+	assert.True(t, result.MaxDepth > 1)
+	assert.True(t, result.TotalSizeInBytes > 0)
+}
+
+func TestInspect_ShouldReportMissingAndCorruptedNodes(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(200)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+	require.True(t, len(hashes) > 3)
+
+	copyDb := func() *mock.MemDbMock {
+		db := mock.NewMemDbMock()
+		for _, hash := range hashes {
+			value, _ := tr.Database().Get(hash)
+			_ = db.Put(hash, value)
+		}
+
+		return db
+	}
+
+	missingHash := hashes[len(hashes)-1]
+	db := copyDb()
+	_ = db.Remove(missingHash)
+
+	result, err := trie.Inspect(context.Background(), rootHash, db, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	require.Nil(t, err)
+	assert.False(t, result.IsComplete())
+	assert.Equal(t, [][]byte{missingHash}, result.MissingNodes)
+	assert.Equal(t, 0, len(result.CorruptedNodes))
+
+	corruptedHash := hashes[len(hashes)-2]
+	db = copyDb()
+	_ = db.Put(corruptedHash, []byte("corrupted node"))
+
+	result, err = trie.Inspect(context.Background(), rootHash, db, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	require.Nil(t, err)
+	assert.False(t, result.IsComplete())
+	assert.Equal(t, 0, len(result.MissingNodes))
+	assert.Equal(t, [][]byte{corruptedHash}, result.CorruptedNodes)
+}
+
+func TestInspect_ClosedContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(10)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := trie.Inspect(ctx, rootHash, tr.Database(), &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	assert.Nil(t, result)
+	assert.Equal(t, trie.ErrContextClosing, err)
+}
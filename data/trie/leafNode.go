@@ -347,7 +347,7 @@ func (ln *leafNode) getAllLeavesOnChannel(
 	key []byte,
 	_ data.DBWriteCacher,
 	_ marshal.Marshalizer,
-	_ context.Context,
+	ctx context.Context,
 ) error {
 	err := ln.isEmptyOrNil()
 	if err != nil {
@@ -361,7 +361,11 @@ func (ln *leafNode) getAllLeavesOnChannel(
 	}
 
 	trieLeaf := keyValStorage.NewKeyValStorage(nodeKey, ln.Value)
-	leavesChannel <- trieLeaf
+	select {
+	case leavesChannel <- trieLeaf:
+	case <-ctx.Done():
+		log.Trace("getAllLeavesOnChannel interrupted")
+	}
 
 	return nil
 }
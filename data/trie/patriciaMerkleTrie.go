@@ -96,6 +96,43 @@ func (tr *patriciaMerkleTrie) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// GetProof returns the Merkle proof of the given key, as the encoded nodes on the path from the root node to the leaf
+// node holding the key. The proof can be checked against the trie root hash using VerifyProof
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, 0)
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for currentNode != nil {
+		encodedNode, errEncode := getCollapsedEncodedNode(currentNode)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		proof = append(proof, encodedNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if err == ErrNodeNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, hex.EncodeToString(key))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proof, nil
+}
+
 // Update updates the value at the given key.
 // If the key is not in the trie, it will be added.
 // If the value is empty, the key will be removed from the trie
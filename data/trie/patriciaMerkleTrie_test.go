@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var emptyTrieHash = make([]byte, 32)
@@ -659,6 +660,41 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelCancelledShouldCloseChannel(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	numLeaves := 1000
+	for i := 0; i < numLeaves; i++ {
+		_ = tr.Update([]byte("key"+strconv.Itoa(i)), []byte("value"+strconv.Itoa(i)))
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash, ctx)
+	require.Nil(t, err)
+
+	<-leavesChannel
+	cancel()
+
+	numRecovered := 1
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-leavesChannel:
+			if !ok {
+				assert.True(t, numRecovered < numLeaves)
+				return
+			}
+			numRecovered++
+		case <-timeout:
+			assert.Fail(t, "leaves channel should have been closed after cancellation")
+			return
+		}
+	}
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
@@ -0,0 +1,80 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// VerifyProof checks that the given proof, as produced by GetProof, links the key to the provided root hash.
+// Each node of the proof has to hash to the reference held by its parent, starting with the root hash. On success,
+// the value stored under the key is returned
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(proof) == 0 {
+		return nil, fmt.Errorf("%w: empty proof", ErrInvalidProof)
+	}
+
+	expectedHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for i, encodedNode := range proof {
+		nodeHash := hasher.Compute(string(encodedNode))
+		if !bytes.Equal(nodeHash, expectedHash) {
+			return nil, fmt.Errorf("%w: hash mismatch for node at position %d", ErrInvalidProof, i)
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+		}
+
+		isLastNode := i == len(proof)-1
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			if !isLastNode || !bytes.Equal(n.Key, hexKey) {
+				return nil, fmt.Errorf("%w: leaf node does not match the key", ErrInvalidProof)
+			}
+
+			return n.Value, nil
+		case *extensionNode:
+			if len(hexKey) < len(n.Key) || !bytes.Equal(n.Key, hexKey[:len(n.Key)]) {
+				return nil, fmt.Errorf("%w: extension node does not match the key", ErrInvalidProof)
+			}
+			expectedHash = n.EncodedChild
+			hexKey = hexKey[len(n.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 || childPosOutOfRange(hexKey[0]) {
+				return nil, fmt.Errorf("%w: branch node does not match the key", ErrInvalidProof)
+			}
+			expectedHash = n.EncodedChildren[hexKey[0]]
+			hexKey = hexKey[1:]
+		default:
+			return nil, fmt.Errorf("%w: unknown node type", ErrInvalidProof)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: the proof does not end with a leaf node", ErrInvalidProof)
+}
+
+func getCollapsedEncodedNode(n node) ([]byte, error) {
+	collapsed, err := n.getCollapsed()
+	if err != nil {
+		return nil, err
+	}
+
+	return collapsed.getEncodedNode()
+}
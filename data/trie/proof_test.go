@@ -0,0 +1,108 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetProofEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, trie.ErrKeyNotFound))
+}
+
+func TestPatriciaMerkleTrie_GetProofMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	proof, err := tr.GetProof([]byte("cat"))
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, trie.ErrKeyNotFound))
+}
+
+func TestPatriciaMerkleTrie_GetProofShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	for _, key := range values[:100] {
+		proof, errProof := recreatedTrie.GetProof(key)
+		require.Nil(t, errProof)
+
+		value, errVerify := trie.VerifyProof(rootHash, key, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		require.Nil(t, errVerify)
+		assert.Equal(t, key, value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofOnDirtyTrieShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+
+	proof, err := tr.GetProof([]byte("doe"))
+	require.Nil(t, err)
+
+	value, err := trie.VerifyProof(rootHash, []byte("doe"), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("reindeer"), value)
+}
+
+func TestVerifyProof_InvalidProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	proof, _ := tr.GetProof([]byte("dog"))
+	require.True(t, len(proof) > 1)
+
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+
+	_, err := trie.VerifyProof(rootHash, []byte("dog"), nil, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof([]byte("other root hash"), []byte("dog"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof(rootHash, []byte("doe"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	tamperedProof := make([][]byte, len(proof))
+	copy(tamperedProof, proof)
+	tamperedLeaf := append([]byte{}, proof[len(proof)-1]...)
+	tamperedLeaf[len(tamperedLeaf)-2] ^= 0xff
+	tamperedProof[len(proof)-1] = tamperedLeaf
+	_, err = trie.VerifyProof(rootHash, []byte("dog"), tamperedProof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := trie.VerifyProof([]byte("root"), []byte("key"), [][]byte{{1}}, nil, &mock.KeccakMock{})
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	_, err = trie.VerifyProof([]byte("root"), []byte("key"), [][]byte{{1}}, &mock.ProtobufMarshalizerMock{}, nil)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
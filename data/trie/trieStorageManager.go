@@ -37,11 +37,21 @@ type trieStorageManager struct {
 	pruningBuffer      atomicBuffer
 	pruningBlockingOps uint32
 	maxSnapshots       uint32
+	numRetainedRoots   uint32
+	retainedRoots      [][]byte
+	pinnedNewRoots     []*pinnedNewRoot
 
 	dbEvictionWaitingList data.DBRemoveCacher
 	storageOperationMutex sync.RWMutex
 }
 
+// pinnedNewRoot is a new root whose prune was canceled while older roots were retained. Its hashes are kept in the
+// eviction waiting list until all those roots are pruned, as the nodes it re-added might be shared with them
+type pinnedNewRoot struct {
+	rootHash                []byte
+	numRetainedRootsToPrune int
+}
+
 type snapshotsQueueEntry struct {
 	rootHash []byte
 	newDb    bool
@@ -84,6 +94,8 @@ func NewTrieStorageManager(
 		snapshotReq:           make(chan *snapshotsQueueEntry, generalConfig.SnapshotsBufferLen),
 		pruningBlockingOps:    0,
 		maxSnapshots:          generalConfig.MaxSnapshots,
+		numRetainedRoots:      generalConfig.NumRetainedRoots,
+		retainedRoots:         make([][]byte, 0, generalConfig.NumRetainedRoots+1),
 	}
 
 	go tsm.storageProcessLoop(marshalizer, hasher)
@@ -205,13 +217,24 @@ func (tsm *trieStorageManager) ExitPruningBufferingMode() {
 	log.Trace("exit pruning buffering state", "operations in progress that block pruning", tsm.pruningBlockingOps)
 }
 
-// Prune removes the given hash from db
+// Prune removes the given hash from db. The old roots are retained, so they can still be read, until the configured
+// number of newer old roots are pruned as well
 func (tsm *trieStorageManager) Prune(rootHash []byte, identifier data.TriePruningIdentifier) {
 	tsm.storageOperationMutex.Lock()
 	defer tsm.storageOperationMutex.Unlock()
 
 	rootHash = append(rootHash, byte(identifier))
 
+	if identifier == data.OldRoot && tsm.numRetainedRoots > 0 {
+		var shouldPrune bool
+		rootHash, shouldPrune = tsm.retainRoot(rootHash)
+		if !shouldPrune {
+			return
+		}
+
+		defer tsm.releasePinnedNewRoots()
+	}
+
 	if tsm.pruningBlockingOps > 0 {
 		if identifier == data.NewRoot {
 			tsm.cancelPrune(rootHash)
@@ -229,6 +252,45 @@ func (tsm *trieStorageManager) Prune(rootHash []byte, identifier data.TriePrunin
 	tsm.prune(rootHash)
 }
 
+// retainRoot adds the root to the retained roots queue and returns the oldest retained root if the queue is full
+func (tsm *trieStorageManager) retainRoot(rootHash []byte) ([]byte, bool) {
+	retainedRoot := make([]byte, len(rootHash))
+	copy(retainedRoot, rootHash)
+	tsm.retainedRoots = append(tsm.retainedRoots, retainedRoot)
+
+	if uint32(len(tsm.retainedRoots)) <= tsm.numRetainedRoots {
+		log.Trace("trie storage manager retained root", "root", retainedRoot)
+		return nil, false
+	}
+
+	oldestRoot := tsm.retainedRoots[0]
+	tsm.retainedRoots = tsm.retainedRoots[1:]
+
+	return oldestRoot, true
+}
+
+// releasePinnedNewRoots is called after the oldest retained root was pruned and cancels the prune of the new roots
+// that no longer protect any retained root
+func (tsm *trieStorageManager) releasePinnedNewRoots() {
+	stillPinned := make([]*pinnedNewRoot, 0, len(tsm.pinnedNewRoots))
+	for _, pinned := range tsm.pinnedNewRoots {
+		pinned.numRetainedRootsToPrune--
+		if pinned.numRetainedRootsToPrune > 0 {
+			stillPinned = append(stillPinned, pinned)
+			continue
+		}
+
+		if tsm.pruningBlockingOps > 0 || tsm.pruningBuffer.len() != 0 {
+			tsm.pruningBuffer.add(append(pinned.rootHash, byte(cancelPrune)))
+			continue
+		}
+
+		tsm.cancelPrune(pinned.rootHash)
+	}
+
+	tsm.pinnedNewRoots = stillPinned
+}
+
 func (tsm *trieStorageManager) resolveBufferedHashes(oldHashes [][]byte) {
 	for _, rootHash := range oldHashes {
 		lastBytePos := len(rootHash) - 1
@@ -266,6 +328,19 @@ func (tsm *trieStorageManager) CancelPrune(rootHash []byte, identifier data.Trie
 
 	rootHash = append(rootHash, byte(identifier))
 
+	// the retained roots are pruned later, so the new hashes that might be shared with them are kept until then
+	if identifier == data.NewRoot && len(tsm.retainedRoots) > 0 {
+		pinnedRoot := make([]byte, len(rootHash))
+		copy(pinnedRoot, rootHash)
+		tsm.pinnedNewRoots = append(tsm.pinnedNewRoots, &pinnedNewRoot{
+			rootHash:                pinnedRoot,
+			numRetainedRootsToPrune: len(tsm.retainedRoots),
+		})
+		log.Trace("trie storage manager pinned new root", "root", pinnedRoot)
+
+		return
+	}
+
 	if tsm.pruningBlockingOps > 0 || tsm.pruningBuffer.len() != 0 {
 		rootHash = append(rootHash, byte(cancelPrune))
 		tsm.pruningBuffer.add(rootHash)
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const pruningDelay = time.Second / 2
@@ -103,6 +104,64 @@ func TestNewTrieStorageManagerWithExistingSnapshot(t *testing.T) {
 	assert.Equal(t, 1, newTrieStorage.snapshotId)
 }
 
+func TestNewTrieStorageManagerWithExistingBoltDBSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tempDir, _ := ioutil.TempDir("", "boltdb_temp")
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+	cfg := config.DBConfig{
+		FilePath:          tempDir,
+		Type:              string(storageUnit.BoltDB),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      40000,
+	}
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+
+	db := mock.NewMemDbMock()
+	msh, hsh := getTestMarshalizerAndHasher()
+	size := uint(100)
+	evictionWaitList, _ := mock.NewEvictionWaitingList(size, mock.NewMemDbMock(), msh)
+	trieStorage, _ := NewTrieStorageManager(db, msh, hsh, cfg, evictionWaitList, generalCfg)
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := NewTrie(trieStorage, msh, hsh, maxTrieLevelInMemory)
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("dogglesworth"), []byte("cat"))
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	// the snapshot is taken synchronously, instead of through the snapshots queue
+	trieStorage.EnterPruningBufferingMode()
+	trieStorage.takeSnapshot(&snapshotsQueueEntry{rootHash: rootHash, newDb: true}, msh, hsh)
+
+	// the snapshot nodes are still in the batch, closing the db should write them
+	trieStorage.storageOperationMutex.Lock()
+	_ = trieStorage.snapshots[0].Close()
+	trieStorage.storageOperationMutex.Unlock()
+
+	newTrieStorage, _ := NewTrieStorageManager(memorydb.New(), msh, hsh, cfg, evictionWaitList, generalCfg)
+	snapshot := newTrieStorage.GetSnapshotThatContainsHash(rootHash)
+	assert.NotNil(t, snapshot)
+	assert.Equal(t, 1, newTrieStorage.snapshotId)
+
+	newTrie, _ := NewTrie(newTrieStorage, msh, hsh, maxTrieLevelInMemory)
+	recreatedTrie, err := newTrie.Recreate(rootHash)
+	assert.Nil(t, err)
+	val, err := recreatedTrie.Get([]byte("dogglesworth"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("cat"), val)
+
+	newTrieStorage.storageOperationMutex.Lock()
+	_ = newTrieStorage.snapshots[0].Close()
+	newTrieStorage.storageOperationMutex.Unlock()
+}
+
 func TestNewTrieStorageManagerLoadsSnapshotsInOrder(t *testing.T) {
 	t.Parallel()
 
@@ -225,6 +284,142 @@ func TestTrieDatabasePruning(t *testing.T) {
 	}
 }
 
+func TestTrieDatabasePruningWithRetainedRoots(t *testing.T) {
+	t.Parallel()
+
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+		NumRetainedRoots:   1,
+	}
+	db := mock.NewMemDbMock()
+	msh, hsh := getTestMarshalizerAndHasher()
+	size := uint(1)
+	evictionWaitList, _ := mock.NewEvictionWaitingList(size, mock.NewMemDbMock(), msh)
+	trieStorage, _ := NewTrieStorageManager(db, msh, hsh, config.DBConfig{}, evictionWaitList, generalCfg)
+
+	tr := &patriciaMerkleTrie{
+		trieStorage:          trieStorage,
+		oldHashes:            make([][]byte, 0),
+		oldRoot:              make([]byte, 0),
+		marshalizer:          msh,
+		hasher:               hsh,
+		maxTrieLevelInMemory: 5,
+	}
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	_ = tr.Commit()
+	firstRootHash, _ := tr.Root()
+
+	_ = tr.Update([]byte("dog"), []byte("doee"))
+	_ = tr.Commit()
+	secondRootHash, _ := tr.Root()
+
+	tr.CancelPrune(firstRootHash, data.NewRoot)
+	tr.Prune(firstRootHash, data.OldRoot)
+	time.Sleep(pruningDelay)
+
+	encNode, err := tr.Database().Get(firstRootHash)
+	assert.Nil(t, err)
+	assert.NotNil(t, encNode)
+	retainedTrie, err := tr.Recreate(firstRootHash)
+	assert.Nil(t, err)
+	value, _ := retainedTrie.Get([]byte("dog"))
+	assert.Equal(t, []byte("puppy"), value)
+
+	_ = tr.Update([]byte("dog"), []byte("kitten"))
+	_ = tr.Commit()
+
+	tr.CancelPrune(secondRootHash, data.NewRoot)
+	tr.Prune(secondRootHash, data.OldRoot)
+	time.Sleep(pruningDelay)
+
+	encNode, err = tr.Database().Get(firstRootHash)
+	assert.Nil(t, encNode)
+	assert.NotNil(t, err)
+	encNode, err = tr.Database().Get(secondRootHash)
+	assert.Nil(t, err)
+	assert.NotNil(t, encNode)
+}
+
+// commitWithNewHashes commits the trie after marking its dirty hashes as new hashes, as done by the accounts adapter
+func commitWithNewHashes(tr *patriciaMerkleTrie) {
+	newHashes, _ := tr.GetDirtyHashes()
+	tr.SetNewHashes(newHashes)
+	_ = tr.Commit()
+}
+
+func TestTrieDatabasePruningWithRetainedRootsShouldKeepNodesSharedWithNewerRoots(t *testing.T) {
+	t.Parallel()
+
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+		NumRetainedRoots:   2,
+	}
+	db := mock.NewMemDbMock()
+	msh, hsh := getTestMarshalizerAndHasher()
+	size := uint(100)
+	evictionWaitList, _ := mock.NewEvictionWaitingList(size, mock.NewMemDbMock(), msh)
+	trieStorage, _ := NewTrieStorageManager(db, msh, hsh, config.DBConfig{}, evictionWaitList, generalCfg)
+
+	tr := &patriciaMerkleTrie{
+		trieStorage:          trieStorage,
+		oldHashes:            make([][]byte, 0),
+		oldRoot:              make([]byte, 0),
+		marshalizer:          msh,
+		hasher:               hsh,
+		maxTrieLevelInMemory: 5,
+	}
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	commitWithNewHashes(tr)
+	rootHashes := make([][]byte, 0)
+	rootHash, _ := tr.Root()
+	rootHashes = append(rootHashes, rootHash)
+
+	// the "dog" leaf is removed by the second root and added back by the third one, so the first and the third
+	// roots share it
+	updates := [][][]byte{
+		{[]byte("dog"), []byte("doee")},
+		{[]byte("dog"), []byte("puppy"), []byte("doe"), []byte("deer")},
+		{[]byte("ddog"), []byte("kitten")},
+		{[]byte("doe"), []byte("elk")},
+	}
+	for _, update := range updates {
+		for i := 0; i < len(update); i += 2 {
+			_ = tr.Update(update[i], update[i+1])
+		}
+		commitWithNewHashes(tr)
+		rootHash, _ = tr.Root()
+		rootHashes = append(rootHashes, rootHash)
+	}
+
+	// the roots become final one by one, as done by the block processor
+	for i := 0; i < len(rootHashes)-1; i++ {
+		tr.CancelPrune(rootHashes[i], data.NewRoot)
+		tr.Prune(rootHashes[i], data.OldRoot)
+		time.Sleep(pruningDelay)
+	}
+
+	_, err := tr.Database().Get(rootHashes[0])
+	assert.NotNil(t, err)
+
+	for i := 2; i < len(rootHashes); i++ {
+		recreatedTrie, errRecreate := tr.Recreate(rootHashes[i])
+		require.Nil(t, errRecreate)
+		value, errGet := recreatedTrie.Get([]byte("dog"))
+		require.Nil(t, errGet)
+		assert.Equal(t, []byte("puppy"), value)
+	}
+}
+
 func TestRecreateTrieFromSnapshotDb(t *testing.T) {
 	t.Parallel()
 
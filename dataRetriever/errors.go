@@ -107,6 +107,9 @@ var ErrCacheConfigInvalidSize = errors.New("cache parameter [size] is not valid,
 // ErrCacheConfigInvalidShards signals that the cache parameter "shards" is invalid
 var ErrCacheConfigInvalidShards = errors.New("cache parameter [shards] is not valid, it must be a positive number")
 
+// ErrCacheConfigInvalidSelectionPolicy signals that the cache parameter "selectionPolicy" is invalid
+var ErrCacheConfigInvalidSelectionPolicy = errors.New("cache parameter [selectionPolicy] is not valid, it must be a known selection policy")
+
 // ErrCacheConfigInvalidEconomics signals that an economics parameter required by the cache is invalid
 var ErrCacheConfigInvalidEconomics = errors.New("cache-economics parameter is not valid")
 
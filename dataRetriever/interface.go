@@ -39,8 +39,22 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case TxLogsUnit:
+		return "TxLogsUnit"
+	case MiniblocksMetadataUnit:
+		return "MiniblocksMetadataUnit"
+	case EpochByHashUnit:
+		return "EpochByHashUnit"
+	case MiniblockHashByTxHashUnit:
+		return "MiniblockHashByTxHashUnit"
 	case ReceiptsUnit:
 		return "ReceiptsUnit"
+	case ResultsHashesByTxHashUnit:
+		return "ResultsHashesByTxHashUnit"
+	case TxHashesByAddressUnit:
+		return "TxHashesByAddressUnit"
+	case TxPoolUnit:
+		return "TxPoolUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -85,6 +99,10 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// TxHashesByAddressUnit is the transactions hashes by address storage unit identifier
+	TxHashesByAddressUnit UnitType = 17
+	// TxPoolUnit is the storage unit identifier for the persisted transactions pool
+	TxPoolUnit UnitType = 18
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -37,6 +37,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if config.Shards == 0 {
 		return fmt.Errorf("%w: config.Shards (map chunks) is not valid", dataRetriever.ErrCacheConfigInvalidShards)
 	}
+	if !txcache.IsSelectionPolicySupported(config.SelectionPolicy) {
+		return fmt.Errorf("%w: config.SelectionPolicy is not valid", dataRetriever.ErrCacheConfigInvalidSelectionPolicy)
+	}
 	if check.IfNil(args.TxGasHandler) {
 		return fmt.Errorf("%w: TxGasHandler is not valid", dataRetriever.ErrNilTxGasHandler)
 	}
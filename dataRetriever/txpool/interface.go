@@ -9,6 +9,7 @@ type txCache interface {
 	storage.Cacher
 
 	AddTx(tx *txcache.WrappedTransaction) (ok bool, added bool)
+	AddTxWithReplacement(tx *txcache.WrappedTransaction) (ok bool, added bool)
 	GetByTxHash(txHash []byte) (*txcache.WrappedTransaction, bool)
 	RemoveTxByHash(txHash []byte) bool
 	ImmunizeTxsAgainstEviction(keys [][]byte)
@@ -16,3 +17,8 @@ type txCache interface {
 	NumBytes() int
 	Diagnose(deep bool)
 }
+
+type txCacheForSenders interface {
+	GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction
+	GetNonceGapsForSender(sender []byte, accountNonce uint64) []txcache.NonceGap
+}
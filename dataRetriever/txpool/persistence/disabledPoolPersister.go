@@ -0,0 +1,36 @@
+package persistence
+
+import "time"
+
+type disabledPoolPersister struct {
+}
+
+// NewDisabledPoolPersister returns a pool persister which does nothing, used when the persistence is not enabled
+func NewDisabledPoolPersister() *disabledPoolPersister {
+	return &disabledPoolPersister{}
+}
+
+// Restore does nothing and returns 0
+func (dpp *disabledPoolPersister) Restore() (int, error) {
+	return 0, nil
+}
+
+// Save does nothing
+func (dpp *disabledPoolPersister) Save() error {
+	return nil
+}
+
+// StartCheckpoints does nothing
+func (dpp *disabledPoolPersister) StartCheckpoints(_ time.Duration) error {
+	return nil
+}
+
+// Close does nothing
+func (dpp *disabledPoolPersister) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *disabledPoolPersister) IsInterfaceNil() bool {
+	return dpp == nil
+}
@@ -0,0 +1,30 @@
+package persistence
+
+import "errors"
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilTxValidator signals that a nil transaction validator has been provided
+var ErrNilTxValidator = errors.New("nil transaction validator")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidMaxNumTxs signals that an invalid maximum number of persisted transactions has been provided
+var ErrInvalidMaxNumTxs = errors.New("invalid maximum number of persisted transactions")
+
+// ErrInvalidMaxNumBytes signals that an invalid maximum size of the persisted transactions has been provided
+var ErrInvalidMaxNumBytes = errors.New("invalid maximum size of the persisted transactions")
+
+// ErrInvalidCheckpointInterval signals that an invalid checkpoint interval has been provided
+var ErrInvalidCheckpointInterval = errors.New("invalid checkpoint interval")
@@ -0,0 +1,31 @@
+package persistence
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// TxPool defines the transactions pool operations needed by the persister
+type TxPool interface {
+	AddData(key []byte, data interface{}, sizeInBytes int, cacheId string)
+	ForEachTransaction(function txcache.ForEachTransaction)
+	IsInterfaceNil() bool
+}
+
+// FeeHandler is able to compute the fee of a transaction
+type FeeHandler interface {
+	ComputeTxFee(tx process.TransactionWithFeeHandler) *big.Int
+	IsInterfaceNil() bool
+}
+
+// PoolPersister saves the transactions pool in a storage unit and restores it on the node's startup
+type PoolPersister interface {
+	Restore() (int, error)
+	Save() error
+	StartCheckpoints(interval time.Duration) error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,326 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ PoolPersister = (*poolPersister)(nil)
+
+var log = logger.GetOrCreate("txpool/persistence")
+
+// indexKey is the key under which the hashes of the persisted transactions are saved, in the order they are restored
+var indexKey = []byte("txPoolIndex")
+
+// ArgsPoolPersister holds the arguments needed to create a pool persister
+type ArgsPoolPersister struct {
+	TxPool           TxPool
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	TxValidator      process.TxValidator
+	FeeHandler       FeeHandler
+	ShardCoordinator sharding.Coordinator
+	MaxNumTxs        uint32
+	MaxNumBytes      uint64
+}
+
+type poolPersister struct {
+	txPool           TxPool
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	txValidator      process.TxValidator
+	feeHandler       FeeHandler
+	shardCoordinator sharding.Coordinator
+	maxNumTxs        uint32
+	maxNumBytes      uint64
+	mutSave          sync.Mutex
+	persistedHashes  map[string]struct{}
+	mutCancel        sync.Mutex
+	cancelFunc       context.CancelFunc
+}
+
+type poolEntry struct {
+	txHash []byte
+	tx     *transaction.Transaction
+	size   int64
+}
+
+// NewPoolPersister creates a persister of the transactions pool
+func NewPoolPersister(args ArgsPoolPersister) (*poolPersister, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, ErrNilTxPool
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxValidator) {
+		return nil, ErrNilTxValidator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, ErrNilFeeHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.MaxNumTxs == 0 {
+		return nil, ErrInvalidMaxNumTxs
+	}
+	if args.MaxNumBytes == 0 {
+		return nil, ErrInvalidMaxNumBytes
+	}
+
+	return &poolPersister{
+		txPool:           args.TxPool,
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		txValidator:      args.TxValidator,
+		feeHandler:       args.FeeHandler,
+		shardCoordinator: args.ShardCoordinator,
+		maxNumTxs:        args.MaxNumTxs,
+		maxNumBytes:      args.MaxNumBytes,
+		persistedHashes:  make(map[string]struct{}),
+	}, nil
+}
+
+// Restore re-validates the persisted transactions against the current state and adds the valid ones in the pool.
+// At most the configured number of transactions (and bytes) are read, so that the startup is not delayed.
+// It returns the number of restored transactions
+func (pp *poolPersister) Restore() (int, error) {
+	pp.mutSave.Lock()
+	defer pp.mutSave.Unlock()
+
+	txHashes, err := pp.getPersistedHashes()
+	if err != nil {
+		return 0, err
+	}
+
+	numRestored := 0
+	numBytes := uint64(0)
+	for _, txHash := range txHashes {
+		pp.persistedHashes[string(txHash)] = struct{}{}
+		if uint32(numRestored) >= pp.maxNumTxs {
+			continue
+		}
+
+		txBytes, errGet := pp.storer.Get(txHash)
+		if errGet != nil {
+			log.Trace("poolPersister.Restore: missing transaction", "hash", txHash, "error", errGet)
+			continue
+		}
+		if numBytes+uint64(len(txBytes)) > pp.maxNumBytes {
+			continue
+		}
+
+		tx := &transaction.Transaction{}
+		err = pp.marshalizer.Unmarshal(tx, txBytes)
+		if err != nil {
+			log.Trace("poolPersister.Restore: cannot unmarshal transaction", "hash", txHash, "error", err)
+			continue
+		}
+
+		senderShardID, receiverShardID := pp.computeShards(tx)
+		err = pp.txValidator.CheckTxValidity(&txValidatorHandler{
+			tx:              tx,
+			senderShardID:   senderShardID,
+			receiverShardID: receiverShardID,
+			fee:             pp.feeHandler.ComputeTxFee(tx),
+		})
+		if err != nil {
+			log.Trace("poolPersister.Restore: invalid transaction", "hash", txHash, "error", err)
+			continue
+		}
+
+		cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+		pp.txPool.AddData(txHash, tx, len(txBytes), cacheID)
+		numRestored++
+		numBytes += uint64(len(txBytes))
+	}
+
+	log.Debug("transactions pool restored", "num persisted", len(txHashes), "num restored", numRestored)
+
+	return numRestored, nil
+}
+
+func (pp *poolPersister) getPersistedHashes() ([][]byte, error) {
+	indexBytes, err := pp.storer.Get(indexKey)
+	if err != nil {
+		log.Debug("no persisted transactions pool found", "error", err)
+		return make([][]byte, 0), nil
+	}
+
+	index := &batch.Batch{}
+	err = pp.marshalizer.Unmarshal(index, indexBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Data, nil
+}
+
+// computeShards computes the shards of the transaction in the same manner as the interceptors do
+func (pp *poolPersister) computeShards(tx *transaction.Transaction) (uint32, uint32) {
+	senderShardID := pp.shardCoordinator.ComputeId(tx.SndAddr)
+	receiverShardID := pp.shardCoordinator.ComputeId(tx.RcvAddr)
+	emptyAddress := make([]byte, len(tx.RcvAddr))
+	if bytes.Equal(tx.RcvAddr, emptyAddress) {
+		receiverShardID = senderShardID
+	}
+
+	return senderShardID, receiverShardID
+}
+
+// Save persists the transactions of the pool, up to the configured size cap. The transactions are grouped by sender
+// and sorted by nonce, so that no nonce gaps are introduced when the cap is reached
+func (pp *poolPersister) Save() error {
+	pp.mutSave.Lock()
+	defer pp.mutSave.Unlock()
+
+	entries := pp.collectEntries()
+
+	txHashes := make([][]byte, 0, len(entries))
+	savedHashes := make(map[string]struct{}, len(entries))
+	numBytes := uint64(0)
+	for _, entry := range entries {
+		if uint32(len(txHashes)) >= pp.maxNumTxs || numBytes+uint64(entry.size) > pp.maxNumBytes {
+			break
+		}
+
+		_, alreadyPersisted := pp.persistedHashes[string(entry.txHash)]
+		if !alreadyPersisted {
+			txBytes, err := pp.marshalizer.Marshal(entry.tx)
+			if err != nil {
+				return err
+			}
+
+			err = pp.storer.Put(entry.txHash, txBytes)
+			if err != nil {
+				return err
+			}
+		}
+
+		txHashes = append(txHashes, entry.txHash)
+		savedHashes[string(entry.txHash)] = struct{}{}
+		numBytes += uint64(entry.size)
+	}
+
+	indexBytes, err := pp.marshalizer.Marshal(batch.New(txHashes...))
+	if err != nil {
+		return err
+	}
+	err = pp.storer.Put(indexKey, indexBytes)
+	if err != nil {
+		return err
+	}
+
+	// the stale transactions are removed only after the index is saved, so that the index never refers missing ones
+	for txHash := range pp.persistedHashes {
+		_, stillSaved := savedHashes[txHash]
+		if !stillSaved {
+			log.LogIfError(pp.storer.Remove([]byte(txHash)))
+		}
+	}
+	pp.persistedHashes = savedHashes
+
+	log.Debug("transactions pool saved", "num in pool", len(entries), "num saved", len(txHashes), "num bytes", numBytes)
+
+	return nil
+}
+
+func (pp *poolPersister) collectEntries() []*poolEntry {
+	entries := make([]*poolEntry, 0)
+	pp.txPool.ForEachTransaction(func(txHash []byte, wrappedTx *txcache.WrappedTransaction) {
+		tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+		if !ok {
+			return
+		}
+
+		entries = append(entries, &poolEntry{
+			txHash: txHash,
+			tx:     tx,
+			size:   wrappedTx.Size,
+		})
+	})
+
+	sort.Slice(entries, func(i, j int) bool {
+		senderComparison := bytes.Compare(entries[i].tx.SndAddr, entries[j].tx.SndAddr)
+		if senderComparison != 0 {
+			return senderComparison < 0
+		}
+		if entries[i].tx.Nonce != entries[j].tx.Nonce {
+			return entries[i].tx.Nonce < entries[j].tx.Nonce
+		}
+
+		return bytes.Compare(entries[i].txHash, entries[j].txHash) < 0
+	})
+
+	return entries
+}
+
+// StartCheckpoints will periodically save the transactions pool until Close is called
+func (pp *poolPersister) StartCheckpoints(interval time.Duration) error {
+	if interval <= 0 {
+		return ErrInvalidCheckpointInterval
+	}
+
+	pp.mutCancel.Lock()
+	defer pp.mutCancel.Unlock()
+
+	if pp.cancelFunc != nil {
+		pp.cancelFunc()
+	}
+	var ctx context.Context
+	ctx, pp.cancelFunc = context.WithCancel(context.Background())
+
+	go pp.checkpointLoop(ctx, interval)
+
+	return nil
+}
+
+func (pp *poolPersister) checkpointLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			log.Debug("closing the transactions pool checkpoints loop")
+			return
+		}
+
+		err := pp.Save()
+		if err != nil {
+			log.Debug("cannot save the transactions pool", "error", err)
+		}
+	}
+}
+
+// Close stops the periodic checkpoints, if started, and saves the transactions pool one last time
+func (pp *poolPersister) Close() error {
+	pp.mutCancel.Lock()
+	if pp.cancelFunc != nil {
+		pp.cancelFunc()
+		pp.cancelFunc = nil
+	}
+	pp.mutCancel.Unlock()
+
+	return pp.Save()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *poolPersister) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,317 @@
+package persistence_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool/persistence"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidTx = errors.New("invalid transaction")
+
+func createTxPool(t *testing.T) dataRetriever.ShardedDataCacherNotifier {
+	pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             1000,
+			SizePerSender:        100,
+			SizeInBytes:          1000000,
+			SizeInBytesPerSender: 100000,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 1,
+		SelfShardID:    0,
+	})
+	require.Nil(t, err)
+
+	return pool
+}
+
+func createMemUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	persist, _ := memorydb.NewlruDB(100000)
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createArgsPoolPersister(t *testing.T) persistence.ArgsPoolPersister {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	return persistence.ArgsPoolPersister{
+		TxPool:      createTxPool(t).(persistence.TxPool),
+		Storer:      createMemUnit(),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		TxValidator: &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(_ process.TxValidatorHandler) error {
+				return nil
+			},
+		},
+		FeeHandler:       &economicsmocks.EconomicsHandlerStub{},
+		ShardCoordinator: shardCoordinator,
+		MaxNumTxs:        100,
+		MaxNumBytes:      100000,
+	}
+}
+
+func addTx(pool persistence.TxPool, hash string, sender string, nonce uint64) {
+	tx := &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte("receiver"),
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		GasPrice: 200000000000,
+		GasLimit: 50000,
+	}
+	pool.AddData([]byte(hash), tx, 100, process.ShardCacherIdentifier(0, 0))
+}
+
+func collectHashes(pool persistence.TxPool) map[string]struct{} {
+	hashes := make(map[string]struct{})
+	pool.ForEachTransaction(func(txHash []byte, _ *txcache.WrappedTransaction) {
+		hashes[string(txHash)] = struct{}{}
+	})
+
+	return hashes
+}
+
+func TestNewPoolPersister_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	args.TxPool = nil
+	pp, err := persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilTxPool, err)
+
+	args = createArgsPoolPersister(t)
+	args.Storer = nil
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilStorer, err)
+
+	args = createArgsPoolPersister(t)
+	args.Marshalizer = nil
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilMarshalizer, err)
+
+	args = createArgsPoolPersister(t)
+	args.TxValidator = nil
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilTxValidator, err)
+
+	args = createArgsPoolPersister(t)
+	args.FeeHandler = nil
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilFeeHandler, err)
+
+	args = createArgsPoolPersister(t)
+	args.ShardCoordinator = nil
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrNilShardCoordinator, err)
+
+	args = createArgsPoolPersister(t)
+	args.MaxNumTxs = 0
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrInvalidMaxNumTxs, err)
+
+	args = createArgsPoolPersister(t)
+	args.MaxNumBytes = 0
+	pp, err = persistence.NewPoolPersister(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, persistence.ErrInvalidMaxNumBytes, err)
+}
+
+func TestPoolPersister_RestoreWithoutPersistedPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pp, _ := persistence.NewPoolPersister(createArgsPoolPersister(t))
+
+	numRestored, err := pp.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numRestored)
+}
+
+func TestPoolPersister_SaveAndRestoreShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	addTx(args.TxPool, "hash-a2", "alice", 2)
+	addTx(args.TxPool, "hash-b7", "bob", 7)
+	pp, _ := persistence.NewPoolPersister(args)
+
+	err := pp.Save()
+	require.Nil(t, err)
+
+	argsAfterRestart := createArgsPoolPersister(t)
+	argsAfterRestart.Storer = args.Storer
+	ppAfterRestart, _ := persistence.NewPoolPersister(argsAfterRestart)
+
+	numRestored, err := ppAfterRestart.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numRestored)
+	hashes := collectHashes(argsAfterRestart.TxPool)
+	assert.Equal(t, 3, len(hashes))
+	assert.Contains(t, hashes, "hash-a1")
+	assert.Contains(t, hashes, "hash-a2")
+	assert.Contains(t, hashes, "hash-b7")
+}
+
+func TestPoolPersister_RestoreShouldSkipInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	addTx(args.TxPool, "hash-b7", "bob", 7)
+	pp, _ := persistence.NewPoolPersister(args)
+	require.Nil(t, pp.Save())
+
+	argsAfterRestart := createArgsPoolPersister(t)
+	argsAfterRestart.Storer = args.Storer
+	argsAfterRestart.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+		ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(int64(tx.GetGasLimit()))
+		},
+	}
+	argsAfterRestart.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(handler process.TxValidatorHandler) error {
+			assert.Equal(t, uint32(0), handler.SenderShardId())
+			assert.Equal(t, uint32(0), handler.ReceiverShardId())
+			assert.Equal(t, big.NewInt(50000), handler.Fee())
+			if string(handler.SenderAddress()) == "bob" && handler.Nonce() == 7 {
+				return errInvalidTx
+			}
+
+			return nil
+		},
+	}
+	ppAfterRestart, _ := persistence.NewPoolPersister(argsAfterRestart)
+
+	numRestored, err := ppAfterRestart.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRestored)
+	hashes := collectHashes(argsAfterRestart.TxPool)
+	assert.Equal(t, 1, len(hashes))
+	assert.Contains(t, hashes, "hash-a1")
+}
+
+func TestPoolPersister_SaveShouldRespectTheSizeCap(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	args.MaxNumTxs = 3
+	addTx(args.TxPool, "hash-b1", "bob", 1)
+	addTx(args.TxPool, "hash-a3", "alice", 3)
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	addTx(args.TxPool, "hash-a2", "alice", 2)
+	pp, _ := persistence.NewPoolPersister(args)
+	require.Nil(t, pp.Save())
+
+	argsAfterRestart := createArgsPoolPersister(t)
+	argsAfterRestart.Storer = args.Storer
+	ppAfterRestart, _ := persistence.NewPoolPersister(argsAfterRestart)
+
+	numRestored, err := ppAfterRestart.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numRestored)
+	hashes := collectHashes(argsAfterRestart.TxPool)
+	assert.Contains(t, hashes, "hash-a1")
+	assert.Contains(t, hashes, "hash-a2")
+	assert.Contains(t, hashes, "hash-a3")
+
+	args = createArgsPoolPersister(t)
+	args.MaxNumBytes = 250
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	addTx(args.TxPool, "hash-a2", "alice", 2)
+	addTx(args.TxPool, "hash-a3", "alice", 3)
+	pp, _ = persistence.NewPoolPersister(args)
+	require.Nil(t, pp.Save())
+
+	argsAfterRestart = createArgsPoolPersister(t)
+	argsAfterRestart.Storer = args.Storer
+	ppAfterRestart, _ = persistence.NewPoolPersister(argsAfterRestart)
+
+	numRestored, err = ppAfterRestart.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numRestored)
+}
+
+func TestPoolPersister_SaveShouldRemoveStaleTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	addTx(args.TxPool, "hash-a2", "alice", 2)
+	pp, _ := persistence.NewPoolPersister(args)
+	require.Nil(t, pp.Save())
+
+	args.TxPool.(dataRetriever.ShardedDataCacherNotifier).RemoveData([]byte("hash-a1"), process.ShardCacherIdentifier(0, 0))
+	require.Nil(t, pp.Save())
+
+	assert.NotNil(t, args.Storer.Has([]byte("hash-a1")))
+	assert.Nil(t, args.Storer.Has([]byte("hash-a2")))
+}
+
+func TestPoolPersister_CheckpointsAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsPoolPersister(t)
+	pp, _ := persistence.NewPoolPersister(args)
+
+	err := pp.StartCheckpoints(0)
+	assert.Equal(t, persistence.ErrInvalidCheckpointInterval, err)
+
+	err = pp.StartCheckpoints(time.Millisecond * 10)
+	require.Nil(t, err)
+
+	addTx(args.TxPool, "hash-a1", "alice", 1)
+	time.Sleep(time.Millisecond * 100)
+	assert.Nil(t, args.Storer.Has([]byte("hash-a1")))
+
+	addTx(args.TxPool, "hash-a2", "alice", 2)
+	err = pp.Close()
+	assert.Nil(t, err)
+	assert.Nil(t, args.Storer.Has([]byte("hash-a2")))
+}
+
+func TestDisabledPoolPersister(t *testing.T) {
+	t.Parallel()
+
+	pp := persistence.NewDisabledPoolPersister()
+	assert.False(t, check.IfNil(pp))
+
+	numRestored, err := pp.Restore()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numRestored)
+	assert.Nil(t, pp.Save())
+	assert.Nil(t, pp.StartCheckpoints(0))
+	assert.Nil(t, pp.Close())
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.TxValidatorHandler = (*txValidatorHandler)(nil)
+
+// txValidatorHandler exposes a restored transaction to the transaction validator, in the same manner as an
+// intercepted transaction is exposed
+type txValidatorHandler struct {
+	tx              *transaction.Transaction
+	senderShardID   uint32
+	receiverShardID uint32
+	fee             *big.Int
+}
+
+// SenderShardId returns the shard of the sender
+func (handler *txValidatorHandler) SenderShardId() uint32 {
+	return handler.senderShardID
+}
+
+// ReceiverShardId returns the shard of the receiver
+func (handler *txValidatorHandler) ReceiverShardId() uint32 {
+	return handler.receiverShardID
+}
+
+// Nonce returns the nonce of the transaction
+func (handler *txValidatorHandler) Nonce() uint64 {
+	return handler.tx.Nonce
+}
+
+// SenderAddress returns the sender address
+func (handler *txValidatorHandler) SenderAddress() []byte {
+	return handler.tx.SndAddr
+}
+
+// Fee returns the fee of the transaction
+func (handler *txValidatorHandler) Fee() *big.Int {
+	return handler.fee
+}
@@ -56,6 +56,9 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+
+		MinGasPriceBumpPercentForReplacement: args.Config.MinGasPriceBumpPercentForReplacement,
+		SelectionPolicy:                      args.Config.SelectionPolicy,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -160,35 +163,62 @@ func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cac
 	shard.Cache.ImmunizeTxsAgainstEviction(keys)
 }
 
-// AddData adds the transaction to the cache
+// AddData adds the transaction to the cache, the transactions with the same sender and nonce being kept side by side
 func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes int, cacheID string) {
-	valueAsTransaction, ok := value.(data.TransactionHandler)
+	wrapper, ok := createWrappedTransaction(key, value, sizeInBytes, cacheID)
 	if !ok {
 		return
 	}
 
+	txPool.addTx(wrapper, cacheID)
+}
+
+// AddDataWithReplacement adds the transaction to the cache, like AddData does. If replacement is enabled, a
+// transaction sent from the current shard replaces a pending one with the same sender and nonce, provided that its
+// gas price is high enough, otherwise it is rejected. It should only be used for the broadcast transactions
+func (txPool *shardedTxPool) AddDataWithReplacement(key []byte, value interface{}, sizeInBytes int, cacheID string) {
+	wrapper, ok := createWrappedTransaction(key, value, sizeInBytes, cacheID)
+	if !ok {
+		return
+	}
+
+	txPool.addTxWithReplacement(wrapper, cacheID)
+}
+
+func createWrappedTransaction(key []byte, value interface{}, sizeInBytes int, cacheID string) (*txcache.WrappedTransaction, bool) {
+	valueAsTransaction, ok := value.(data.TransactionHandler)
+	if !ok {
+		return nil, false
+	}
+
 	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
 	if err != nil {
 		log.Error("shardedTxPool.AddData()", "err", err)
-		return
+		return nil, false
 	}
 
-	wrapper := &txcache.WrappedTransaction{
+	return &txcache.WrappedTransaction{
 		Tx:              valueAsTransaction,
 		TxHash:          key,
 		SenderShardID:   sourceShardID,
 		ReceiverShardID: destinationShardID,
 		Size:            int64(sizeInBytes),
-	}
-
-	txPool.addTx(wrapper, cacheID)
+	}, true
 }
 
 // addTx adds the transaction to the cache
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
-	cache := shard.Cache
-	_, added := cache.AddTx(tx)
+	_, added := shard.Cache.AddTx(tx)
+	if added {
+		txPool.onAdded(tx.TxHash, tx)
+	}
+}
+
+// addTxWithReplacement adds the transaction to the cache, applying the replacement rules
+func (txPool *shardedTxPool) addTxWithReplacement(tx *txcache.WrappedTransaction, cacheID string) {
+	shard := txPool.getOrCreateShard(cacheID)
+	_, added := shard.Cache.AddTxWithReplacement(tx)
 	if added {
 		txPool.onAdded(tx.TxHash, tx)
 	}
@@ -287,6 +317,20 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 	txPool.mutexBackingMap.Unlock()
 }
 
+// ForEachTransaction iterates over the transactions of all the caches of the pool
+func (txPool *shardedTxPool) ForEachTransaction(function txcache.ForEachTransaction) {
+	txPool.mutexBackingMap.RLock()
+	caches := make([]txCache, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		caches = append(caches, shard.Cache)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, cache := range caches {
+		cache.ForEachTransaction(function)
+	}
+}
+
 // Clear clears everything in the pool
 func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
@@ -327,6 +371,42 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// GetTransactionsForSender returns the transactions of the provided sender, sorted by nonce. Only the cache holding
+// the transactions sent from the self shard is inspected, since the transactions of a sender are indexed only there
+func (txPool *shardedTxPool) GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction {
+	cache, ok := txPool.getCacheForSendersInSelfShard()
+	if !ok {
+		return make([]*txcache.WrappedTransaction, 0)
+	}
+
+	return cache.GetTransactionsForSender(sender)
+}
+
+// GetNonceGapsForSender returns the intervals of nonces missing from the transactions of the provided sender,
+// starting with the given account nonce
+func (txPool *shardedTxPool) GetNonceGapsForSender(sender []byte, accountNonce uint64) []txcache.NonceGap {
+	cache, ok := txPool.getCacheForSendersInSelfShard()
+	if !ok {
+		return make([]txcache.NonceGap, 0)
+	}
+
+	return cache.GetNonceGapsForSender(sender, accountNonce)
+}
+
+func (txPool *shardedTxPool) getCacheForSendersInSelfShard() (txCacheForSenders, bool) {
+	cacheID := process.ShardCacherIdentifier(txPool.selfShardID, txPool.selfShardID)
+
+	txPool.mutexBackingMap.RLock()
+	shard, ok := txPool.backingMap[cacheID]
+	txPool.mutexBackingMap.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	cache, ok := shard.Cache.(txCacheForSenders)
+	return cache, ok
+}
+
 // Diagnose diagnoses the internal caches
 func (txPool *shardedTxPool) Diagnose(deep bool) {
 	log.Debug("shardedTxPool.Diagnose()", "counts", txPool.GetCounts().String())
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -76,6 +78,12 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidShards.Error())
 
+	args = goodArgs
+	args.Config.SelectionPolicy = "dummy"
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrCacheConfigInvalidSelectionPolicy))
+
 	args = goodArgs
 	args.TxGasHandler = &txcachemocks.TxGasHandlerMock{
 		MinimumGasMove:       50000,
@@ -96,7 +104,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, MinGasPriceBumpPercentForReplacement: 10, SelectionPolicy: txcache.SelectionPolicyGasPrice}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -116,6 +124,8 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.MinGasPriceBumpPercentForReplacement))
+	require.Equal(t, txcache.SelectionPolicyGasPrice, pool.configPrototypeSourceMe.SelectionPolicy)
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -181,6 +191,30 @@ func Test_AddData(t *testing.T) {
 	require.True(t, ok)
 }
 
+func Test_AddDataWithReplacement(t *testing.T) {
+	poolAsInterface, _ := newTxPoolWithReplacementToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	cacheSourceMe := pool.getTxCache("0")
+	cacheDestinationMe := pool.getTxCache("1_0")
+
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 100), 0, "0")
+	pool.AddData([]byte("hash-x-"), createTxWithGasPrice("alice", 42, 50), 0, "0")
+	require.Equal(t, 2, cacheSourceMe.Len())
+
+	pool.AddDataWithReplacement([]byte("hash-x+"), createTxWithGasPrice("alice", 42, 105), 0, "0")
+	require.Equal(t, 2, cacheSourceMe.Len())
+	pool.AddDataWithReplacement([]byte("hash-x++"), createTxWithGasPrice("alice", 42, 200), 0, "0")
+	require.Equal(t, 2, cacheSourceMe.Len())
+	_, ok := cacheSourceMe.GetByTxHash([]byte("hash-x++"))
+	require.True(t, ok)
+
+	// Cross-shard transactions are never replaced
+	pool.AddDataWithReplacement([]byte("hash-y"), createTxWithGasPrice("bob", 7, 100), 0, "1_0")
+	pool.AddDataWithReplacement([]byte("hash-y++"), createTxWithGasPrice("bob", 7, 200), 0, "1_0")
+	pool.AddDataWithReplacement([]byte("hash-y-"), createTxWithGasPrice("bob", 7, 50), 0, "1_0")
+	require.Equal(t, 3, cacheDestinationMe.Len())
+}
+
 func Test_AddData_NoPanic_IfNotATransaction(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 
@@ -282,6 +316,25 @@ func Test_MergeShardStores(t *testing.T) {
 	require.Equal(t, 2, pool.getTxCache("2_0").Len())
 }
 
+func Test_ForEachTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "1_0")
+	pool.AddData([]byte("hash-z"), createTx("carol", 15), 0, "2_0")
+
+	visited := make(map[string]struct{})
+	pool.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+		visited[string(txHash)] = struct{}{}
+	})
+
+	require.Equal(t, 3, len(visited))
+	require.Contains(t, visited, "hash-x")
+	require.Contains(t, visited, "hash-y")
+	require.Contains(t, visited, "hash-z")
+}
+
 func Test_Clear(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -333,6 +386,37 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetTransactionsForSender(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Len(t, pool.GetTransactionsForSender([]byte("alice")), 0)
+
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "0")
+	// Transactions from other shards are not indexed by sender
+	pool.AddData([]byte("hash-w"), createTx("alice", 44), 0, "1_0")
+
+	txs := pool.GetTransactionsForSender([]byte("alice"))
+	require.Len(t, txs, 2)
+	require.Equal(t, []byte("hash-x"), txs[0].TxHash)
+	require.Equal(t, []byte("hash-y"), txs[1].TxHash)
+}
+
+func Test_GetNonceGapsForSender(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Len(t, pool.GetNonceGapsForSender([]byte("alice"), 40), 0)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 45), 0, "0_1")
+
+	expectedGaps := []txcache.NonceGap{{From: 40, To: 41}, {From: 43, To: 44}}
+	require.Equal(t, expectedGaps, pool.GetNonceGapsForSender([]byte("alice"), 40))
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -381,6 +465,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -409,4 +501,26 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 	return NewShardedTxPool(args)
 }
 
+func newTxPoolWithReplacementToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
+	config := storageUnit.CacheConfig{
+		Capacity:                             100,
+		SizePerSender:                        10,
+		SizeInBytes:                          409600,
+		SizeInBytesPerSender:                 40960,
+		Shards:                               1,
+		MinGasPriceBumpPercentForReplacement: 10,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	return NewShardedTxPool(args)
+}
+
 // TODO: Add high load test, reach maximum capacity and inspect RAM usage. EN-6735.
@@ -0,0 +1,287 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// prepareEpochFromSnapshot bootstraps the node from the epoch start data and the state tries held by a snapshot file.
+// The snapshot is not trusted: its epoch start metablock has to match the one agreed by the network peers and its
+// nodes config has to match the one computed from the validators info synced from the network
+func (e *epochStartBootstrap) prepareEpochFromSnapshot() (Parameters, error) {
+	file, err := os.Open(e.importSnapshotFilePath)
+	if err != nil {
+		return Parameters{}, err
+	}
+	defer func() {
+		errClose := file.Close()
+		log.LogIfError(errClose)
+	}()
+
+	snapshotReader, err := snapshot.NewReader(snapshot.ArgsReader{
+		Reader:      file,
+		Marshalizer: e.marshalizer,
+		Hasher:      e.hasher,
+		ChainID:     []byte(e.genesisNodesConfig.GetChainId()),
+	})
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	snapshotData, err := snapshotReader.ReadData()
+	if err != nil {
+		return Parameters{}, err
+	}
+	log.Info("start in epoch bootstrap: read snapshot data",
+		"epoch", snapshotData.Epoch,
+		"shard", core.GetShardIDString(snapshotData.ShardID),
+		"epoch start meta nonce", snapshotData.EpochStartMetaBlock.Nonce,
+	)
+
+	err = e.checkSnapshotGenesis(snapshotData.EpochStartMetaBlock)
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	err = e.checkSnapshotEpochStartMeta(snapshotData.EpochStartMetaBlock)
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	e.epochStartMeta = snapshotData.EpochStartMetaBlock
+	e.prevEpochStartMeta = snapshotData.PreviousEpochStart
+	e.syncedHeaders = snapshotData.Headers
+	e.baseData.lastEpoch = snapshotData.Epoch
+	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
+	e.setEpochStartMetrics()
+
+	pubKey, err := e.publicKey.ToByteArray()
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	err = e.processNodesConfig(pubKey)
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	err = checkSnapshotNodesConfig(snapshotData.NodesConfig, e.nodesConfig, snapshotData.Epoch)
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	if e.baseData.shardId != snapshotData.ShardID {
+		return Parameters{}, fmt.Errorf("%w: snapshot shard %s, node shard %s", epochStart.ErrSnapshotShardMismatch,
+			core.GetShardIDString(snapshotData.ShardID), core.GetShardIDString(e.baseData.shardId))
+	}
+
+	e.shardCoordinator, err = sharding.NewMultiShardCoordinator(e.baseData.numberOfShards, e.baseData.shardId)
+	if err != nil {
+		return Parameters{}, fmt.Errorf("%w numberOfShards=%v shardId=%v", err, e.baseData.numberOfShards, e.baseData.shardId)
+	}
+
+	// the metachain tries components were already created when preparing the components needed to sync from the network
+	if e.baseData.shardId != core.MetachainShardId {
+		err = e.createTriesComponentsForShardId(e.baseData.shardId)
+		if err != nil {
+			return Parameters{}, err
+		}
+	}
+
+	err = snapshotReader.ImportTrieNodes(
+		e.trieStorageManagers[factory.UserAccountTrie].Database(),
+		e.trieStorageManagers[factory.PeerAccountTrie].Database(),
+	)
+	if err != nil {
+		return Parameters{}, err
+	}
+	log.Info("start in epoch bootstrap: imported and verified snapshot tries")
+
+	e.userAccountTries, err = e.recreateSnapshotTrie(factory.UserAccountTrie, snapshotData.UserAccountsRootHash())
+	if err != nil {
+		return Parameters{}, err
+	}
+	e.peerAccountTries = make(map[string]data.Trie)
+	if e.baseData.shardId == core.MetachainShardId {
+		e.peerAccountTries, err = e.recreateSnapshotTrie(factory.PeerAccountTrie, snapshotData.PeerAccountsRootHash())
+		if err != nil {
+			return Parameters{}, err
+		}
+	}
+
+	err = e.saveSnapshotDataToStorage(snapshotData)
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	return Parameters{
+		Epoch:       e.baseData.lastEpoch,
+		SelfShardId: e.baseData.shardId,
+		NumOfShards: e.baseData.numberOfShards,
+		NodesConfig: e.nodesConfig,
+	}, nil
+}
+
+// checkSnapshotGenesis checks that the snapshot epoch start metablock was produced after the genesis of the node's chain,
+// in the round matching its timestamp
+func (e *epochStartBootstrap) checkSnapshotGenesis(epochStartMeta *block.MetaBlock) error {
+	if epochStartMeta.Epoch <= e.startEpoch || int64(epochStartMeta.Round) <= e.startRound {
+		return fmt.Errorf("%w: epoch %d, round %d", epochStart.ErrSnapshotNotAfterGenesis, epochStartMeta.Epoch, epochStartMeta.Round)
+	}
+
+	roundsSinceGenesis := int64(epochStartMeta.Round) - e.startRound
+	roundDurationInMillis := int64(e.genesisNodesConfig.GetRoundDuration())
+	expectedTimeStamp := (e.genesisNodesConfig.GetStartTime()*1000 + roundsSinceGenesis*roundDurationInMillis) / 1000
+	if int64(epochStartMeta.TimeStamp) != expectedTimeStamp {
+		return fmt.Errorf("%w: round %d has timestamp %d, expected %d", epochStart.ErrSnapshotNotAfterGenesis,
+			epochStartMeta.Round, epochStartMeta.TimeStamp, expectedTimeStamp)
+	}
+
+	return nil
+}
+
+// checkSnapshotEpochStartMeta checks the snapshot epoch start metablock against the one agreed by the network peers
+func (e *epochStartBootstrap) checkSnapshotEpochStartMeta(snapshotEpochStartMeta *block.MetaBlock) error {
+	err := e.prepareComponentsToSyncFromNetwork()
+	if err != nil {
+		return err
+	}
+
+	networkEpochStartMeta, err := e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	if err != nil {
+		return err
+	}
+
+	snapshotHash, err := core.CalculateHash(e.marshalizer, e.hasher, snapshotEpochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	networkHash, err := core.CalculateHash(e.marshalizer, e.hasher, networkEpochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(snapshotHash, networkHash) {
+		return fmt.Errorf("%w: snapshot epoch %d, network epoch %d", epochStart.ErrSnapshotEpochStartMetaMismatch,
+			snapshotEpochStartMeta.Epoch, networkEpochStartMeta.Epoch)
+	}
+
+	return nil
+}
+
+// checkSnapshotNodesConfig checks that the snapshot holds the same eligible and waiting validators for the snapshot
+// epoch as the nodes config computed by the node
+func checkSnapshotNodesConfig(
+	snapshotNodesConfig *sharding.NodesCoordinatorRegistry,
+	nodesConfig *sharding.NodesCoordinatorRegistry,
+	epoch uint32,
+) error {
+	epochKey := fmt.Sprintf("%d", epoch)
+	snapshotValidators := snapshotNodesConfig.EpochsConfig[epochKey]
+	computedValidators := nodesConfig.EpochsConfig[epochKey]
+	if snapshotValidators == nil || computedValidators == nil {
+		return fmt.Errorf("%w: missing epoch %d", epochStart.ErrSnapshotNodesConfigMismatch, epoch)
+	}
+
+	if !sameValidators(snapshotValidators.EligibleValidators, computedValidators.EligibleValidators) {
+		return fmt.Errorf("%w: different eligible validators", epochStart.ErrSnapshotNodesConfigMismatch)
+	}
+	if !sameValidators(snapshotValidators.WaitingValidators, computedValidators.WaitingValidators) {
+		return fmt.Errorf("%w: different waiting validators", epochStart.ErrSnapshotNodesConfigMismatch)
+	}
+
+	return nil
+}
+
+func sameValidators(first map[string][]*sharding.SerializableValidator, second map[string][]*sharding.SerializableValidator) bool {
+	if countValidators(first) != countValidators(second) {
+		return false
+	}
+
+	for shardID, validators := range first {
+		if len(validators) != len(second[shardID]) {
+			return false
+		}
+
+		for i, validator := range validators {
+			if !bytes.Equal(validator.PubKey, second[shardID][i].PubKey) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func countValidators(validatorsPerShard map[string][]*sharding.SerializableValidator) int {
+	numValidators := 0
+	for _, validators := range validatorsPerShard {
+		numValidators += len(validators)
+	}
+
+	return numValidators
+}
+
+func (e *epochStartBootstrap) recreateSnapshotTrie(trieID string, rootHash []byte) (map[string]data.Trie, error) {
+	recreatedTrie, err := e.trieContainer.Get([]byte(trieID)).Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]data.Trie{string(rootHash): recreatedTrie}, nil
+}
+
+func (e *epochStartBootstrap) saveSnapshotDataToStorage(snapshotData *snapshot.Data) error {
+	components := &ComponentsNeededForBootstrap{
+		EpochStartMetaBlock: e.epochStartMeta,
+		PreviousEpochStart:  e.prevEpochStartMeta,
+		NodesConfig:         e.nodesConfig,
+		Headers:             e.syncedHeaders,
+		ShardCoordinator:    e.shardCoordinator,
+		UserAccountTries:    e.userAccountTries,
+		PeerAccountTries:    e.peerAccountTries,
+	}
+
+	if e.baseData.shardId == core.MetachainShardId {
+		storageHandlerComponent, err := NewMetaStorageHandler(
+			e.generalConfig,
+			e.shardCoordinator,
+			e.pathManager,
+			e.marshalizer,
+			e.hasher,
+			e.baseData.lastEpoch,
+			e.uint64Converter,
+		)
+		if err != nil {
+			return err
+		}
+
+		return storageHandlerComponent.SaveDataToStorage(components)
+	}
+
+	components.ShardHeader = snapshotData.ShardHeader
+	storageHandlerComponent, err := NewShardStorageHandler(
+		e.generalConfig,
+		e.shardCoordinator,
+		e.pathManager,
+		e.marshalizer,
+		e.hasher,
+		e.baseData.lastEpoch,
+		e.uint64Converter,
+	)
+	if err != nil {
+		return err
+	}
+
+	return storageHandlerComponent.SaveDataToStorage(components)
+}
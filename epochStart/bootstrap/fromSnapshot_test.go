@@ -0,0 +1,74 @@
+package bootstrap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNodesConfigForEpoch(epoch string, eligible []string, waiting []string) *sharding.NodesCoordinatorRegistry {
+	createValidators := func(pubKeys []string) map[string][]*sharding.SerializableValidator {
+		validators := make(map[string][]*sharding.SerializableValidator)
+		for _, pubKey := range pubKeys {
+			validators["0"] = append(validators["0"], &sharding.SerializableValidator{PubKey: []byte(pubKey)})
+		}
+
+		return validators
+	}
+
+	return &sharding.NodesCoordinatorRegistry{
+		EpochsConfig: map[string]*sharding.EpochValidators{
+			epoch: {
+				EligibleValidators: createValidators(eligible),
+				WaitingValidators:  createValidators(waiting),
+			},
+		},
+	}
+}
+
+func TestEpochStartBootstrap_CheckSnapshotGenesis(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.GenesisNodesConfig = &mock.NodesSetupStub{
+		GetStartTimeCalled: func() int64 {
+			return 1000
+		},
+		GetRoundDurationCalled: func() uint64 {
+			return 6000
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	err := epochStartProvider.checkSnapshotGenesis(&block.MetaBlock{Epoch: 2, Round: 100, TimeStamp: 1600})
+	assert.Nil(t, err)
+
+	err = epochStartProvider.checkSnapshotGenesis(&block.MetaBlock{Epoch: 2, Round: 100, TimeStamp: 1606})
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotNotAfterGenesis))
+
+	err = epochStartProvider.checkSnapshotGenesis(&block.MetaBlock{Epoch: 0, Round: 100, TimeStamp: 1600})
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotNotAfterGenesis))
+}
+
+func TestCheckSnapshotNodesConfig(t *testing.T) {
+	t.Parallel()
+
+	computed := createNodesConfigForEpoch("2", []string{"a", "b"}, []string{"c"})
+
+	err := checkSnapshotNodesConfig(createNodesConfigForEpoch("2", []string{"a", "b"}, []string{"c"}), computed, 2)
+	assert.Nil(t, err)
+
+	err = checkSnapshotNodesConfig(createNodesConfigForEpoch("1", []string{"a", "b"}, []string{"c"}), computed, 2)
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotNodesConfigMismatch))
+
+	err = checkSnapshotNodesConfig(createNodesConfigForEpoch("2", []string{"a", "x"}, []string{"c"}), computed, 2)
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotNodesConfigMismatch))
+
+	err = checkSnapshotNodesConfig(createNodesConfigForEpoch("2", []string{"a", "b"}, nil), computed, 2)
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotNodesConfigMismatch))
+}
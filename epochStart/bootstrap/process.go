@@ -107,6 +107,7 @@ type epochStartBootstrap struct {
 	enableSignTxWithHashEpoch  uint32
 	txSignHasher               hashing.Hasher
 	epochNotifier              process.EpochNotifier
+	importSnapshotFilePath     string
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -178,6 +179,7 @@ type ArgsEpochStartBootstrap struct {
 	HeaderIntegrityVerifier    process.HeaderIntegrityVerifier
 	TxSignHasher               hashing.Hasher
 	EpochNotifier              process.EpochNotifier
+	ImportSnapshotFilePath     string
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -222,6 +224,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		txSignHasher:               args.TxSignHasher,
 		enableSignTxWithHashEpoch:  args.GeneralConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
 		epochNotifier:              args.EpochNotifier,
+		importSnapshotFilePath:     args.ImportSnapshotFilePath,
 	}
 
 	whiteListCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(epochStartProvider.generalConfig.WhiteListPool))
@@ -320,6 +323,9 @@ func (e *epochStartBootstrap) GetTriesComponents() (state.TriesHolder, map[strin
 func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 	if !e.generalConfig.GeneralSettings.StartInEpochEnabled {
 		log.Warn("fast bootstrap is disabled")
+		if len(e.importSnapshotFilePath) > 0 {
+			log.Warn("snapshot import skipped as fast bootstrap is disabled", "snapshot", e.importSnapshotFilePath)
+		}
 
 		e.initializeFromLocalStorage()
 		if !e.baseData.storageExists {
@@ -385,6 +391,15 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		return Parameters{}, err
 	}
 
+	if len(e.importSnapshotFilePath) > 0 {
+		e.initializeFromLocalStorage()
+		if !e.baseData.storageExists {
+			return e.prepareEpochFromSnapshot()
+		}
+
+		log.Warn("snapshot import skipped as the node storage already exists", "snapshot", e.importSnapshotFilePath)
+	}
+
 	isStartInEpochZero := e.isStartInEpochZero()
 	isCurrentEpochSaved := e.computeIfCurrentEpochIsSaved()
 
@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 	"errors"
+	"os"
 	"testing"
 	"time"
 
@@ -611,3 +612,65 @@ func TestEpochStartBootstrap_WithDisabledShardIDAsOBserver(t *testing.T) {
 	err = epochStartProvider.processNodesConfig([]byte("something"))
 	assert.Nil(t, err)
 }
+
+func createMockEpochStartBootstrapArgsForSnapshotImport() ArgsEpochStartBootstrap {
+	args := createMockEpochStartBootstrapArgs()
+	args.GeneralConfig = testscommon.GetGeneralConfig()
+	args.GeneralConfig.GeneralSettings.StartInEpochEnabled = true
+	args.EconomicsData = &economicsmocks.EconomicsHandlerStub{
+		MinGasPriceCalled: func() uint64 {
+			return 1
+		},
+	}
+	args.ImportSnapshotFilePath = "missing_snapshot_file.bin"
+
+	return args
+}
+
+func TestEpochStartBootstrap_BootstrapWithMissingSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgsForSnapshotImport()
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetCalled: func() (storage.LatestDataFromStorage, error) {
+			return storage.LatestDataFromStorage{}, errors.New("no storage")
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	_, err := epochStartProvider.Bootstrap()
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestEpochStartBootstrap_BootstrapWithFastBootstrapDisabledShouldSkipSnapshotImport(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.ImportSnapshotFilePath = "missing_snapshot_file.bin"
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetCalled: func() (storage.LatestDataFromStorage, error) {
+			return storage.LatestDataFromStorage{}, errors.New("no storage")
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	params, err := epochStartProvider.Bootstrap()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), params.Epoch)
+}
+
+func TestEpochStartBootstrap_BootstrapWithExistingStorageShouldSkipSnapshotImport(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgsForSnapshotImport()
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetCalled: func() (storage.LatestDataFromStorage, error) {
+			return storage.LatestDataFromStorage{Epoch: 0}, nil
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	params, err := epochStartProvider.Bootstrap()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), params.Epoch)
+}
@@ -286,3 +286,15 @@ var ErrSystemValidatorSCCall = errors.New("system validator sc call failed")
 
 // ErrOwnerDoesntHaveEligibleNodesInEpoch signals that the owner doesn't have any eligible nodes in epoch
 var ErrOwnerDoesntHaveEligibleNodesInEpoch = errors.New("owner has no eligible nodes in epoch")
+
+// ErrSnapshotShardMismatch signals that the imported snapshot was exported for a different shard than the node's one
+var ErrSnapshotShardMismatch = errors.New("snapshot shard mismatch")
+
+// ErrSnapshotNotAfterGenesis signals that the snapshot epoch start metablock does not follow the genesis of the node's chain
+var ErrSnapshotNotAfterGenesis = errors.New("snapshot epoch start metablock does not follow the genesis")
+
+// ErrSnapshotEpochStartMetaMismatch signals that the snapshot epoch start metablock is not the one agreed by the network peers
+var ErrSnapshotEpochStartMetaMismatch = errors.New("snapshot epoch start metablock does not match the network one")
+
+// ErrSnapshotNodesConfigMismatch signals that the snapshot nodes config does not match the one computed by the node
+var ErrSnapshotNodesConfigMismatch = errors.New("snapshot nodes config mismatch")
@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("epochStart/snapshot")
+
+// the snapshot is a sequence of records, each one being written as its type, the payload length and the payload.
+// The first record holds the snapshot info, followed by the trie nodes records and by the end record
+const (
+	recordInfo                   = byte(1)
+	recordUserAccountsTrieNode   = byte(2)
+	recordPeerAccountsTrieNode   = byte(3)
+	recordEnd                    = byte(4)
+	recordHeaderSize             = 5
+	maxRecordPayloadSize         = 1 << 30
+	snapshotMagic                = "ERDSNAPSHOT"
+	currentSnapshotFormatVersion = uint32(1)
+)
+
+type snapshotHeader struct {
+	ShardID uint32 `json:"shardID"`
+	Header  []byte `json:"header"`
+}
+
+// snapshotInfo is the serialized form of the snapshot data, the headers being marshalized with the internal marshalizer
+type snapshotInfo struct {
+	ShardID                     uint32            `json:"shardID"`
+	Epoch                       uint32            `json:"epoch"`
+	EpochStartMetaBlock         []byte            `json:"epochStartMetaBlock"`
+	PreviousEpochStartMetaBlock []byte            `json:"previousEpochStartMetaBlock"`
+	ShardHeader                 []byte            `json:"shardHeader"`
+	Headers                     []*snapshotHeader `json:"headers"`
+	NodesConfig                 []byte            `json:"nodesConfig"`
+}
+
+// Data holds the epoch start data read from a snapshot, in the form needed by the epoch start bootstrap
+type Data struct {
+	ShardID             uint32
+	Epoch               uint32
+	EpochStartMetaBlock *block.MetaBlock
+	PreviousEpochStart  *block.MetaBlock
+	ShardHeader         *block.Header
+	Headers             map[string]data.HeaderHandler
+	NodesConfig         *sharding.NodesCoordinatorRegistry
+}
+
+// UserAccountsRootHash returns the root hash of the snapshot user accounts trie
+func (d *Data) UserAccountsRootHash() []byte {
+	if d.ShardID == core.MetachainShardId {
+		return d.EpochStartMetaBlock.RootHash
+	}
+
+	return d.ShardHeader.RootHash
+}
+
+// PeerAccountsRootHash returns the root hash of the snapshot peer accounts trie, only held by metachain snapshots
+func (d *Data) PeerAccountsRootHash() []byte {
+	if d.ShardID == core.MetachainShardId {
+		return d.EpochStartMetaBlock.ValidatorStatsRootHash
+	}
+
+	return nil
+}
+
+func writeRecord(w io.Writer, recordType byte, payload []byte) error {
+	recordHeader := make([]byte, recordHeaderSize)
+	recordHeader[0] = recordType
+	binary.BigEndian.PutUint32(recordHeader[1:], uint32(len(payload)))
+
+	_, err := w.Write(recordHeader)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(payload)
+	return err
+}
+
+func readRecord(r io.Reader) (byte, []byte, error) {
+	recordHeader := make([]byte, recordHeaderSize)
+	_, err := io.ReadFull(r, recordHeader)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	payloadSize := binary.BigEndian.Uint32(recordHeader[1:])
+	if payloadSize > maxRecordPayloadSize {
+		return 0, nil, fmt.Errorf("%w: record of %d bytes", ErrInvalidSnapshotFormat, payloadSize)
+	}
+
+	payload := make([]byte, payloadSize)
+	_, err = io.ReadFull(r, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return recordHeader[0], payload, nil
+}
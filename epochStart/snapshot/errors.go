@@ -0,0 +1,60 @@
+package snapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUserAccountsTrie signals that a nil user accounts trie has been provided
+var ErrNilUserAccountsTrie = errors.New("nil user accounts trie")
+
+// ErrNilPeerAccountsTrie signals that a nil peer accounts trie has been provided
+var ErrNilPeerAccountsTrie = errors.New("nil peer accounts trie")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilTrieStorage signals that a nil trie storage has been provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrWrongSnapshotChainID signals that a snapshot header belongs to a different chain than the node's one
+var ErrWrongSnapshotChainID = errors.New("wrong snapshot chain ID")
+
+// ErrInvalidSnapshotFormat signals that the provided input is not a snapshot or it has an unsupported version
+var ErrInvalidSnapshotFormat = errors.New("invalid snapshot format")
+
+// ErrUnexpectedSnapshotRecord signals that a snapshot record was found where it was not expected
+var ErrUnexpectedSnapshotRecord = errors.New("unexpected snapshot record")
+
+// ErrSnapshotDataNotRead signals that the trie nodes were imported before reading the snapshot data
+var ErrSnapshotDataNotRead = errors.New("snapshot data not read")
+
+// ErrNotEpochStartBlock signals that the snapshot metablock is not an epoch start block
+var ErrNotEpochStartBlock = errors.New("not an epoch start block")
+
+// ErrWrongSnapshotHeader signals that a snapshot header does not match the hash referenced by the epoch start metablock
+var ErrWrongSnapshotHeader = errors.New("wrong snapshot header")
+
+// ErrMissingSnapshotHeader signals that a header needed for bootstrap is missing from the snapshot
+var ErrMissingSnapshotHeader = errors.New("missing snapshot header")
+
+// ErrInvalidSnapshotNodesConfig signals that the snapshot nodes config does not hold the snapshot epoch
+var ErrInvalidSnapshotNodesConfig = errors.New("invalid snapshot nodes config")
+
+// ErrInvalidSnapshotTrieNode signals that a snapshot trie node does not hash to its reference
+var ErrInvalidSnapshotTrieNode = errors.New("invalid snapshot trie node")
+
+// ErrIncompleteSnapshotTrie signals that the imported trie nodes do not form the complete trie referenced by the epoch start data
+var ErrIncompleteSnapshotTrie = errors.New("incomplete snapshot trie")
+
+// ErrWrongNumberOfTrieNodes signals that the number of read trie nodes does not match the one written in the snapshot
+var ErrWrongNumberOfTrieNodes = errors.New("wrong number of trie nodes")
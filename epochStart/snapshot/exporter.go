@@ -0,0 +1,349 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgsExporter holds the arguments needed to create a new snapshot exporter
+type ArgsExporter struct {
+	Marshalizer      marshal.Marshalizer
+	StorageService   dataRetriever.StorageService
+	UserAccountsTrie data.Trie
+	PeerAccountsTrie data.Trie
+	ShardID          uint32
+	Epoch            uint32
+}
+
+type exporter struct {
+	marshalizer      marshal.Marshalizer
+	storageService   dataRetriever.StorageService
+	userAccountsTrie data.Trie
+	peerAccountsTrie data.Trie
+	shardID          uint32
+	epoch            uint32
+}
+
+// NewExporter creates a new snapshot exporter which writes the epoch start data and state tries of a stopped node
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.UserAccountsTrie) {
+		return nil, ErrNilUserAccountsTrie
+	}
+	if args.ShardID == core.MetachainShardId && check.IfNil(args.PeerAccountsTrie) {
+		return nil, ErrNilPeerAccountsTrie
+	}
+
+	return &exporter{
+		marshalizer:      args.Marshalizer,
+		storageService:   args.StorageService,
+		userAccountsTrie: args.UserAccountsTrie,
+		peerAccountsTrie: args.PeerAccountsTrie,
+		shardID:          args.ShardID,
+		epoch:            args.Epoch,
+	}, nil
+}
+
+// Export writes the snapshot of the configured epoch start to the provided writer
+func (e *exporter) Export(w io.Writer) error {
+	info, snapshotData, err := e.createSnapshotInfo()
+	if err != nil {
+		return err
+	}
+
+	infoBytes, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(createSnapshotPreamble())
+	if err != nil {
+		return err
+	}
+
+	err = writeRecord(w, recordInfo, infoBytes)
+	if err != nil {
+		return err
+	}
+
+	numNodes, err := e.exportUserAccountsTrie(w, snapshotData.UserAccountsRootHash())
+	if err != nil {
+		return err
+	}
+
+	if e.shardID == core.MetachainShardId {
+		numPeerNodes, errExport := e.exportTrie(w, recordPeerAccountsTrieNode, e.peerAccountsTrie, snapshotData.PeerAccountsRootHash())
+		if errExport != nil {
+			return errExport
+		}
+		numNodes += numPeerNodes
+	}
+
+	numNodesBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numNodesBytes, numNodes)
+
+	log.Debug("snapshot exported", "epoch", e.epoch, "shard", e.shardID, "num trie nodes", numNodes)
+
+	return writeRecord(w, recordEnd, numNodesBytes)
+}
+
+func (e *exporter) createSnapshotInfo() (*snapshotInfo, *Data, error) {
+	metaStorer := e.storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	epochStartMetaBytes, err := metaStorer.Get([]byte(core.EpochStartIdentifier(e.epoch)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while getting the epoch start metablock for epoch %d", err, e.epoch)
+	}
+
+	epochStartMeta := &block.MetaBlock{}
+	err = e.marshalizer.Unmarshal(epochStartMeta, epochStartMetaBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !epochStartMeta.IsStartOfEpochBlock() {
+		return nil, nil, ErrNotEpochStartBlock
+	}
+
+	info := &snapshotInfo{
+		ShardID:             e.shardID,
+		Epoch:               e.epoch,
+		EpochStartMetaBlock: epochStartMetaBytes,
+		Headers:             make([]*snapshotHeader, 0),
+	}
+	snapshotData := &Data{
+		ShardID:             e.shardID,
+		Epoch:               e.epoch,
+		EpochStartMetaBlock: epochStartMeta,
+	}
+
+	info.PreviousEpochStartMetaBlock, err = e.getPreviousEpochStartMetaBlock(epochStartMeta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headersToExport, err := e.getHeadersToExport(epochStartMeta)
+	if err != nil {
+		return nil, nil, err
+	}
+	info.Headers = headersToExport
+
+	if e.shardID != core.MetachainShardId {
+		info.ShardHeader, snapshotData.ShardHeader, err = e.getSelfShardHeader(epochStartMeta)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	bootstrapStorer := e.storageService.GetStorer(dataRetriever.BootstrapUnit)
+	nodesConfigKey := append([]byte(core.NodesCoordinatorRegistryKeyPrefix), epochStartMeta.PrevRandSeed...)
+	info.NodesConfig, err = bootstrapStorer.Get(nodesConfigKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while getting the nodes coordinator registry", err)
+	}
+
+	return info, snapshotData, nil
+}
+
+func (e *exporter) getPreviousEpochStartMetaBlock(epochStartMeta *block.MetaBlock) ([]byte, error) {
+	if epochStartMeta.Epoch <= 1 {
+		// the previous epoch start is the genesis block, the bootstrap process uses an empty metablock in this case
+		return nil, nil
+	}
+
+	metaStorer := e.storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	prevEpochStartMetaBytes, err := metaStorer.Get(epochStartMeta.EpochStart.Economics.PrevEpochStartHash)
+	if err == nil {
+		return prevEpochStartMetaBytes, nil
+	}
+
+	prevEpochStartMetaBytes, err = metaStorer.Get([]byte(core.EpochStartIdentifier(epochStartMeta.Epoch - 1)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the previous epoch start metablock", err)
+	}
+
+	return prevEpochStartMetaBytes, nil
+}
+
+func (e *exporter) getHeadersToExport(epochStartMeta *block.MetaBlock) ([]*snapshotHeader, error) {
+	if e.shardID == core.MetachainShardId {
+		headers := make([]*snapshotHeader, 0, len(epochStartMeta.EpochStart.LastFinalizedHeaders))
+		for _, shardData := range epochStartMeta.EpochStart.LastFinalizedHeaders {
+			headerBytes, err := e.getHeaderBytes(dataRetriever.BlockHeaderUnit, shardData.HeaderHash)
+			if err != nil {
+				return nil, err
+			}
+
+			headers = append(headers, &snapshotHeader{ShardID: shardData.ShardID, Header: headerBytes})
+		}
+
+		return headers, nil
+	}
+
+	shardData, err := getShardData(epochStartMeta, e.shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]*snapshotHeader, 0, 2)
+	metaHashes := [][]byte{shardData.FirstPendingMetaBlock, shardData.LastFinishedMetaBlock}
+	for _, metaHash := range metaHashes {
+		headerBytes, errGet := e.getHeaderBytes(dataRetriever.MetaBlockUnit, metaHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		headers = append(headers, &snapshotHeader{ShardID: core.MetachainShardId, Header: headerBytes})
+	}
+
+	return headers, nil
+}
+
+func (e *exporter) getSelfShardHeader(epochStartMeta *block.MetaBlock) ([]byte, *block.Header, error) {
+	shardData, err := getShardData(epochStartMeta, e.shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headerBytes, err := e.getHeaderBytes(dataRetriever.BlockHeaderUnit, shardData.HeaderHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shardHeader := &block.Header{}
+	err = e.marshalizer.Unmarshal(shardHeader, headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerBytes, shardHeader, nil
+}
+
+func (e *exporter) getHeaderBytes(unitType dataRetriever.UnitType, hash []byte) ([]byte, error) {
+	headerBytes, err := e.storageService.GetStorer(unitType).Get(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for header %x", ErrMissingSnapshotHeader, hash)
+	}
+
+	return headerBytes, nil
+}
+
+func (e *exporter) exportUserAccountsTrie(w io.Writer, rootHash []byte) (uint64, error) {
+	numNodes, err := e.exportTrie(w, recordUserAccountsTrieNode, e.userAccountsTrie, rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	dataTriesRootHashes, err := e.getDataTriesRootHashes(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		numDataTrieNodes, errExport := e.exportTrie(w, recordUserAccountsTrieNode, e.userAccountsTrie, dataTrieRootHash)
+		if errExport != nil {
+			return 0, errExport
+		}
+		numNodes += numDataTrieNodes
+	}
+
+	return numNodes, nil
+}
+
+func (e *exporter) getDataTriesRootHashes(rootHash []byte) ([][]byte, error) {
+	leavesChannel, err := e.userAccountsTrie.GetAllLeavesOnChannel(rootHash, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	uniqueRootHashes := make(map[string]struct{})
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		err = e.marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("this must be a leaf with code", "err", err)
+			continue
+		}
+
+		if len(account.RootHash) == 0 {
+			continue
+		}
+		if _, exists := uniqueRootHashes[string(account.RootHash)]; exists {
+			continue
+		}
+
+		uniqueRootHashes[string(account.RootHash)] = struct{}{}
+		dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+	}
+
+	return dataTriesRootHashes, nil
+}
+
+func (e *exporter) exportTrie(w io.Writer, recordType byte, tr data.Trie, rootHash []byte) (uint64, error) {
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes, err := recreatedTrie.GetAllHashes()
+	if err != nil {
+		return 0, err
+	}
+
+	db := tr.Database()
+	for _, hash := range hashes {
+		value, errGet := db.Get(hash)
+		if errGet != nil {
+			return 0, fmt.Errorf("%w for trie node %x", errGet, hash)
+		}
+
+		payload := make([]byte, 0, len(hash)+len(value))
+		payload = append(payload, hash...)
+		payload = append(payload, value...)
+		err = writeRecord(w, recordType, payload)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(hashes)), nil
+}
+
+func getShardData(epochStartMeta *block.MetaBlock, shardID uint32) (*block.EpochStartShardData, error) {
+	for i := range epochStartMeta.EpochStart.LastFinalizedHeaders {
+		shardData := &epochStartMeta.EpochStart.LastFinalizedHeaders[i]
+		if shardData.ShardID == shardID {
+			return shardData, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for shard %d", ErrMissingSnapshotHeader, shardID)
+}
+
+func createSnapshotPreamble() []byte {
+	preamble := make([]byte, len(snapshotMagic)+4)
+	copy(preamble, snapshotMagic)
+	binary.BigEndian.PutUint32(preamble[len(snapshotMagic):], currentSnapshotFormatVersion)
+
+	return preamble
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,292 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEpoch = uint32(2)
+
+var testChainID = []byte("chain ID")
+
+var (
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+	testHasher      = &blake2b.Blake2b{}
+)
+
+type testStorage struct {
+	storageService   *dataRetriever.ChainStorer
+	userAccountsTrie data.Trie
+	peerAccountsTrie data.Trie
+	userRootHash     []byte
+	peerRootHash     []byte
+	epochStartMeta   *block.MetaBlock
+	shardHeaderHash  []byte
+	numAccounts      int
+}
+
+func createTrie(t *testing.T) data.Trie {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func marshalAndHash(t *testing.T, obj interface{}) ([]byte, []byte) {
+	buff, err := testMarshalizer.Marshal(obj)
+	require.Nil(t, err)
+
+	return buff, testHasher.Compute(string(buff))
+}
+
+func fillUserAccountsTrie(t *testing.T, tr data.Trie, numAccounts int) []byte {
+	dataTrie, err := tr.Recreate(make([]byte, 0))
+	require.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		err = dataTrie.Update([]byte(fmt.Sprintf("data key %d", i)), []byte(fmt.Sprintf("data value %d", i)))
+		require.Nil(t, err)
+	}
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, _ := dataTrie.Root()
+
+	for i := 0; i < numAccounts; i++ {
+		account := state.NewEmptyUserAccount()
+		account.Nonce = uint64(i)
+		account.Balance = big.NewInt(int64(i * 100))
+		if i%2 == 0 {
+			account.RootHash = dataTrieRootHash
+		}
+
+		accountBytes, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(t, errMarshal)
+		err = tr.Update([]byte(fmt.Sprintf("address %d", i)), accountBytes)
+		require.Nil(t, err)
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, _ := tr.Root()
+	return rootHash
+}
+
+func createTestStorage(t *testing.T, shardID uint32) *testStorage {
+	ts := &testStorage{
+		storageService:   dataRetriever.NewChainStorer(),
+		userAccountsTrie: createTrie(t),
+		peerAccountsTrie: createTrie(t),
+		numAccounts:      20,
+	}
+	metaStorer := mock.NewStorerMock()
+	headersStorer := mock.NewStorerMock()
+	bootstrapStorer := mock.NewStorerMock()
+	ts.storageService.AddStorer(dataRetriever.MetaBlockUnit, metaStorer)
+	ts.storageService.AddStorer(dataRetriever.BlockHeaderUnit, headersStorer)
+	ts.storageService.AddStorer(dataRetriever.BootstrapUnit, bootstrapStorer)
+
+	ts.userRootHash = fillUserAccountsTrie(t, ts.userAccountsTrie, ts.numAccounts)
+	for i := 0; i < 5; i++ {
+		err := ts.peerAccountsTrie.Update([]byte(fmt.Sprintf("validator %d", i)), []byte(fmt.Sprintf("peer account %d", i)))
+		require.Nil(t, err)
+	}
+	require.Nil(t, ts.peerAccountsTrie.Commit())
+	ts.peerRootHash, _ = ts.peerAccountsTrie.Root()
+
+	prevEpochStartMetaBytes, prevEpochStartMetaHash := marshalAndHash(t, &block.MetaBlock{Nonce: 10, Epoch: testEpoch - 1, ChainID: testChainID})
+	firstPendingBytes, firstPendingHash := marshalAndHash(t, &block.MetaBlock{Nonce: 18, Epoch: testEpoch - 1, ChainID: testChainID})
+	lastFinishedBytes, lastFinishedHash := marshalAndHash(t, &block.MetaBlock{Nonce: 17, Epoch: testEpoch - 1, ChainID: testChainID})
+	_ = metaStorer.Put(prevEpochStartMetaHash, prevEpochStartMetaBytes)
+	_ = metaStorer.Put(firstPendingHash, firstPendingBytes)
+	_ = metaStorer.Put(lastFinishedHash, lastFinishedBytes)
+
+	shardHeaderRootHash := ts.userRootHash
+	if shardID == core.MetachainShardId {
+		shardHeaderRootHash = []byte("shard root hash")
+	}
+	shardHeaderBytes, shardHeaderHash := marshalAndHash(t, &block.Header{Nonce: 30, ShardID: 0, Epoch: testEpoch, RootHash: shardHeaderRootHash, ChainID: testChainID})
+	_ = headersStorer.Put(shardHeaderHash, shardHeaderBytes)
+	ts.shardHeaderHash = shardHeaderHash
+
+	ts.epochStartMeta = &block.MetaBlock{
+		Nonce:                  20,
+		Epoch:                  testEpoch,
+		ChainID:                testChainID,
+		PrevRandSeed:           []byte("prev rand seed"),
+		RootHash:               []byte("meta root hash"),
+		ValidatorStatsRootHash: []byte("meta validator stats root hash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:               0,
+					HeaderHash:            shardHeaderHash,
+					FirstPendingMetaBlock: firstPendingHash,
+					LastFinishedMetaBlock: lastFinishedHash,
+				},
+			},
+			Economics: block.Economics{
+				PrevEpochStartHash: prevEpochStartMetaHash,
+			},
+		},
+	}
+	if shardID == core.MetachainShardId {
+		ts.epochStartMeta.RootHash = ts.userRootHash
+		ts.epochStartMeta.ValidatorStatsRootHash = ts.peerRootHash
+	}
+	epochStartMetaBytes, _ := marshalAndHash(t, ts.epochStartMeta)
+	_ = metaStorer.Put([]byte(core.EpochStartIdentifier(testEpoch)), epochStartMetaBytes)
+
+	nodesConfig := &sharding.NodesCoordinatorRegistry{
+		EpochsConfig: map[string]*sharding.EpochValidators{
+			fmt.Sprintf("%d", testEpoch): {},
+		},
+		CurrentEpoch: testEpoch,
+	}
+	nodesConfigBytes, _ := json.Marshal(nodesConfig)
+	_ = bootstrapStorer.Put(append([]byte(core.NodesCoordinatorRegistryKeyPrefix), ts.epochStartMeta.PrevRandSeed...), nodesConfigBytes)
+
+	return ts
+}
+
+func createArgsExporter(ts *testStorage, shardID uint32) snapshot.ArgsExporter {
+	return snapshot.ArgsExporter{
+		Marshalizer:      testMarshalizer,
+		StorageService:   ts.storageService,
+		UserAccountsTrie: ts.userAccountsTrie,
+		PeerAccountsTrie: ts.peerAccountsTrie,
+		ShardID:          shardID,
+		Epoch:            testEpoch,
+	}
+}
+
+func exportSnapshot(t *testing.T, ts *testStorage, shardID uint32) []byte {
+	exp, err := snapshot.NewExporter(createArgsExporter(ts, shardID))
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	err = exp.Export(buff)
+	require.Nil(t, err)
+
+	return buff.Bytes()
+}
+
+func TestNewExporter_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+
+	args := createArgsExporter(ts, 0)
+	args.Marshalizer = nil
+	exp, err := snapshot.NewExporter(args)
+	assert.True(t, check.IfNil(exp))
+	assert.Equal(t, snapshot.ErrNilMarshalizer, err)
+
+	args = createArgsExporter(ts, 0)
+	args.StorageService = nil
+	exp, err = snapshot.NewExporter(args)
+	assert.True(t, check.IfNil(exp))
+	assert.Equal(t, snapshot.ErrNilStorageService, err)
+
+	args = createArgsExporter(ts, 0)
+	args.UserAccountsTrie = nil
+	exp, err = snapshot.NewExporter(args)
+	assert.True(t, check.IfNil(exp))
+	assert.Equal(t, snapshot.ErrNilUserAccountsTrie, err)
+
+	args = createArgsExporter(ts, core.MetachainShardId)
+	args.PeerAccountsTrie = nil
+	exp, err = snapshot.NewExporter(args)
+	assert.True(t, check.IfNil(exp))
+	assert.Equal(t, snapshot.ErrNilPeerAccountsTrie, err)
+}
+
+func TestNewExporter_ShardWithoutPeerAccountsTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	args := createArgsExporter(ts, 0)
+	args.PeerAccountsTrie = nil
+
+	exp, err := snapshot.NewExporter(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(exp))
+}
+
+func TestExporter_ExportMissingEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	args := createArgsExporter(ts, 0)
+	args.Epoch = testEpoch + 1
+	exp, _ := snapshot.NewExporter(args)
+
+	err := exp.Export(&bytes.Buffer{})
+	assert.NotNil(t, err)
+}
+
+func TestExporter_ExportNotEpochStartBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	metaBytes, _ := marshalAndHash(t, &block.MetaBlock{Nonce: 20, Epoch: testEpoch})
+	_ = ts.storageService.GetStorer(dataRetriever.MetaBlockUnit).Put([]byte(core.EpochStartIdentifier(testEpoch)), metaBytes)
+	exp, _ := snapshot.NewExporter(createArgsExporter(ts, 0))
+
+	err := exp.Export(&bytes.Buffer{})
+	assert.Equal(t, snapshot.ErrNotEpochStartBlock, err)
+}
+
+func TestExporter_ExportMissingShardHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	_ = ts.storageService.GetStorer(dataRetriever.BlockHeaderUnit).Remove(ts.shardHeaderHash)
+	exp, _ := snapshot.NewExporter(createArgsExporter(ts, 0))
+
+	err := exp.Export(&bytes.Buffer{})
+	assert.True(t, errors.Is(err, snapshot.ErrMissingSnapshotHeader))
+}
+
+func TestExporter_ExportMissingNodesConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	key := append([]byte(core.NodesCoordinatorRegistryKeyPrefix), ts.epochStartMeta.PrevRandSeed...)
+	_ = ts.storageService.GetStorer(dataRetriever.BootstrapUnit).Remove(key)
+	exp, _ := snapshot.NewExporter(createArgsExporter(ts, 0))
+
+	err := exp.Export(&bytes.Buffer{})
+	assert.NotNil(t, err)
+}
+
+func TestExporter_ExportShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	exp, _ := snapshot.NewExporter(createArgsExporter(ts, 0))
+
+	buff := &bytes.Buffer{}
+	err := exp.Export(buff)
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(buff.Bytes(), []byte("ERDSNAPSHOT")))
+}
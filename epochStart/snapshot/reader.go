@@ -0,0 +1,415 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsReader holds the arguments needed to create a new snapshot reader
+type ArgsReader struct {
+	Reader      io.Reader
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	ChainID     []byte
+}
+
+type reader struct {
+	reader       io.Reader
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	chainID      []byte
+	snapshotData *Data
+}
+
+// NewReader creates a new snapshot reader. The snapshot data has to be read before importing the trie nodes,
+// as the trie nodes are verified against the root hashes of the epoch start data
+func NewReader(args ArgsReader) (*reader, error) {
+	if args.Reader == nil {
+		return nil, ErrNilReader
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.ChainID) == 0 {
+		return nil, ErrInvalidChainID
+	}
+
+	return &reader{
+		reader:      args.Reader,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+		chainID:     args.ChainID,
+	}, nil
+}
+
+// ReadData reads and verifies the epoch start data held by the snapshot
+func (r *reader) ReadData() (*Data, error) {
+	err := r.checkSnapshotPreamble()
+	if err != nil {
+		return nil, err
+	}
+
+	recordType, payload, err := readRecord(r.reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFormat, err)
+	}
+	if recordType != recordInfo {
+		return nil, fmt.Errorf("%w: type %d instead of the snapshot info", ErrUnexpectedSnapshotRecord, recordType)
+	}
+
+	info := &snapshotInfo{}
+	err = json.Unmarshal(payload, info)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFormat, err)
+	}
+
+	snapshotData, err := r.createData(info)
+	if err != nil {
+		return nil, err
+	}
+
+	r.snapshotData = snapshotData
+
+	return snapshotData, nil
+}
+
+func (r *reader) checkSnapshotPreamble() error {
+	preamble := make([]byte, len(snapshotMagic)+4)
+	_, err := io.ReadFull(r.reader, preamble)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSnapshotFormat, err)
+	}
+	if string(preamble[:len(snapshotMagic)]) != snapshotMagic {
+		return ErrInvalidSnapshotFormat
+	}
+
+	version := binary.BigEndian.Uint32(preamble[len(snapshotMagic):])
+	if version != currentSnapshotFormatVersion {
+		return fmt.Errorf("%w: version %d", ErrInvalidSnapshotFormat, version)
+	}
+
+	return nil
+}
+
+func (r *reader) createData(info *snapshotInfo) (*Data, error) {
+	epochStartMeta := &block.MetaBlock{}
+	err := r.marshalizer.Unmarshal(epochStartMeta, info.EpochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !epochStartMeta.IsStartOfEpochBlock() {
+		return nil, ErrNotEpochStartBlock
+	}
+	if epochStartMeta.Epoch != info.Epoch {
+		return nil, fmt.Errorf("%w: epoch start metablock is for epoch %d, snapshot is for epoch %d",
+			ErrWrongSnapshotHeader, epochStartMeta.Epoch, info.Epoch)
+	}
+	err = r.checkChainID(epochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotData := &Data{
+		ShardID:             info.ShardID,
+		Epoch:               info.Epoch,
+		EpochStartMetaBlock: epochStartMeta,
+		Headers:             make(map[string]data.HeaderHandler),
+	}
+
+	snapshotData.PreviousEpochStart, err = r.createPreviousEpochStart(epochStartMeta, info.PreviousEpochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+	snapshotData.Headers[string(epochStartMeta.EpochStart.Economics.PrevEpochStartHash)] = snapshotData.PreviousEpochStart
+
+	for _, snapshotHdr := range info.Headers {
+		hash, hdr, errCreate := r.createHeader(snapshotHdr.ShardID, snapshotHdr.Header)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		snapshotData.Headers[string(hash)] = hdr
+	}
+
+	err = checkRequiredHeaders(snapshotData)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.ShardID != core.MetachainShardId {
+		snapshotData.ShardHeader, err = r.createSelfShardHeader(epochStartMeta, info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshotData.NodesConfig, err = createNodesConfig(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshotData, nil
+}
+
+func (r *reader) createPreviousEpochStart(epochStartMeta *block.MetaBlock, buff []byte) (*block.MetaBlock, error) {
+	if len(buff) == 0 {
+		if epochStartMeta.Epoch > 1 {
+			return nil, fmt.Errorf("%w: previous epoch start metablock", ErrMissingSnapshotHeader)
+		}
+
+		return &block.MetaBlock{}, nil
+	}
+
+	prevEpochStartMeta := &block.MetaBlock{}
+	err := r.marshalizer.Unmarshal(prevEpochStartMeta, buff)
+	if err != nil {
+		return nil, err
+	}
+	err = r.checkChainID(prevEpochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := r.hasher.Compute(string(buff))
+	if !bytes.Equal(hash, epochStartMeta.EpochStart.Economics.PrevEpochStartHash) {
+		return nil, fmt.Errorf("%w: previous epoch start metablock hash mismatch", ErrWrongSnapshotHeader)
+	}
+
+	return prevEpochStartMeta, nil
+}
+
+func (r *reader) createHeader(shardID uint32, buff []byte) ([]byte, data.HeaderHandler, error) {
+	var hdr data.HeaderHandler
+	if shardID == core.MetachainShardId {
+		hdr = &block.MetaBlock{}
+	} else {
+		hdr = &block.Header{}
+	}
+
+	err := r.marshalizer.Unmarshal(hdr, buff)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = r.checkChainID(hdr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.hasher.Compute(string(buff)), hdr, nil
+}
+
+func (r *reader) checkChainID(hdr data.HeaderHandler) error {
+	if !bytes.Equal(hdr.GetChainID(), r.chainID) {
+		return fmt.Errorf("%w: header of shard %d, nonce %d has chain ID %s",
+			ErrWrongSnapshotChainID, hdr.GetShardID(), hdr.GetNonce(), hdr.GetChainID())
+	}
+
+	return nil
+}
+
+func (r *reader) createSelfShardHeader(epochStartMeta *block.MetaBlock, info *snapshotInfo) (*block.Header, error) {
+	shardData, err := getShardData(epochStartMeta, info.ShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, hdr, err := r.createHeader(info.ShardID, info.ShardHeader)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash, shardData.HeaderHash) {
+		return nil, fmt.Errorf("%w: shard %d header hash mismatch", ErrWrongSnapshotHeader, info.ShardID)
+	}
+
+	shardHeader, ok := hdr.(*block.Header)
+	if !ok || shardHeader.ShardID != info.ShardID {
+		return nil, fmt.Errorf("%w: invalid shard %d header", ErrWrongSnapshotHeader, info.ShardID)
+	}
+
+	return shardHeader, nil
+}
+
+func checkRequiredHeaders(snapshotData *Data) error {
+	requiredHashes := make([][]byte, 0)
+	if snapshotData.ShardID == core.MetachainShardId {
+		for _, shardData := range snapshotData.EpochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+			requiredHashes = append(requiredHashes, shardData.HeaderHash)
+		}
+	} else {
+		shardData, err := getShardData(snapshotData.EpochStartMetaBlock, snapshotData.ShardID)
+		if err != nil {
+			return err
+		}
+
+		requiredHashes = append(requiredHashes, shardData.FirstPendingMetaBlock, shardData.LastFinishedMetaBlock)
+	}
+
+	for _, hash := range requiredHashes {
+		_, ok := snapshotData.Headers[string(hash)]
+		if !ok {
+			return fmt.Errorf("%w: hash %x", ErrMissingSnapshotHeader, hash)
+		}
+	}
+
+	return nil
+}
+
+func createNodesConfig(info *snapshotInfo) (*sharding.NodesCoordinatorRegistry, error) {
+	nodesConfig := &sharding.NodesCoordinatorRegistry{}
+	err := json.Unmarshal(info.NodesConfig, nodesConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotNodesConfig, err)
+	}
+
+	_, ok := nodesConfig.EpochsConfig[fmt.Sprintf("%d", info.Epoch)]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing epoch %d", ErrInvalidSnapshotNodesConfig, info.Epoch)
+	}
+
+	return nodesConfig, nil
+}
+
+// ImportTrieNodes writes the snapshot trie nodes in the provided databases and verifies that the tries referenced by the
+// epoch start data are complete. The peer accounts database is only used for metachain snapshots
+func (r *reader) ImportTrieNodes(userAccountsDB data.DBWriteCacher, peerAccountsDB data.DBWriteCacher) error {
+	if r.snapshotData == nil {
+		return ErrSnapshotDataNotRead
+	}
+	if check.IfNil(userAccountsDB) {
+		return ErrNilTrieStorage
+	}
+	isMeta := r.snapshotData.ShardID == core.MetachainShardId
+	if isMeta && check.IfNil(peerAccountsDB) {
+		return ErrNilTrieStorage
+	}
+
+	numNodes := uint64(0)
+	for {
+		recordType, payload, err := readRecord(r.reader)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSnapshotFormat, err)
+		}
+
+		switch {
+		case recordType == recordEnd:
+			return r.checkImportedTries(payload, numNodes, userAccountsDB, peerAccountsDB)
+		case recordType == recordUserAccountsTrieNode:
+			err = r.importTrieNode(userAccountsDB, payload)
+		case recordType == recordPeerAccountsTrieNode && isMeta:
+			err = r.importTrieNode(peerAccountsDB, payload)
+		default:
+			err = fmt.Errorf("%w: type %d", ErrUnexpectedSnapshotRecord, recordType)
+		}
+		if err != nil {
+			return err
+		}
+
+		numNodes++
+	}
+}
+
+func (r *reader) importTrieNode(db data.DBWriteCacher, payload []byte) error {
+	hashSize := r.hasher.Size()
+	if len(payload) <= hashSize {
+		return fmt.Errorf("%w: record of %d bytes", ErrInvalidSnapshotTrieNode, len(payload))
+	}
+
+	hash := payload[:hashSize]
+	value := payload[hashSize:]
+	if !bytes.Equal(r.hasher.Compute(string(value)), hash) {
+		return fmt.Errorf("%w: %x", ErrInvalidSnapshotTrieNode, hash)
+	}
+
+	return db.Put(hash, value)
+}
+
+func (r *reader) checkImportedTries(
+	endPayload []byte,
+	numNodes uint64,
+	userAccountsDB data.DBWriteCacher,
+	peerAccountsDB data.DBWriteCacher,
+) error {
+	if len(endPayload) != 8 {
+		return fmt.Errorf("%w: invalid end record", ErrInvalidSnapshotFormat)
+	}
+	expectedNumNodes := binary.BigEndian.Uint64(endPayload)
+	if expectedNumNodes != numNodes {
+		return fmt.Errorf("%w: expected %d, read %d", ErrWrongNumberOfTrieNodes, expectedNumNodes, numNodes)
+	}
+
+	err := r.checkUserAccountsTrie(userAccountsDB)
+	if err != nil {
+		return err
+	}
+
+	if r.snapshotData.ShardID != core.MetachainShardId {
+		return nil
+	}
+
+	return r.checkTrie(peerAccountsDB, r.snapshotData.PeerAccountsRootHash(), nil)
+}
+
+func (r *reader) checkUserAccountsTrie(db data.DBWriteCacher) error {
+	dataTriesRootHashes := make([][]byte, 0)
+	leafHandler := func(value []byte) {
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := r.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return
+		}
+		if len(account.RootHash) > 0 {
+			dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+		}
+	}
+
+	err := r.checkTrie(db, r.snapshotData.UserAccountsRootHash(), leafHandler)
+	if err != nil {
+		return err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		err = r.checkTrie(db, dataTrieRootHash, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) checkTrie(db data.DBWriteCacher, rootHash []byte, leafHandler func(value []byte)) error {
+	result, err := trie.Inspect(context.Background(), rootHash, db, r.marshalizer, r.hasher, leafHandler)
+	if err != nil {
+		return err
+	}
+
+	if !result.IsComplete() {
+		return fmt.Errorf("%w: root hash %x, %d missing node(s), %d corrupted node(s)",
+			ErrIncompleteSnapshotTrie, rootHash, len(result.MissingNodes), len(result.CorruptedNodes))
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *reader) IsInterfaceNil() bool {
+	return r == nil
+}
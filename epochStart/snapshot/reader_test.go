@@ -0,0 +1,232 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsReader(buff []byte) snapshot.ArgsReader {
+	return snapshot.ArgsReader{
+		Reader:      bytes.NewReader(buff),
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+		ChainID:     testChainID,
+	}
+}
+
+func TestNewReader_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsReader(nil)
+	args.Reader = nil
+	r, err := snapshot.NewReader(args)
+	assert.True(t, check.IfNil(r))
+	assert.Equal(t, snapshot.ErrNilReader, err)
+
+	args = createArgsReader(nil)
+	args.Marshalizer = nil
+	r, err = snapshot.NewReader(args)
+	assert.True(t, check.IfNil(r))
+	assert.Equal(t, snapshot.ErrNilMarshalizer, err)
+
+	args = createArgsReader(nil)
+	args.Hasher = nil
+	r, err = snapshot.NewReader(args)
+	assert.True(t, check.IfNil(r))
+	assert.Equal(t, snapshot.ErrNilHasher, err)
+
+	args = createArgsReader(nil)
+	args.ChainID = nil
+	r, err = snapshot.NewReader(args)
+	assert.True(t, check.IfNil(r))
+	assert.Equal(t, snapshot.ErrInvalidChainID, err)
+}
+
+func TestReader_ReadDataInvalidFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	r, _ := snapshot.NewReader(createArgsReader([]byte("not a snapshot file")))
+
+	snapshotData, err := r.ReadData()
+	assert.Nil(t, snapshotData)
+	assert.True(t, errors.Is(err, snapshot.ErrInvalidSnapshotFormat))
+}
+
+func TestReader_ReadDataWrongShardHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	tamperedHeaderBytes, _ := marshalAndHash(t, &block.Header{Nonce: 31, ShardID: 0, Epoch: testEpoch, RootHash: ts.userRootHash, ChainID: testChainID})
+	_ = ts.storageService.GetStorer(dataRetriever.BlockHeaderUnit).Put(ts.shardHeaderHash, tamperedHeaderBytes)
+	r, _ := snapshot.NewReader(createArgsReader(exportSnapshot(t, ts, 0)))
+
+	snapshotData, err := r.ReadData()
+	assert.Nil(t, snapshotData)
+	assert.True(t, errors.Is(err, snapshot.ErrWrongSnapshotHeader))
+}
+
+func TestReader_ReadDataWrongChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	args := createArgsReader(exportSnapshot(t, ts, 0))
+	args.ChainID = []byte("other chain ID")
+	r, _ := snapshot.NewReader(args)
+
+	snapshotData, err := r.ReadData()
+	assert.Nil(t, snapshotData)
+	assert.True(t, errors.Is(err, snapshot.ErrWrongSnapshotChainID))
+}
+
+func TestReader_ReadDataShardHeaderFromOtherChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	otherChainHeader := &block.Header{Nonce: 30, ShardID: 0, Epoch: testEpoch, RootHash: ts.userRootHash, ChainID: []byte("other chain ID")}
+	otherChainHeaderBytes, otherChainHeaderHash := marshalAndHash(t, otherChainHeader)
+	_ = ts.storageService.GetStorer(dataRetriever.BlockHeaderUnit).Put(otherChainHeaderHash, otherChainHeaderBytes)
+	ts.epochStartMeta.EpochStart.LastFinalizedHeaders[0].HeaderHash = otherChainHeaderHash
+	epochStartMetaBytes, _ := marshalAndHash(t, ts.epochStartMeta)
+	_ = ts.storageService.GetStorer(dataRetriever.MetaBlockUnit).Put([]byte(core.EpochStartIdentifier(testEpoch)), epochStartMetaBytes)
+	r, _ := snapshot.NewReader(createArgsReader(exportSnapshot(t, ts, 0)))
+
+	snapshotData, err := r.ReadData()
+	assert.Nil(t, snapshotData)
+	assert.True(t, errors.Is(err, snapshot.ErrWrongSnapshotChainID))
+}
+
+func TestReader_ImportTrieNodesBeforeReadingDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	r, _ := snapshot.NewReader(createArgsReader(exportSnapshot(t, ts, 0)))
+
+	err := r.ImportTrieNodes(memorydb.New(), memorydb.New())
+	assert.Equal(t, snapshot.ErrSnapshotDataNotRead, err)
+}
+
+func TestReader_ImportTrieNodesCorruptedNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	buff := exportSnapshot(t, ts, 0)
+	// the last byte before the end record belongs to the value of the last exported trie node
+	endRecordSize := 13
+	buff[len(buff)-endRecordSize-1]++
+	r, _ := snapshot.NewReader(createArgsReader(buff))
+
+	_, err := r.ReadData()
+	require.Nil(t, err)
+
+	err = r.ImportTrieNodes(memorydb.New(), nil)
+	assert.True(t, errors.Is(err, snapshot.ErrInvalidSnapshotTrieNode))
+}
+
+func TestReader_ImportTrieNodesTruncatedSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	buff := exportSnapshot(t, ts, 0)
+	r, _ := snapshot.NewReader(createArgsReader(buff[:len(buff)-20]))
+
+	_, err := r.ReadData()
+	require.Nil(t, err)
+
+	err = r.ImportTrieNodes(memorydb.New(), nil)
+	assert.True(t, errors.Is(err, snapshot.ErrInvalidSnapshotFormat))
+}
+
+func TestReader_ShardSnapshotShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, 0)
+	r, _ := snapshot.NewReader(createArgsReader(exportSnapshot(t, ts, 0)))
+
+	snapshotData, err := r.ReadData()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(0), snapshotData.ShardID)
+	assert.Equal(t, testEpoch, snapshotData.Epoch)
+	assert.Equal(t, ts.epochStartMeta.Nonce, snapshotData.EpochStartMetaBlock.Nonce)
+	assert.Equal(t, ts.userRootHash, snapshotData.UserAccountsRootHash())
+	assert.Nil(t, snapshotData.PeerAccountsRootHash())
+	assert.Equal(t, uint64(30), snapshotData.ShardHeader.Nonce)
+	assert.Equal(t, uint64(10), snapshotData.PreviousEpochStart.Nonce)
+	assert.Equal(t, 3, len(snapshotData.Headers))
+	assert.Equal(t, testEpoch, snapshotData.NodesConfig.CurrentEpoch)
+
+	userAccountsDB := memorydb.New()
+	err = r.ImportTrieNodes(userAccountsDB, nil)
+	require.Nil(t, err)
+
+	checkImportedAccounts(t, ts, userAccountsDB)
+}
+
+func TestReader_MetaSnapshotShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorage(t, core.MetachainShardId)
+	r, _ := snapshot.NewReader(createArgsReader(exportSnapshot(t, ts, core.MetachainShardId)))
+
+	snapshotData, err := r.ReadData()
+	require.Nil(t, err)
+	assert.Equal(t, core.MetachainShardId, snapshotData.ShardID)
+	assert.Nil(t, snapshotData.ShardHeader)
+	assert.Equal(t, ts.peerRootHash, snapshotData.PeerAccountsRootHash())
+	_, ok := snapshotData.Headers[string(ts.shardHeaderHash)]
+	assert.True(t, ok)
+
+	userAccountsDB := memorydb.New()
+	peerAccountsDB := memorydb.New()
+	err = r.ImportTrieNodes(userAccountsDB, peerAccountsDB)
+	require.Nil(t, err)
+
+	checkImportedAccounts(t, ts, userAccountsDB)
+
+	peerTrie := recreateTrie(t, peerAccountsDB, ts.peerRootHash)
+	value, err := peerTrie.Get([]byte("validator 3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("peer account 3"), value)
+}
+
+func checkImportedAccounts(t *testing.T, ts *testStorage, db data.DBWriteCacher) {
+	userTrie := recreateTrie(t, db, ts.userRootHash)
+	for i := 0; i < ts.numAccounts; i++ {
+		key := []byte(fmt.Sprintf("address %d", i))
+		expectedValue, _ := ts.userAccountsTrie.Get(key)
+
+		value, err := userTrie.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, value)
+	}
+
+	result, err := trie.Inspect(context.Background(), ts.userRootHash, db, testMarshalizer, testHasher, nil)
+	require.Nil(t, err)
+	assert.True(t, result.IsComplete())
+}
+
+func recreateTrie(t *testing.T, db data.DBWriteCacher, rootHash []byte) data.Trie {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	return recreatedTrie
+}
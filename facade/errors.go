@@ -17,6 +17,9 @@ var ErrInvalidValue = errors.New("invalid value")
 // ErrNoApiRoutesConfig signals that no configuration was found for API routes
 var ErrNoApiRoutesConfig = errors.New("no configuration found for API routes")
 
+// ErrNilEventsNotifier signals that a nil events notifier has been provided
+var ErrNilEventsNotifier = errors.New("nil events notifier")
+
 // ErrNilPeerState signals that a nil peer state has been provided
 var ErrNilPeerState = errors.New("nil peer state")
 
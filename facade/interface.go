@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -34,6 +35,9 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) ([]string, error)
 
+	// GetAllESDTNFTs returns the non fungible and semi fungible token instances held by a given account
+	GetAllESDTNFTs(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -48,10 +52,52 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionStatus will return the status of a transaction based on the hash
+	GetTransactionStatus(hash string) (string, error)
+
+	// GetTransactionsByAddress returns a page of the transactions that touched the given address, most recent first
+	GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error)
+
+	// GetKeyValuePairs returns a page of the key-value pairs stored in the data trie of the given address
+	GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error)
+
+	// GetAccountProof returns the Merkle proof of the given account in the accounts trie
+	GetAccountProof(address string, options api.AccountQueryOptions) (*api.TrieProof, error)
+
+	// GetAccountKeyProof returns the Merkle proofs of the given account and of a key in its data trie
+	GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
+
+	// GetStorageUsage returns the disk space used by the node's databases, per shard, per epoch and per storage unit
+	GetStorageUsage() (*api.StorageUsage, error)
+
+	// CompactStorageUnit starts the compaction of the storage unit with the provided name
+	CompactStorageUnit(unitName string) error
+
+	// GetGasPriceSuggestion returns the low, median and high gas prices suggested for the transactions sent now
+	GetGasPriceSuggestion() (*api.GasPriceSuggestion, error)
+
+	// GetTransactionsPool returns the number of transactions found in each cache of the transactions pool
+	GetTransactionsPool() (*api.TransactionsPool, error)
+
+	// GetTransactionsPoolForSender returns the pending transactions of a sender, along with their nonce gaps
+	GetTransactionsPoolForSender(sender string) (*api.TransactionsPoolForSender, error)
+
+	// GetPendingNonce returns the nonce to be used by the next transaction of a sender
+	GetPendingNonce(sender string) (uint64, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string) (state.UserAccountHandler, error)
 
+	// GetBalanceWithOptions returns the balance for a specific address, read from the state selected by the options
+	GetBalanceWithOptions(address string, options api.AccountQueryOptions) (*big.Int, error)
+
+	// GetValueForKeyWithOptions returns the value of a key from a given account, read from the state selected by the options
+	GetValueForKeyWithOptions(address string, key string, options api.AccountQueryOptions) (string, error)
+
+	// GetAccountWithOptions returns the account correlated with provided address, read from the state selected by the options
+	GetAccountWithOptions(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
+
 	// GetCode returns the code for the given account
 	GetCode(account state.UserAccountHandler) []byte
 
@@ -74,6 +120,9 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHash(hash string) (*api.Hyperblock, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -97,3 +146,10 @@ type HardforkTrigger interface {
 	IsSelfTrigger() bool
 	IsInterfaceNil() bool
 }
+
+// EventsNotifier defines the structure used to subscribe to the events of the committed blocks
+type EventsNotifier interface {
+	Subscribe(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error)
+	Unsubscribe(subscription *eventsNotifier.Subscription)
+	IsInterfaceNil() bool
+}
@@ -39,9 +39,27 @@ type NodeStub struct {
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByRoundCalled                          func(round uint64, withTxs bool) (*api.Block, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHashCalled                      func(hash string) (*api.Hyperblock, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetAllESDTNFTsCalled                           func(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error)
+	GetTransactionStatusCalled                     func(hash string) (string, error)
+	GetTransactionsByAddressCalled                 func(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error)
+	GetKeyValuePairsCalled                         func(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error)
+	GetAccountProofCalled                          func(address string, options api.AccountQueryOptions) (*api.TrieProof, error)
+	GetAccountKeyProofCalled                       func(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
+	GetBalanceWithOptionsCalled                    func(address string, options api.AccountQueryOptions) (*big.Int, error)
+	GetValueForKeyWithOptionsCalled                func(address string, key string, options api.AccountQueryOptions) (string, error)
+	GetAccountWithOptionsCalled                    func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetStorageUsageCalled                          func() (*api.StorageUsage, error)
+	CompactStorageUnitCalled                       func(unitName string) error
+	GetGasPriceSuggestionCalled                    func() (*api.GasPriceSuggestion, error)
+	GetTransactionsPoolCalled                      func() (*api.TransactionsPool, error)
+	GetTransactionsPoolForSenderCalled             func(sender string) (*api.TransactionsPoolForSender, error)
+	GetPendingNonceCalled                          func(sender string) (uint64, error)
 }
 
 // GetUsername -
@@ -77,6 +95,33 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetBlockByRound -
+func (ns *NodeStub) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
+	if ns.GetBlockByRoundCalled != nil {
+		return ns.GetBlockByRoundCalled(round, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (ns *NodeStub) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if ns.GetHyperblockByNonceCalled != nil {
+		return ns.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (ns *NodeStub) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if ns.GetHyperblockByHashCalled != nil {
+		return ns.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -114,6 +159,105 @@ func (ns *NodeStub) GetTransaction(hash string, withEvents bool) (*transaction.A
 	return ns.GetTransactionHandler(hash, withEvents)
 }
 
+// GetTransactionStatus -
+func (ns *NodeStub) GetTransactionStatus(hash string) (string, error) {
+	if ns.GetTransactionStatusCalled != nil {
+		return ns.GetTransactionStatusCalled(hash)
+	}
+
+	return "", nil
+}
+
+// GetTransactionsByAddress -
+func (ns *NodeStub) GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+	if ns.GetTransactionsByAddressCalled != nil {
+		return ns.GetTransactionsByAddressCalled(address, skip, maxSize)
+	}
+
+	return nil, 0, nil
+}
+
+// GetKeyValuePairs -
+func (ns *NodeStub) GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error) {
+	if ns.GetKeyValuePairsCalled != nil {
+		return ns.GetKeyValuePairsCalled(address, skip, maxSize)
+	}
+
+	return &api.AccountKeyValuePairsPage{}, nil
+}
+
+// GetAccountProof -
+func (ns *NodeStub) GetAccountProof(address string, options api.AccountQueryOptions) (*api.TrieProof, error) {
+	if ns.GetAccountProofCalled != nil {
+		return ns.GetAccountProofCalled(address, options)
+	}
+
+	return &api.TrieProof{}, nil
+}
+
+// GetAccountKeyProof -
+func (ns *NodeStub) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	if ns.GetAccountKeyProofCalled != nil {
+		return ns.GetAccountKeyProofCalled(address, key, options)
+	}
+
+	return &api.AccountKeyProof{}, nil
+}
+
+// GetStorageUsage -
+func (ns *NodeStub) GetStorageUsage() (*api.StorageUsage, error) {
+	if ns.GetStorageUsageCalled != nil {
+		return ns.GetStorageUsageCalled()
+	}
+
+	return &api.StorageUsage{}, nil
+}
+
+// CompactStorageUnit -
+func (ns *NodeStub) CompactStorageUnit(unitName string) error {
+	if ns.CompactStorageUnitCalled != nil {
+		return ns.CompactStorageUnitCalled(unitName)
+	}
+
+	return nil
+}
+
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool() (*api.TransactionsPool, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled()
+	}
+
+	return &api.TransactionsPool{}, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string) (*api.TransactionsPoolForSender, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return &api.TransactionsPoolForSender{}, nil
+}
+
+// GetPendingNonce -
+func (ns *NodeStub) GetPendingNonce(sender string) (uint64, error) {
+	if ns.GetPendingNonceCalled != nil {
+		return ns.GetPendingNonceCalled(sender)
+	}
+
+	return 0, nil
+}
+
+// GetGasPriceSuggestion -
+func (ns *NodeStub) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	if ns.GetGasPriceSuggestionCalled != nil {
+		return ns.GetGasPriceSuggestionCalled()
+	}
+
+	return &api.GasPriceSuggestion{}, nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
@@ -124,6 +268,33 @@ func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error)
 	return ns.GetAccountHandler(address)
 }
 
+// GetBalanceWithOptions -
+func (ns *NodeStub) GetBalanceWithOptions(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	if ns.GetBalanceWithOptionsCalled != nil {
+		return ns.GetBalanceWithOptionsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetValueForKeyWithOptions -
+func (ns *NodeStub) GetValueForKeyWithOptions(address string, key string, options api.AccountQueryOptions) (string, error) {
+	if ns.GetValueForKeyWithOptionsCalled != nil {
+		return ns.GetValueForKeyWithOptionsCalled(address, key, options)
+	}
+
+	return "", nil
+}
+
+// GetAccountWithOptions -
+func (ns *NodeStub) GetAccountWithOptions(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	if ns.GetAccountWithOptionsCalled != nil {
+		return ns.GetAccountWithOptionsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // GetCode -
 func (ns *NodeStub) GetCode(account state.UserAccountHandler) []byte {
 	if ns.GetCodeCalled != nil {
@@ -189,6 +360,15 @@ func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetAllESDTNFTs -
+func (ns *NodeStub) GetAllESDTNFTs(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error) {
+	if ns.GetAllESDTNFTsCalled != nil {
+		return ns.GetAllESDTNFTsCalled(address, tokenIdentifier)
+	}
+
+	return make([]*api.ESDTNFTData, 0), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
+	"sync"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/batch"
+	"github.com/ElrondNetwork/elrond-go/api/grpcApi"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
@@ -19,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -41,6 +46,8 @@ const DefaultRestInterface = "localhost:8080"
 const DefaultRestPortOff = "off"
 
 var _ = address.FacadeHandler(&nodeFacade{})
+var _ = batch.FacadeHandler(&nodeFacade{})
+var _ = grpcApi.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
@@ -65,6 +72,7 @@ type ArgNodeFacade struct {
 	ApiRoutesConfig        config.ApiRoutesConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
+	EventsNotifier         EventsNotifier
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -81,6 +89,9 @@ type nodeFacade struct {
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
+	eventsNotifier         EventsNotifier
+	mutGrpcServer          sync.RWMutex
+	grpcServer             io.Closer
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -114,6 +125,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.PeerState) {
 		return nil, ErrNilPeerState
 	}
+	if check.IfNil(arg.EventsNotifier) {
+		return nil, ErrNilEventsNotifier
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -128,6 +142,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		endpointsThrottlers:    throttlersMap,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
+		eventsNotifier:         arg.EventsNotifier,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -175,6 +190,7 @@ func (nf *nodeFacade) StartNode() error {
 // StartBackgroundServices starts all background services needed for the correct functionality of the node
 func (nf *nodeFacade) StartBackgroundServices() {
 	go nf.startRest()
+	nf.startGRPC()
 }
 
 // RestAPIServerDebugMode return true is debug mode for Rest API is enabled
@@ -225,31 +241,81 @@ func (nf *nodeFacade) startRest() {
 	}
 }
 
+func (nf *nodeFacade) startGRPC() {
+	if !nf.apiRoutesConfig.GRPC.Enabled {
+		log.Debug("gRPC server is off")
+		return
+	}
+
+	grpcServer, err := grpcApi.NewServer(grpcApi.ArgsServer{
+		Facade:          nf,
+		ListenAddress:   nf.apiRoutesConfig.GRPC.Interface,
+		AntifloodConfig: nf.wsAntifloodConfig,
+	})
+	if err != nil {
+		log.Error("could not create gRPC server", "error", err.Error())
+		return
+	}
+
+	err = grpcServer.Start()
+	if err != nil {
+		log.Error("could not start gRPC server", "error", err.Error())
+		return
+	}
+
+	nf.mutGrpcServer.Lock()
+	defer nf.mutGrpcServer.Unlock()
+
+	// the facade might have been closed while the server was starting
+	if nf.ctx.Err() != nil {
+		log.LogIfError(grpcServer.Close())
+		return
+	}
+
+	nf.grpcServer = grpcServer
+}
+
 // CreateMiddlewareLimiters will create the middleware limiters used in web server
 func (nf *nodeFacade) CreateMiddlewareLimiters() ([]api.MiddlewareProcessor, error) {
 	sourceLimiter, err := middleware.NewSourceThrottler(nf.wsAntifloodConfig.SameSourceRequests)
 	if err != nil {
 		return nil, err
 	}
-	go nf.sourceLimiterReset(sourceLimiter)
+	go nf.limiterReset(sourceLimiter, nf.wsAntifloodConfig.SameSourceResetIntervalInSec, "WS source limiter")
 
 	globalLimiter, err := middleware.NewGlobalThrottler(nf.wsAntifloodConfig.SimultaneousRequests)
 	if err != nil {
 		return nil, err
 	}
 
-	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter}, nil
+	limiters := []api.MiddlewareProcessor{sourceLimiter, globalLimiter}
+
+	apiKeysConfig := nf.apiRoutesConfig.APIKeys
+	if !apiKeysConfig.Enabled {
+		return limiters, nil
+	}
+
+	apiKeyAuthenticator, err := middleware.NewApiKeyAuthenticator(apiKeysConfig)
+	if err != nil {
+		return nil, err
+	}
+	if apiKeysConfig.QuotaResetIntervalInSec > 0 {
+		go nf.limiterReset(apiKeyAuthenticator, apiKeysConfig.QuotaResetIntervalInSec, "WS API keys quotas")
+	}
+
+	// the authenticator should be the first middleware as the source limiter skips the authenticated requests
+	return append([]api.MiddlewareProcessor{apiKeyAuthenticator}, limiters...), nil
 }
 
-func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(nf.wsAntifloodConfig.SameSourceResetIntervalInSec)
+func (nf *nodeFacade) limiterReset(reset resetHandler, resetIntervalInSec uint32, name string) {
+	betweenResetDuration := time.Second * time.Duration(resetIntervalInSec)
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset", "limiter", name)
 			reset.Reset()
 		case <-nf.ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing nodeFacade.limiterReset go routine", "limiter", name)
 			return
 		}
 	}
@@ -280,6 +346,12 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
 	return nf.node.GetAllESDTTokens(address)
 }
 
+// GetAllESDTNFTs returns the non fungible and semi fungible token instances held by a given address, optionally
+// filtered by token identifier
+func (nf *nodeFacade) GetAllESDTNFTs(address string, tokenIdentifier string) ([]*apiData.ESDTNFTData, error) {
+	return nf.node.GetAllESDTNFTs(address, tokenIdentifier)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -330,6 +402,64 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactionStatus gets the current status of the transaction with a specified hash
+func (nf *nodeFacade) GetTransactionStatus(hash string) (string, error) {
+	return nf.node.GetTransactionStatus(hash)
+}
+
+// GetTransactionsByAddress returns a page of the transactions that touched the given address, most recent first,
+// along with the total number of transactions recorded for the address
+func (nf *nodeFacade) GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+	return nf.node.GetTransactionsByAddress(address, skip, maxSize)
+}
+
+// GetKeyValuePairs returns a page of the key-value pairs stored in the data trie of the given address, in trie order
+func (nf *nodeFacade) GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*apiData.AccountKeyValuePairsPage, error) {
+	return nf.node.GetKeyValuePairs(address, skip, maxSize)
+}
+
+// GetAccountProof returns the Merkle proof of the given account in the accounts trie
+func (nf *nodeFacade) GetAccountProof(address string, options apiData.AccountQueryOptions) (*apiData.TrieProof, error) {
+	return nf.node.GetAccountProof(address, options)
+}
+
+// GetAccountKeyProof returns the Merkle proofs of the given account in the accounts trie and of the given key in
+// the data trie of the account
+func (nf *nodeFacade) GetAccountKeyProof(address string, key string, options apiData.AccountQueryOptions) (*apiData.AccountKeyProof, error) {
+	return nf.node.GetAccountKeyProof(address, key, options)
+}
+
+// GetStorageUsage returns the disk space used by the node's databases, per shard, per epoch and per storage unit
+func (nf *nodeFacade) GetStorageUsage() (*apiData.StorageUsage, error) {
+	return nf.node.GetStorageUsage()
+}
+
+// CompactStorageUnit starts, in background, the compaction of the storage unit with the provided name
+func (nf *nodeFacade) CompactStorageUnit(unitName string) error {
+	return nf.node.CompactStorageUnit(unitName)
+}
+
+// GetTransactionsPool returns the number of transactions (and their size) found in each cache of the transactions pool
+func (nf *nodeFacade) GetTransactionsPool() (*apiData.TransactionsPool, error) {
+	return nf.node.GetTransactionsPool()
+}
+
+// GetTransactionsPoolForSender returns the pending transactions of the provided sender, along with their nonce gaps
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*apiData.TransactionsPoolForSender, error) {
+	return nf.node.GetTransactionsPoolForSender(sender)
+}
+
+// GetPendingNonce returns the nonce to be used by the next transaction of the provided sender, taking into account
+// the transactions already waiting in the pool
+func (nf *nodeFacade) GetPendingNonce(sender string) (uint64, error) {
+	return nf.node.GetPendingNonce(sender)
+}
+
+// GetGasPriceSuggestion returns the low, median and high gas prices suggested for the transactions sent now
+func (nf *nodeFacade) GetGasPriceSuggestion() (*apiData.GasPriceSuggestion, error) {
+	return nf.node.GetGasPriceSuggestion()
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -341,6 +471,22 @@ func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, erro
 	return nf.node.GetAccount(address)
 }
 
+// GetBalanceWithOptions gets the balance for a specified address from the state selected by the provided options
+func (nf *nodeFacade) GetBalanceWithOptions(address string, options apiData.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalanceWithOptions(address, options)
+}
+
+// GetValueForKeyWithOptions gets the value for a key in a given address from the state selected by the provided options
+func (nf *nodeFacade) GetValueForKeyWithOptions(address string, key string, options apiData.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKeyWithOptions(address, key, options)
+}
+
+// GetAccountWithOptions returns the account correlated with provided address from the state selected by the
+// provided options
+func (nf *nodeFacade) GetAccountWithOptions(address string, options apiData.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccountWithOptions(address, options)
+}
+
 // GetCode returns the code for the given account
 func (nf *nodeFacade) GetCode(account state.UserAccountHandler) []byte {
 	return nf.node.GetCode(account)
@@ -429,11 +575,33 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlockByRound returns the block committed in the given round
+func (nf *nodeFacade) GetBlockByRound(round uint64, withTxs bool) (*apiData.Block, error) {
+	return nf.node.GetBlockByRound(round, withTxs)
+}
+
+// GetHyperblockByNonce returns the hyperblock of the metablock with the given nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64) (*apiData.Hyperblock, error) {
+	return nf.node.GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the hyperblock of the metablock with the given hash
+func (nf *nodeFacade) GetHyperblockByHash(hash string) (*apiData.Hyperblock, error) {
+	return nf.node.GetHyperblockByHash(hash)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
 	nf.cancelFunc()
 
+	nf.mutGrpcServer.RLock()
+	defer nf.mutGrpcServer.RUnlock()
+
+	if nf.grpcServer != nil {
+		return nf.grpcServer.Close()
+	}
+
 	return nil
 }
 
@@ -518,6 +686,16 @@ func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm
 	}
 }
 
+// SubscribeToEvents registers a new subscriber for the committed blocks events selected by the filter
+func (nf *nodeFacade) SubscribeToEvents(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error) {
+	return nf.eventsNotifier.Subscribe(filter)
+}
+
+// UnsubscribeFromEvents cancels the provided subscription
+func (nf *nodeFacade) UnsubscribeFromEvents(subscription *eventsNotifier.Subscription) {
+	nf.eventsNotifier.Unsubscribe(subscription)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
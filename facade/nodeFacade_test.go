@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -49,8 +50,9 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &mock.AccountsStub{},
-		PeerState:     &mock.AccountsStub{},
+		AccountsState:  &mock.AccountsStub{},
+		PeerState:      &mock.AccountsStub{},
+		EventsNotifier: &testscommon.EventsNotifierStub{},
 	}
 }
 
@@ -78,6 +80,17 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilEventsNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.EventsNotifier = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilEventsNotifier, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -654,3 +667,24 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.NotNil(t, thr)
 	assert.True(t, ok)
 }
+
+func TestNodeFacade_CloseConcurrentWithGRPCStartShouldNotRace(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiRoutesConfig.GRPC = config.GRPCConfig{
+		Enabled:   true,
+		Interface: "127.0.0.1:0",
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	done := make(chan struct{})
+	go func() {
+		nf.startGRPC()
+		close(done)
+	}()
+
+	err := nf.Close()
+	assert.Nil(t, err)
+	<-done
+}
@@ -271,6 +271,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		ValidatorAccountsDB: arg.ValidatorAccounts,
 		ChanceComputer:      &disabled.Rater{},
 		EpochNotifier:       epochNotifier,
+		ESDTNFTEnableEpoch:  generalConfig.ESDTNFTEnableEpoch,
 	}
 	virtualMachineFactory, err := metachain.NewVMContainerFactory(argsNewVMContainerFactory)
 	if err != nil {
@@ -310,10 +311,13 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:              arg.PubkeyConv,
+		ShardCoordinator:             arg.ShardCoordinator,
+		BuiltInFuncNames:             builtInFuncs.Keys(),
+		ArgumentParser:               parsers.NewCallArgsParser(),
+		EpochNotifier:                epochNotifier,
+		ESDTMultiTransferEnableEpoch: generalConfig.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:           generalConfig.ESDTNFTEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -350,6 +354,8 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      generalConfig.RepairCallbackEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   generalConfig.ESDTMultiTransferEnableEpoch,
+		ESDTNFTEnableEpoch:             generalConfig.ESDTNFTEnableEpoch,
 		IsGenesisProcessing:            true,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -423,12 +429,12 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(
-		vmContainer,
-		arg.Economics,
-		virtualMachineFactory.BlockChainHookImpl(),
-		arg.Blkc,
-	)
+	queryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:    vmContainer,
+		EconomicsFee:   arg.Economics,
+		BlockChainHook: virtualMachineFactory.BlockChainHookImpl(),
+		BlockChain:     arg.Blkc,
+	})
 	if err != nil {
 		return nil, err
 	}
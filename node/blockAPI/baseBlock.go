@@ -6,7 +6,10 @@ import (
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -21,28 +24,25 @@ type baseAPIBockProcessor struct {
 	marshalizer              marshal.Marshalizer
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	historyRepo              dblookupext.HistoryRepository
+	chainHandler             data.ChainHandler
 	unmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 }
 
 var log = logger.GetOrCreate("node/blockAPI")
 
-func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epoch uint32) []*transaction.ApiTransactionResult {
+func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epoch uint32) ([]*transaction.ApiTransactionResult, error) {
 	miniblockHash := mbHeader.Hash
 	mbBytes, err := bap.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, miniblockHash, epoch)
 	if err != nil {
-		log.Warn("cannot get miniblock from storage",
-			"hash", hex.EncodeToString(miniblockHash),
-			"error", err.Error())
-		return nil
+		return nil, fmt.Errorf("%w: hash %s, epoch %d, %s",
+			ErrCannotLoadMiniblock, hex.EncodeToString(miniblockHash), epoch, err.Error())
 	}
 
 	miniBlock := &block.MiniBlock{}
 	err = bap.marshalizer.Unmarshal(miniBlock, mbBytes)
 	if err != nil {
-		log.Warn("cannot unmarshal miniblock",
-			"hash", hex.EncodeToString(miniblockHash),
-			"error", err.Error())
-		return nil
+		return nil, fmt.Errorf("%w: hash %s, %s",
+			ErrCannotUnmarshalMiniblock, hex.EncodeToString(miniblockHash), err.Error())
 	}
 
 	switch miniBlock.Type {
@@ -55,7 +55,7 @@ func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epo
 	case block.InvalidBlock:
 		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeInvalid, dataRetriever.TransactionUnit)
 	default:
-		return nil
+		return nil, nil
 	}
 }
 
@@ -65,14 +65,17 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	epoch uint32,
 	txType transaction.TxType,
 	unit dataRetriever.UnitType,
-) []*transaction.ApiTransactionResult {
+) ([]*transaction.ApiTransactionResult, error) {
 	storer := bap.store.GetStorer(unit)
 	start := time.Now()
 	marshalizedTxs, err := storer.GetBulkFromEpoch(miniblock.TxHashes, epoch)
 	if err != nil {
-		log.Warn("cannot get from storage transactions",
-			"error", err.Error())
-		return []*transaction.ApiTransactionResult{}
+		return nil, fmt.Errorf("%w: miniblock %s, %s",
+			ErrCannotLoadTransactions, hex.EncodeToString(miniblockHash), err.Error())
+	}
+	if len(marshalizedTxs) != len(miniblock.TxHashes) {
+		return nil, fmt.Errorf("%w: miniblock %s, found %d out of %d transactions",
+			ErrCannotLoadTransactions, hex.EncodeToString(miniblockHash), len(marshalizedTxs), len(miniblock.TxHashes))
 	}
 	log.Debug(fmt.Sprintf("GetBulkFromEpoch took %s", time.Since(start)))
 
@@ -81,10 +84,8 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	for txHash, txBytes := range marshalizedTxs {
 		tx, errUnmarshalTx := bap.unmarshalTx(txBytes, txType)
 		if errUnmarshalTx != nil {
-			log.Warn("cannot unmarshal transaction",
-				"hash", hex.EncodeToString([]byte(txHash)),
-				"error", errUnmarshalTx.Error())
-			continue
+			return nil, fmt.Errorf("%w: hash %s, %s",
+				ErrCannotUnmarshalTransaction, hex.EncodeToString([]byte(txHash)), errUnmarshalTx.Error())
 		}
 		tx.Hash = hex.EncodeToString([]byte(txHash))
 		tx.MiniBlockType = miniblock.Type.String()
@@ -105,7 +106,7 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	}
 	log.Debug(fmt.Sprintf("UnmarshalTransactions took %s", time.Since(start)))
 
-	return txs
+	return txs, nil
 }
 
 func (bap *baseAPIBockProcessor) getFromStorer(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
@@ -126,3 +127,66 @@ func (bap *baseAPIBockProcessor) getFromStorerWithEpoch(unit dataRetriever.UnitT
 	storer := bap.store.GetStorer(unit)
 	return storer.GetFromEpoch(key, epoch)
 }
+
+// getHeaderByRound searches the header committed in the provided round. As the rounds of the committed headers
+// strictly increase with their nonces, a binary search is done on the nonces interval [0, min(round, current nonce)]
+func (bap *baseAPIBockProcessor) getHeaderByRound(
+	round uint64,
+	nonceHashUnit dataRetriever.UnitType,
+	headerUnit dataRetriever.UnitType,
+	createHeader func() data.HeaderHandler,
+) ([]byte, []byte, error) {
+	maxNonce := round
+	if !check.IfNil(bap.chainHandler) && !check.IfNil(bap.chainHandler.GetCurrentBlockHeader()) {
+		maxNonce = core.MinUint64(maxNonce, bap.chainHandler.GetCurrentBlockHeader().GetNonce())
+	}
+
+	low, high := int64(0), int64(maxNonce)
+	for low <= high {
+		middle := low + (high-low)/2
+		headerHash, headerBytes, header, err := bap.getHeaderByNonce(uint64(middle), nonceHashUnit, headerUnit, createHeader)
+		if err != nil {
+			// headers from old epochs might not be available anymore
+			log.Trace("getHeaderByRound: cannot get header", "nonce", middle, "error", err.Error())
+			low = middle + 1
+			continue
+		}
+
+		switch {
+		case header.GetRound() == round:
+			return headerHash, headerBytes, nil
+		case header.GetRound() < round:
+			low = middle + 1
+		default:
+			high = middle - 1
+		}
+	}
+
+	return nil, nil, ErrNoBlockForRound
+}
+
+func (bap *baseAPIBockProcessor) getHeaderByNonce(
+	nonce uint64,
+	nonceHashUnit dataRetriever.UnitType,
+	headerUnit dataRetriever.UnitType,
+	createHeader func() data.HeaderHandler,
+) ([]byte, []byte, data.HeaderHandler, error) {
+	nonceToByteSlice := bap.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := bap.store.Get(nonceHashUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	headerBytes, err := bap.getFromStorer(headerUnit, headerHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := createHeader()
+	err = bap.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return headerHash, headerBytes, header, nil
+}
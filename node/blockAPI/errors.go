@@ -0,0 +1,18 @@
+package blockAPI
+
+import "errors"
+
+// ErrNoBlockForRound signals that no committed block could be found for the provided round
+var ErrNoBlockForRound = errors.New("no committed block found for the provided round")
+
+// ErrCannotLoadMiniblock signals that a miniblock could not be loaded from storage
+var ErrCannotLoadMiniblock = errors.New("cannot load miniblock from storage")
+
+// ErrCannotUnmarshalMiniblock signals that a stored miniblock could not be decoded
+var ErrCannotUnmarshalMiniblock = errors.New("cannot unmarshal miniblock")
+
+// ErrCannotLoadTransactions signals that the transactions of a miniblock could not be loaded from storage
+var ErrCannotLoadTransactions = errors.New("cannot load miniblock transactions from storage")
+
+// ErrCannotUnmarshalTransaction signals that a stored transaction could not be decoded
+var ErrCannotUnmarshalTransaction = errors.New("cannot unmarshal transaction")
@@ -8,4 +8,11 @@ import (
 type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error)
+	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+}
+
+// APIHyperblockHandler defines the behavior of a component able to return api hyperblocks
+type APIHyperblockHandler interface {
+	GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHash(hash []byte) (*api.Hyperblock, error)
 }
@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
 
@@ -24,6 +26,7 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 			marshalizer:              arg.Marshalizer,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
+			chainHandler:             arg.ChainHandler,
 			unmarshalTx:              arg.UnmarshalTx,
 		},
 	}
@@ -31,15 +34,31 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 
 // GetBlockByNonce wil return a meta APIBlock by nonce
 func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
-	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
+	headerHash, blockBytes, err := mbp.getMetaBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
 
-	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
-	headerHash, err := mbp.store.Get(storerUnit, nonceToByteSlice)
+	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+}
+
+// GetBlockByHash will return a shard APIBlock by hash
+func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error) {
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
 	if err != nil {
 		return nil, err
 	}
 
-	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+	return mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, withTxs)
+}
+
+// GetBlockByRound will return a meta APIBlock by round
+func (mbp *metaAPIBlockProcessor) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
+	createHeader := func() data.HeaderHandler {
+		return &block.MetaBlock{}
+	}
+
+	headerHash, blockBytes, err := mbp.getHeaderByRound(round, dataRetriever.MetaHdrNonceHashDataUnit, dataRetriever.MetaBlockUnit, createHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -47,14 +66,150 @@ func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*
 	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
 }
 
-// GetBlockByHash will return a shard APIBlock by hash
-func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error) {
+// GetHyperblockByNonce will return the hyperblock of the metablock with the provided nonce
+func (mbp *metaAPIBlockProcessor) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	headerHash, blockBytes, err := mbp.getMetaBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.convertMetaBlockBytesToAPIHyperblock(headerHash, blockBytes)
+}
+
+// GetHyperblockByHash will return the hyperblock of the metablock with the provided hash
+func (mbp *metaAPIBlockProcessor) GetHyperblockByHash(hash []byte) (*api.Hyperblock, error) {
 	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
 	if err != nil {
 		return nil, err
 	}
 
-	return mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, withTxs)
+	return mbp.convertMetaBlockBytesToAPIHyperblock(hash, blockBytes)
+}
+
+func (mbp *metaAPIBlockProcessor) getMetaBlockBytesByNonce(nonce uint64) ([]byte, []byte, error) {
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, blockBytes, nil
+}
+
+// convertMetaBlockBytesToAPIHyperblock assembles the transactions executed in the metablock and in the notarized
+// shard blocks. Only the miniblocks executed at destination are considered, so that every transaction is
+// returned once, in the hyperblock that notarizes its final execution. Miniblocks that are not available in
+// storage are reported in the result, while the ones that exist but cannot be read cause an error
+func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIHyperblock(hash []byte, blockBytes []byte) (*api.Hyperblock, error) {
+	metaBlock := &block.MetaBlock{}
+	err := mbp.marshalizer.Unmarshal(metaBlock, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock := &api.Hyperblock{
+		Nonce:         metaBlock.Nonce,
+		Round:         metaBlock.Round,
+		Hash:          hex.EncodeToString(hash),
+		PrevBlockHash: hex.EncodeToString(metaBlock.PrevHash),
+		Epoch:         metaBlock.Epoch,
+		ShardBlocks:   make([]*api.NotarizedBlock, 0, len(metaBlock.ShardInfo)),
+		Transactions:  make([]*transaction.ApiTransactionResult, 0),
+	}
+
+	err = mbp.addTxsFromMiniblocksExecutedAtDestination(hyperblock, metaBlock.MiniBlockHeaders, core.MetachainShardId, metaBlock.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shardData := range metaBlock.ShardInfo {
+		hyperblock.ShardBlocks = append(hyperblock.ShardBlocks, &api.NotarizedBlock{
+			Hash:  hex.EncodeToString(shardData.HeaderHash),
+			Nonce: shardData.Nonce,
+			Shard: shardData.ShardID,
+		})
+
+		shardHeaderEpoch := mbp.getShardHeaderEpoch(shardData.HeaderHash, metaBlock.Epoch)
+		err = mbp.addTxsFromMiniblocksExecutedAtDestination(hyperblock, shardData.ShardMiniBlockHeaders, shardData.ShardID, shardHeaderEpoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hyperblock.NumTxs = uint32(len(hyperblock.Transactions))
+	hyperblock.Partial = len(hyperblock.MissingMiniBlocks) > 0
+
+	return hyperblock, nil
+}
+
+func (mbp *metaAPIBlockProcessor) addTxsFromMiniblocksExecutedAtDestination(
+	hyperblock *api.Hyperblock,
+	miniblockHeaders []block.MiniBlockHeader,
+	shardID uint32,
+	headerEpoch uint32,
+) error {
+	miniblocksStorer := mbp.store.GetStorer(dataRetriever.MiniBlockUnit)
+	for _, mb := range miniblockHeaders {
+		if mb.Type == block.PeerBlock || mb.ReceiverShardID != shardID {
+			continue
+		}
+
+		epoch := mbp.getMiniblockEpoch(mb.Hash, headerEpoch)
+		err := miniblocksStorer.HasInEpoch(mb.Hash, epoch)
+		if err != nil {
+			log.Debug("hyperblock: miniblock not available in storage",
+				"hash", mb.Hash, "shard", shardID, "epoch", epoch)
+			hyperblock.MissingMiniBlocks = append(hyperblock.MissingMiniBlocks, hex.EncodeToString(mb.Hash))
+			continue
+		}
+
+		miniBlockCopy := mb
+		txs, err := mbp.getTxsByMb(&miniBlockCopy, epoch)
+		if err != nil {
+			return err
+		}
+
+		hyperblock.Transactions = append(hyperblock.Transactions, txs...)
+	}
+
+	return nil
+}
+
+// getMiniblockEpoch returns the epoch in which the miniblock was stored. It relies on the db lookup extensions
+// when available and falls back on the epoch of the header that contains the miniblock otherwise
+func (mbp *metaAPIBlockProcessor) getMiniblockEpoch(miniblockHash []byte, headerEpoch uint32) uint32 {
+	if !mbp.hasDbLookupExtensions {
+		return headerEpoch
+	}
+
+	epoch, err := mbp.historyRepo.GetEpochByHash(miniblockHash)
+	if err != nil {
+		return headerEpoch
+	}
+
+	return epoch
+}
+
+// getShardHeaderEpoch returns the epoch of the notarized shard header, which can differ from the epoch of the
+// notarizing metablock around the epoch change. The metablock epoch is used if the shard header is not available
+func (mbp *metaAPIBlockProcessor) getShardHeaderEpoch(shardHeaderHash []byte, metaBlockEpoch uint32) uint32 {
+	headerBytes, err := mbp.getFromStorer(dataRetriever.BlockHeaderUnit, shardHeaderHash)
+	if err != nil {
+		return metaBlockEpoch
+	}
+
+	shardHeader := &block.Header{}
+	err = mbp.marshalizer.Unmarshal(shardHeader, headerBytes)
+	if err != nil {
+		return metaBlockEpoch
+	}
+
+	return shardHeader.Epoch
 }
 
 func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
@@ -83,7 +238,10 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		}
 		if withTxs {
 			miniBlockCopy := mb
-			miniblockAPI.Transactions = mbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			miniblockAPI.Transactions, err = mbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		miniblocks = append(miniblocks, miniblockAPI)
@@ -0,0 +1,283 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockAPIBlockProcessorArg(store *genericmocks.ChainStorerMock, marshalizer marshal.Marshalizer) *APIBlockProcessorArg {
+	return &APIBlockProcessorArg{
+		SelfShardID:              core.MetachainShardId,
+		Store:                    store,
+		Marshalizer:              marshalizer,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		HistoryRepo: &testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		},
+		ChainHandler: &mock.ChainHandlerStub{},
+		UnmarshalTx: func(txBytes []byte, _ transaction.TxType) (*transaction.ApiTransactionResult, error) {
+			tx := &transaction.Transaction{}
+			err := marshalizer.Unmarshal(tx, txBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			return &transaction.ApiTransactionResult{Tx: tx, Data: tx.Data}, nil
+		},
+	}
+}
+
+func putMiniblockWithTxs(
+	t *testing.T,
+	store *genericmocks.ChainStorerMock,
+	marshalizer marshal.Marshalizer,
+	mbHash []byte,
+	epoch uint32,
+	senderShard uint32,
+	receiverShard uint32,
+	txHashes ...string,
+) block.MiniBlockHeader {
+	miniblock := &block.MiniBlock{
+		SenderShardID:   senderShard,
+		ReceiverShardID: receiverShard,
+		Type:            block.TxBlock,
+	}
+	for _, txHash := range txHashes {
+		miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+
+		txBytes, err := marshalizer.Marshal(&transaction.Transaction{Data: []byte(txHash)})
+		require.Nil(t, err)
+		_ = store.Transactions.PutInEpoch([]byte(txHash), txBytes, epoch)
+	}
+
+	mbBytes, err := marshalizer.Marshal(miniblock)
+	require.Nil(t, err)
+	_ = store.MiniBlocks.PutInEpoch(mbHash, mbBytes, epoch)
+
+	return block.MiniBlockHeader{
+		Hash:            mbHash,
+		SenderShardID:   senderShard,
+		ReceiverShardID: receiverShard,
+		TxCount:         uint32(len(txHashes)),
+		Type:            block.TxBlock,
+	}
+}
+
+func putShardHeader(t *testing.T, store *genericmocks.ChainStorerMock, marshalizer marshal.Marshalizer, hash []byte, epoch uint32) {
+	headerBytes, err := marshalizer.Marshal(&block.Header{Epoch: epoch})
+	require.Nil(t, err)
+	_ = store.BlockHeaders.Put(hash, headerBytes)
+}
+
+func putMetaBlock(t *testing.T, store *genericmocks.ChainStorerMock, marshalizer marshal.Marshalizer, hash []byte, metaBlock *block.MetaBlock) {
+	metaBlockBytes, err := marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+	_ = store.MetaBlocks.Put(hash, metaBlockBytes)
+}
+
+func getTxsData(txs []*transaction.ApiTransactionResult) []string {
+	txsData := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txsData = append(txsData, string(tx.Data))
+	}
+
+	return txsData
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metaEpoch := uint32(3)
+	shardEpoch := uint32(2)
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(metaEpoch)
+
+	metaMb := putMiniblockWithTxs(t, store, marshalizer, []byte("meta mb"), metaEpoch, 0, core.MetachainShardId, "tx meta")
+	shardMb := putMiniblockWithTxs(t, store, marshalizer, []byte("shard mb"), shardEpoch, 1, 0, "tx1", "tx2")
+	crossShardMb := putMiniblockWithTxs(t, store, marshalizer, []byte("cross mb"), shardEpoch, 0, 1, "tx3")
+	putShardHeader(t, store, marshalizer, []byte("shard hdr"), shardEpoch)
+
+	metaBlock := &block.MetaBlock{
+		Nonce:            7,
+		Epoch:            metaEpoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{metaMb},
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash:            []byte("shard hdr"),
+				ShardID:               0,
+				Nonce:                 5,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{shardMb, crossShardMb},
+			},
+		},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	mbp := NewMetaApiBlockProcessor(createMockAPIBlockProcessorArg(store, marshalizer))
+	hyperblock, err := mbp.GetHyperblockByHash([]byte("meta hash"))
+	require.Nil(t, err)
+
+	assert.False(t, hyperblock.Partial)
+	assert.Empty(t, hyperblock.MissingMiniBlocks)
+	assert.Equal(t, uint32(3), hyperblock.NumTxs)
+	assert.ElementsMatch(t, []string{"tx meta", "tx1", "tx2"}, getTxsData(hyperblock.Transactions))
+	require.Equal(t, 1, len(hyperblock.ShardBlocks))
+	assert.Equal(t, hex.EncodeToString([]byte("shard hdr")), hyperblock.ShardBlocks[0].Hash)
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashShouldUseTheMiniblockEpochFromHistory(t *testing.T) {
+	t.Parallel()
+
+	metaEpoch := uint32(3)
+	miniblockEpoch := uint32(1)
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(metaEpoch)
+
+	shardMb := putMiniblockWithTxs(t, store, marshalizer, []byte("shard mb"), miniblockEpoch, 0, 0, "tx1")
+	metaBlock := &block.MetaBlock{
+		Epoch: metaEpoch,
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash:            []byte("shard hdr"),
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{shardMb},
+			},
+		},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	arg := createMockAPIBlockProcessorArg(store, marshalizer)
+	arg.HistoryRepo = &testscommon.HistoryRepositoryStub{
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			if string(hash) == "shard mb" {
+				return miniblockEpoch, nil
+			}
+			if string(hash) == "meta hash" {
+				return metaEpoch, nil
+			}
+			return 0, errors.New("not found")
+		},
+	}
+	mbp := NewMetaApiBlockProcessor(arg)
+	hyperblock, err := mbp.GetHyperblockByHash([]byte("meta hash"))
+	require.Nil(t, err)
+
+	assert.False(t, hyperblock.Partial)
+	assert.Equal(t, []string{"tx1"}, getTxsData(hyperblock.Transactions))
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashMissingMiniblockShouldFlagPartial(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	storedMb := putMiniblockWithTxs(t, store, marshalizer, []byte("stored mb"), 0, 0, 0, "tx1")
+	missingMb := block.MiniBlockHeader{
+		Hash:            []byte("missing mb"),
+		SenderShardID:   1,
+		ReceiverShardID: 0,
+		Type:            block.TxBlock,
+	}
+	metaBlock := &block.MetaBlock{
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash:            []byte("shard hdr"),
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{storedMb, missingMb},
+			},
+		},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	mbp := NewMetaApiBlockProcessor(createMockAPIBlockProcessorArg(store, marshalizer))
+	hyperblock, err := mbp.GetHyperblockByHash([]byte("meta hash"))
+	require.Nil(t, err)
+
+	assert.True(t, hyperblock.Partial)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("missing mb"))}, hyperblock.MissingMiniBlocks)
+	assert.Equal(t, []string{"tx1"}, getTxsData(hyperblock.Transactions))
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashCorruptedMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	_ = store.MiniBlocks.Put([]byte("corrupted mb"), []byte("not a miniblock"))
+	metaBlock := &block.MetaBlock{
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{
+				Hash:            []byte("corrupted mb"),
+				ReceiverShardID: core.MetachainShardId,
+				Type:            block.TxBlock,
+			},
+		},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	mbp := NewMetaApiBlockProcessor(createMockAPIBlockProcessorArg(store, marshalizer))
+	hyperblock, err := mbp.GetHyperblockByHash([]byte("meta hash"))
+
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, ErrCannotUnmarshalMiniblock))
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashMissingTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	mb := putMiniblockWithTxs(t, store, marshalizer, []byte("meta mb"), 0, 0, core.MetachainShardId, "tx1", "tx2")
+	store.Transactions.GetCurrentEpochData().Remove("tx2")
+	metaBlock := &block.MetaBlock{
+		MiniBlockHeaders: []block.MiniBlockHeader{mb},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	mbp := NewMetaApiBlockProcessor(createMockAPIBlockProcessorArg(store, marshalizer))
+	hyperblock, err := mbp.GetHyperblockByHash([]byte("meta hash"))
+
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, ErrCannotLoadTransactions))
+}
+
+func TestMetaAPIBlockProcessor_GetBlockByHashWithTxsMissingMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	metaBlock := &block.MetaBlock{
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{
+				Hash:            []byte("missing mb"),
+				ReceiverShardID: core.MetachainShardId,
+				Type:            block.TxBlock,
+			},
+		},
+	}
+	putMetaBlock(t, store, marshalizer, []byte("meta hash"), metaBlock)
+
+	mbp := NewMetaApiBlockProcessor(createMockAPIBlockProcessorArg(store, marshalizer))
+	apiBlock, err := mbp.GetBlockByHash([]byte("meta hash"), true)
+	assert.Nil(t, apiBlock)
+	assert.True(t, errors.Is(err, ErrCannotLoadMiniblock))
+
+	apiBlock, err = mbp.GetBlockByHash([]byte("meta hash"), false)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(apiBlock.MiniBlocks))
+}
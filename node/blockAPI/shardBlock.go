@@ -3,6 +3,7 @@ package blockAPI
 import (
 	"encoding/hex"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -23,6 +24,7 @@ func NewShardApiBlockProcessor(arg *APIBlockProcessorArg) *shardAPIBlockProcesso
 			marshalizer:              arg.Marshalizer,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
+			chainHandler:             arg.ChainHandler,
 			unmarshalTx:              arg.UnmarshalTx,
 		},
 	}
@@ -56,6 +58,21 @@ func (sbp *shardAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*a
 	return sbp.convertShardBlockBytesToAPIBlock(hash, blockBytes, withTxs)
 }
 
+// GetBlockByRound will return a shard APIBlock by round
+func (sbp *shardAPIBlockProcessor) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
+	nonceHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(sbp.selfShardID)
+	createHeader := func() data.HeaderHandler {
+		return &block.Header{}
+	}
+
+	headerHash, blockBytes, err := sbp.getHeaderByRound(round, nonceHashUnit, dataRetriever.BlockHeaderUnit, createHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+}
+
 func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader := &block.Header{}
 	err := sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -82,7 +99,10 @@ func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte,
 		}
 		if withTxs {
 			miniBlockCopy := mb
-			miniblockAPI.Transactions = sbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			miniblockAPI.Transactions, err = sbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		miniblocks = append(miniblocks, miniblockAPI)
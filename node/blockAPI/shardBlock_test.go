@@ -0,0 +1,59 @@
+package blockAPI
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardAPIBlockProcessor_GetBlockByHashWithTxsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	mb := putMiniblockWithTxs(t, store, marshalizer, []byte("mb"), 0, 0, 1, "tx1", "tx2")
+	headerBytes, _ := marshalizer.Marshal(&block.Header{Nonce: 3, MiniBlockHeaders: []block.MiniBlockHeader{mb}})
+	_ = store.BlockHeaders.Put([]byte("hdr"), headerBytes)
+
+	arg := createMockAPIBlockProcessorArg(store, marshalizer)
+	arg.SelfShardID = 0
+	sbp := NewShardApiBlockProcessor(arg)
+	apiBlock, err := sbp.GetBlockByHash([]byte("hdr"), true)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(2), apiBlock.NumTxs)
+	require.Equal(t, 1, len(apiBlock.MiniBlocks))
+	assert.ElementsMatch(t, []string{"tx1", "tx2"}, getTxsData(apiBlock.MiniBlocks[0].Transactions))
+}
+
+func TestShardAPIBlockProcessor_GetBlockByHashWithTxsMissingMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	store := genericmocks.NewChainStorerMock(0)
+
+	header := &block.Header{
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{
+				Hash: []byte("missing mb"),
+				Type: block.TxBlock,
+			},
+		},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+	_ = store.BlockHeaders.Put([]byte("hdr"), headerBytes)
+
+	arg := createMockAPIBlockProcessorArg(store, marshalizer)
+	arg.SelfShardID = 0
+	sbp := NewShardApiBlockProcessor(arg)
+	apiBlock, err := sbp.GetBlockByHash([]byte("hdr"), true)
+
+	assert.Nil(t, apiBlock)
+	assert.True(t, errors.Is(err, ErrCannotLoadMiniblock))
+}
@@ -231,3 +231,57 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrDbLookupExtensionsNotEnabled signals that the db lookup extensions are not enabled
+var ErrDbLookupExtensionsNotEnabled = errors.New("db lookup extensions not enabled")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
+var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrHistoricalStateNotSupported signals that the node is not able to read the accounts from a past state
+var ErrHistoricalStateNotSupported = errors.New("reading the accounts from a past state is not supported")
+
+// ErrInvalidAccountQueryOptions signals that both the block nonce and the block hash were provided
+var ErrInvalidAccountQueryOptions = errors.New("only one of block nonce or block hash can be provided")
+
+// ErrStateNotAvailable signals that the state of the requested block is no longer available, most probably pruned
+var ErrStateNotAvailable = errors.New("state is not available, it was probably pruned")
+
+// ErrNilAccountsTrie signals that a nil accounts trie has been provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrTrieProofsNotSupported signals that the node is not able to compute Merkle proofs for the accounts
+var ErrTrieProofsNotSupported = errors.New("merkle proofs are not supported")
+
+// ErrNilCurrentBlockHeader signals that the current block header is not available
+var ErrNilCurrentBlockHeader = errors.New("nil current block header")
+
+// ErrAccountHasNoDataTrie signals that the account has no data trie, so no key proof can be computed
+var ErrAccountHasNoDataTrie = errors.New("account has no data trie")
+
+// ErrNilStorageUsageCollector signals that a nil storage usage collector has been provided
+var ErrNilStorageUsageCollector = errors.New("nil storage usage collector")
+
+// ErrUnknownStorageUnit signals that the requested storage unit does not exist
+var ErrUnknownStorageUnit = errors.New("unknown storage unit")
+
+// ErrStorageCompactionInProgress signals that a storage unit compaction is already in progress
+var ErrStorageCompactionInProgress = errors.New("a storage unit compaction is already in progress")
+
+// ErrTransactionsPoolInspectionNotSupported signals that the transactions pool does not support inspecting the transactions of a sender
+var ErrTransactionsPoolInspectionNotSupported = errors.New("transactions pool inspection is not supported")
+
+// ErrNilGasPriceOracle signals that a nil gas price oracle has been provided
+var ErrNilGasPriceOracle = errors.New("nil gas price oracle")
+
+// ErrInvalidPageSkip signals that the number of skipped items exceeds the maximum allowed
+var ErrInvalidPageSkip = errors.New("invalid number of skipped items")
+
+// ErrDataTrieTraversalTimeout signals that the traversal of a data trie did not finish in the allowed time
+var ErrDataTrieTraversalTimeout = errors.New("data trie traversal timed out")
+
+// ErrTooManyESDTNFTs signals that the account holds more token instances than a single query can return
+var ErrTooManyESDTNFTs = errors.New("too many ESDT NFTs, filter by token identifier")
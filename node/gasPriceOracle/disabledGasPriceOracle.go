@@ -0,0 +1,20 @@
+package gasPriceOracle
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledGasPriceOracle struct{}
+
+// NewDisabledGasPriceOracle returns a gas price oracle used when the gas prices suggestions are disabled
+func NewDisabledGasPriceOracle() *disabledGasPriceOracle {
+	return new(disabledGasPriceOracle)
+}
+
+// GetGasPriceSuggestion returns ErrGasPriceOracleDisabled
+func (d *disabledGasPriceOracle) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	return nil, ErrGasPriceOracleDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledGasPriceOracle) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,21 @@
+package gasPriceOracle
+
+import "errors"
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidNumBlocksToTrack signals that an invalid number of blocks to track has been provided
+var ErrInvalidNumBlocksToTrack = errors.New("invalid number of blocks to track")
+
+// ErrInvalidPoolCapacity signals that an invalid transactions pool capacity has been provided
+var ErrInvalidPoolCapacity = errors.New("invalid transactions pool capacity")
+
+// ErrGasPriceOracleDisabled signals that the gas price oracle is disabled
+var ErrGasPriceOracleDisabled = errors.New("gas price oracle is disabled")
@@ -0,0 +1,214 @@
+package gasPriceOracle
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("node/gasPriceOracle")
+
+const (
+	lowPercentile    = 25
+	medianPercentile = 50
+	highPercentile   = 90
+)
+
+// ArgsGasPriceOracle holds the arguments needed to create a gas price oracle
+type ArgsGasPriceOracle struct {
+	EconomicsHandler EconomicsHandler
+	TxPool           TxPool
+	ShardCoordinator sharding.Coordinator
+	NumBlocksToTrack int
+	PoolCapacity     uint32
+	PoolSizeInBytes  uint64
+}
+
+// trackedBlock holds the gas prices of the user transactions included in a committed block
+type trackedBlock struct {
+	headerHash []byte
+	gasPrices  []uint64
+}
+
+type gasPriceOracle struct {
+	economicsHandler EconomicsHandler
+	txPool           TxPool
+	shardCoordinator sharding.Coordinator
+	numBlocksToTrack int
+	poolCapacity     uint32
+	poolSizeInBytes  uint64
+
+	mutBlocks sync.RWMutex
+	// trackedBlocks holds the last committed blocks, oldest block first
+	trackedBlocks []*trackedBlock
+}
+
+// NewGasPriceOracle creates a component which suggests gas prices based on the gas prices of the transactions
+// included in the last committed blocks of the self shard and on the current fullness of the transactions pool
+func NewGasPriceOracle(args ArgsGasPriceOracle) (*gasPriceOracle, error) {
+	if check.IfNil(args.EconomicsHandler) {
+		return nil, ErrNilEconomicsHandler
+	}
+	if check.IfNil(args.TxPool) {
+		return nil, ErrNilTxPool
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.NumBlocksToTrack < 1 {
+		return nil, ErrInvalidNumBlocksToTrack
+	}
+	if args.PoolCapacity == 0 || args.PoolSizeInBytes == 0 {
+		return nil, ErrInvalidPoolCapacity
+	}
+
+	return &gasPriceOracle{
+		economicsHandler: args.EconomicsHandler,
+		txPool:           args.TxPool,
+		shardCoordinator: args.ShardCoordinator,
+		numBlocksToTrack: args.NumBlocksToTrack,
+		poolCapacity:     args.PoolCapacity,
+		poolSizeInBytes:  args.PoolSizeInBytes,
+		trackedBlocks:    make([]*trackedBlock, 0, args.NumBlocksToTrack),
+	}, nil
+}
+
+// NotifyCommittedBlock records the gas prices of the user transactions included in a committed block of the self shard
+func (gpo *gasPriceOracle) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+	if check.IfNil(header) || header.GetShardID() != gpo.shardCoordinator.SelfId() {
+		return
+	}
+
+	gasPrices := make([]uint64, 0, len(txs))
+	for _, tx := range txs {
+		_, isUserTx := tx.(*transaction.Transaction)
+		if !isUserTx {
+			continue
+		}
+
+		gasPrices = append(gasPrices, tx.GetGasPrice())
+	}
+
+	gpo.mutBlocks.Lock()
+	gpo.trackedBlocks = append(gpo.trackedBlocks, &trackedBlock{headerHash: headerHash, gasPrices: gasPrices})
+	if len(gpo.trackedBlocks) > gpo.numBlocksToTrack {
+		gpo.trackedBlocks = gpo.trackedBlocks[len(gpo.trackedBlocks)-gpo.numBlocksToTrack:]
+	}
+	gpo.mutBlocks.Unlock()
+
+	log.Trace("gasPriceOracle.NotifyCommittedBlock", "nonce", header.GetNonce(), "num user txs", len(gasPrices))
+}
+
+// NotifyRevertedBlock stops tracking the gas prices of a reverted block of the self shard
+func (gpo *gasPriceOracle) NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler) {
+	if check.IfNil(header) || header.GetShardID() != gpo.shardCoordinator.SelfId() {
+		return
+	}
+
+	gpo.mutBlocks.Lock()
+	defer gpo.mutBlocks.Unlock()
+
+	for i := len(gpo.trackedBlocks) - 1; i >= 0; i-- {
+		if !bytes.Equal(gpo.trackedBlocks[i].headerHash, headerHash) {
+			continue
+		}
+
+		gpo.trackedBlocks = append(gpo.trackedBlocks[:i], gpo.trackedBlocks[i+1:]...)
+		log.Trace("gasPriceOracle.NotifyRevertedBlock", "nonce", header.GetNonce())
+		return
+	}
+}
+
+// GetGasPriceSuggestion returns the low, median and high gas prices suggestions. The suggestions are the percentiles of
+// the gas prices of the transactions included in the tracked blocks, never lower than the minimum gas price. The
+// median and high suggestions are further raised proportionally with the transactions pool fullness, up to double
+// their value for a full pool, since the pool pressure is not yet reflected in the committed blocks
+func (gpo *gasPriceOracle) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	minGasPrice := gpo.economicsHandler.MinGasPrice()
+	gasPrices, numBlocks := gpo.getTrackedGasPrices()
+	poolFullness := gpo.computePoolFullness()
+
+	suggestion := &api.GasPriceSuggestion{
+		Low:             minGasPrice,
+		Median:          minGasPrice,
+		High:            minGasPrice,
+		MinGasPrice:     minGasPrice,
+		NumBlocks:       numBlocks,
+		NumTransactions: len(gasPrices),
+		PoolFullness:    poolFullness,
+	}
+	if len(gasPrices) > 0 {
+		sort.Slice(gasPrices, func(i, j int) bool {
+			return gasPrices[i] < gasPrices[j]
+		})
+
+		suggestion.Low = core.MaxUint64(minGasPrice, percentile(gasPrices, lowPercentile))
+		suggestion.Median = core.MaxUint64(minGasPrice, percentile(gasPrices, medianPercentile))
+		suggestion.High = core.MaxUint64(minGasPrice, percentile(gasPrices, highPercentile))
+	}
+
+	suggestion.Median = applyPoolPressure(suggestion.Median, poolFullness)
+	suggestion.High = core.MaxUint64(suggestion.Median, applyPoolPressure(suggestion.High, poolFullness))
+
+	return suggestion, nil
+}
+
+func (gpo *gasPriceOracle) getTrackedGasPrices() ([]uint64, int) {
+	gpo.mutBlocks.RLock()
+	defer gpo.mutBlocks.RUnlock()
+
+	gasPrices := make([]uint64, 0)
+	for _, tracked := range gpo.trackedBlocks {
+		gasPrices = append(gasPrices, tracked.gasPrices...)
+	}
+
+	return gasPrices, len(gpo.trackedBlocks)
+}
+
+// computePoolFullness returns the fullness of the transactions pool, with respect to both the number of transactions
+// and their size, as a value between 0 and 1
+func (gpo *gasPriceOracle) computePoolFullness() float64 {
+	counts := gpo.txPool.GetCounts()
+	countFullness := float64(counts.GetTotal()) / float64(gpo.poolCapacity)
+	sizeFullness := float64(counts.GetTotalSize()) / float64(gpo.poolSizeInBytes)
+
+	fullness := countFullness
+	if sizeFullness > fullness {
+		fullness = sizeFullness
+	}
+	if fullness > 1 {
+		return 1
+	}
+	if fullness < 0 {
+		return 0
+	}
+
+	return fullness
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpo *gasPriceOracle) IsInterfaceNil() bool {
+	return gpo == nil
+}
+
+// percentile returns the nearest-rank percentile of the provided sorted, non-empty values
+func percentile(sortedValues []uint64, percent int) uint64 {
+	rank := (len(sortedValues)*percent + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+func applyPoolPressure(gasPrice uint64, poolFullness float64) uint64 {
+	return gasPrice + uint64(float64(gasPrice)*poolFullness)
+}
@@ -0,0 +1,228 @@
+package gasPriceOracle_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/counting"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/gasPriceOracle"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const minGasPrice = uint64(1000)
+
+func createArgsGasPriceOracle() gasPriceOracle.ArgsGasPriceOracle {
+	return gasPriceOracle.ArgsGasPriceOracle{
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			MinGasPriceCalled: func() uint64 {
+				return minGasPrice
+			},
+		},
+		TxPool:           createTxPool(0, 0),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		NumBlocksToTrack: 3,
+		PoolCapacity:     100,
+		PoolSizeInBytes:  10000,
+	}
+}
+
+func createTxPool(numTxs int64, numBytes int64) *testscommon.ShardedDataStub {
+	return &testscommon.ShardedDataStub{
+		GetCountsCalled: func() counting.CountsWithSize {
+			counts := counting.NewConcurrentShardedCountsWithSize()
+			counts.PutCounts("0", numTxs, numBytes)
+			return counts
+		},
+	}
+}
+
+func createBlockTxs(gasPrices ...uint64) map[string]data.TransactionHandler {
+	txs := make(map[string]data.TransactionHandler)
+	for i, gasPrice := range gasPrices {
+		txs[fmt.Sprintf("tx%d", i)] = &transaction.Transaction{Nonce: uint64(i), GasPrice: gasPrice}
+	}
+
+	return txs
+}
+
+func TestNewGasPriceOracle_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsGasPriceOracle()
+	args.EconomicsHandler = nil
+	gpo, err := gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrNilEconomicsHandler, err)
+
+	args = createArgsGasPriceOracle()
+	args.TxPool = nil
+	gpo, err = gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrNilTxPool, err)
+
+	args = createArgsGasPriceOracle()
+	args.ShardCoordinator = nil
+	gpo, err = gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrNilShardCoordinator, err)
+
+	args = createArgsGasPriceOracle()
+	args.NumBlocksToTrack = 0
+	gpo, err = gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrInvalidNumBlocksToTrack, err)
+
+	args = createArgsGasPriceOracle()
+	args.PoolCapacity = 0
+	gpo, err = gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrInvalidPoolCapacity, err)
+
+	args = createArgsGasPriceOracle()
+	args.PoolSizeInBytes = 0
+	gpo, err = gasPriceOracle.NewGasPriceOracle(args)
+	assert.True(t, check.IfNil(gpo))
+	assert.Equal(t, gasPriceOracle.ErrInvalidPoolCapacity, err)
+}
+
+func TestGasPriceOracle_GetGasPriceSuggestionWithoutBlocksShouldReturnMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+
+	suggestion, err := gpo.GetGasPriceSuggestion()
+	assert.Nil(t, err)
+	assert.Equal(t, minGasPrice, suggestion.Low)
+	assert.Equal(t, minGasPrice, suggestion.Median)
+	assert.Equal(t, minGasPrice, suggestion.High)
+	assert.Equal(t, minGasPrice, suggestion.MinGasPrice)
+	assert.Equal(t, 0, suggestion.NumBlocks)
+	assert.Equal(t, 0, suggestion.NumTransactions)
+	assert.Equal(t, float64(0), suggestion.PoolFullness)
+}
+
+func TestGasPriceOracle_GetGasPriceSuggestionShouldComputePercentiles(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, createBlockTxs(1000, 2000, 3000, 4000, 5000))
+	gpo.NotifyCommittedBlock([]byte("hash2"), &block.Header{Nonce: 2}, createBlockTxs(6000, 7000, 8000, 9000, 10000))
+
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, uint64(3000), suggestion.Low)
+	assert.Equal(t, uint64(5000), suggestion.Median)
+	assert.Equal(t, uint64(9000), suggestion.High)
+	assert.Equal(t, 2, suggestion.NumBlocks)
+	assert.Equal(t, 10, suggestion.NumTransactions)
+}
+
+func TestGasPriceOracle_NotifyCommittedBlockShouldTrackOnlyTheLastBlocks(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, createBlockTxs(50000, 50000))
+	gpo.NotifyCommittedBlock([]byte("hash2"), &block.Header{Nonce: 2}, createBlockTxs(2000))
+	gpo.NotifyCommittedBlock([]byte("hash3"), &block.Header{Nonce: 3}, createBlockTxs())
+	gpo.NotifyCommittedBlock([]byte("hash4"), &block.Header{Nonce: 4}, createBlockTxs(2000))
+
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 3, suggestion.NumBlocks)
+	assert.Equal(t, 2, suggestion.NumTransactions)
+	assert.Equal(t, uint64(2000), suggestion.High)
+}
+
+func TestGasPriceOracle_NotifyRevertedBlockShouldUntrackTheBlock(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, createBlockTxs(2000))
+	gpo.NotifyCommittedBlock([]byte("hash2"), &block.Header{Nonce: 2}, createBlockTxs(50000, 50000))
+
+	gpo.NotifyRevertedBlock([]byte("hash2"), nil)
+	gpo.NotifyRevertedBlock([]byte("hash2"), &block.Header{Nonce: 2, ShardID: 1})
+	gpo.NotifyRevertedBlock([]byte("missing hash"), &block.Header{Nonce: 2})
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 2, suggestion.NumBlocks)
+
+	gpo.NotifyRevertedBlock([]byte("hash2"), &block.Header{Nonce: 2})
+	suggestion, _ = gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 1, suggestion.NumBlocks)
+	assert.Equal(t, 1, suggestion.NumTransactions)
+	assert.Equal(t, uint64(2000), suggestion.High)
+
+	gpo.NotifyCommittedBlock([]byte("hash2bis"), &block.Header{Nonce: 2}, createBlockTxs(3000))
+	suggestion, _ = gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 2, suggestion.NumBlocks)
+	assert.Equal(t, uint64(3000), suggestion.High)
+}
+
+func TestGasPriceOracle_NotifyCommittedBlockShouldIgnoreOtherShardsAndNonUserTransactions(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1, ShardID: 1}, createBlockTxs(5000))
+	gpo.NotifyCommittedBlock([]byte("hash2"), nil, createBlockTxs(5000))
+	txs := createBlockTxs(3000)
+	txs["reward"] = &rewardTx.RewardTx{}
+	gpo.NotifyCommittedBlock([]byte("hash3"), &block.Header{Nonce: 3}, txs)
+
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 1, suggestion.NumBlocks)
+	assert.Equal(t, 1, suggestion.NumTransactions)
+	assert.Equal(t, uint64(3000), suggestion.Median)
+}
+
+func TestGasPriceOracle_GetGasPriceSuggestionShouldNotGoBelowMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(createArgsGasPriceOracle())
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, createBlockTxs(10, 20, 30))
+
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, minGasPrice, suggestion.Low)
+	assert.Equal(t, minGasPrice, suggestion.Median)
+	assert.Equal(t, minGasPrice, suggestion.High)
+}
+
+func TestGasPriceOracle_GetGasPriceSuggestionShouldApplyPoolPressure(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsGasPriceOracle()
+	args.TxPool = createTxPool(50, 1000)
+	gpo, _ := gasPriceOracle.NewGasPriceOracle(args)
+	gpo.NotifyCommittedBlock([]byte("hash1"), &block.Header{Nonce: 1}, createBlockTxs(2000, 4000, 8000))
+
+	suggestion, _ := gpo.GetGasPriceSuggestion()
+	assert.Equal(t, 0.5, suggestion.PoolFullness)
+	assert.Equal(t, uint64(2000), suggestion.Low)
+	assert.Equal(t, uint64(6000), suggestion.Median)
+	assert.Equal(t, uint64(12000), suggestion.High)
+
+	args.TxPool = createTxPool(10, 50000)
+	gpo, _ = gasPriceOracle.NewGasPriceOracle(args)
+
+	suggestion, _ = gpo.GetGasPriceSuggestion()
+	assert.Equal(t, float64(1), suggestion.PoolFullness)
+	assert.Equal(t, minGasPrice, suggestion.Low)
+	assert.Equal(t, 2*minGasPrice, suggestion.Median)
+	assert.Equal(t, 2*minGasPrice, suggestion.High)
+}
+
+func TestDisabledGasPriceOracle_GetGasPriceSuggestionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gpo := gasPriceOracle.NewDisabledGasPriceOracle()
+	assert.False(t, check.IfNil(gpo))
+
+	suggestion, err := gpo.GetGasPriceSuggestion()
+	assert.Nil(t, suggestion)
+	assert.Equal(t, gasPriceOracle.ErrGasPriceOracleDisabled, err)
+}
@@ -0,0 +1,15 @@
+package gasPriceOracle
+
+import "github.com/ElrondNetwork/elrond-go/core/counting"
+
+// EconomicsHandler provides the minimum gas price accepted by the network
+type EconomicsHandler interface {
+	MinGasPrice() uint64
+	IsInterfaceNil() bool
+}
+
+// TxPool provides the number of transactions (and their size) held by the transactions pool
+type TxPool interface {
+	GetCounts() counting.CountsWithSize
+	IsInterfaceNil() bool
+}
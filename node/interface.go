@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -83,3 +85,21 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// TransactionsPoolInspector defines the transactions pool operations used to inspect the pending transactions of a sender
+type TransactionsPoolInspector interface {
+	GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction
+	GetNonceGapsForSender(sender []byte, accountNonce uint64) []txcache.NonceGap
+}
+
+// StorageUsageCollector defines the component able to provide the disk space used by the node's databases
+type StorageUsageCollector interface {
+	LastUsage() (*api.StorageUsage, error)
+	IsInterfaceNil() bool
+}
+
+// GasPriceOracle defines the component able to suggest gas prices based on the recent blocks and the pool pressure
+type GasPriceOracle interface {
+	GetGasPriceSuggestion() (*api.GasPriceSuggestion, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// GasPriceOracleStub -
+type GasPriceOracleStub struct {
+	GetGasPriceSuggestionCalled func() (*api.GasPriceSuggestion, error)
+}
+
+// GetGasPriceSuggestion -
+func (stub *GasPriceOracleStub) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	if stub.GetGasPriceSuggestionCalled != nil {
+		return stub.GetGasPriceSuggestionCalled()
+	}
+
+	return &api.GasPriceSuggestion{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *GasPriceOracleStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// StorageUsageCollectorStub -
+type StorageUsageCollectorStub struct {
+	LastUsageCalled func() (*api.StorageUsage, error)
+}
+
+// LastUsage -
+func (stub *StorageUsageCollectorStub) LastUsage() (*api.StorageUsage, error) {
+	if stub.LastUsageCalled != nil {
+		return stub.LastUsageCalled()
+	}
+
+	return &api.StorageUsage{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *StorageUsageCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -9,28 +9,37 @@ import (
 
 // TrieStub -
 type TrieStub struct {
-	GetCalled                   func(key []byte) ([]byte, error)
-	UpdateCalled                func(key, value []byte) error
-	DeleteCalled                func(key []byte) error
-	RootCalled                  func() ([]byte, error)
-	CommitCalled                func() error
-	RecreateCalled              func(root []byte) (data.Trie, error)
-	CancelPruneCalled           func(rootHash []byte, identifier data.TriePruningIdentifier)
-	PruneCalled                 func(rootHash []byte, identifier data.TriePruningIdentifier)
-	ResetOldHashesCalled        func() [][]byte
-	AppendToOldHashesCalled     func([][]byte)
-	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
-	GetAllHashesCalled          func() ([][]byte, error)
-	DatabaseCalled              func() data.DBWriteCacher
-	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetCalled                       func(key []byte) ([]byte, error)
+	UpdateCalled                    func(key, value []byte) error
+	DeleteCalled                    func(key []byte) error
+	RootCalled                      func() ([]byte, error)
+	CommitCalled                    func() error
+	RecreateCalled                  func(root []byte) (data.Trie, error)
+	CancelPruneCalled               func(rootHash []byte, identifier data.TriePruningIdentifier)
+	PruneCalled                     func(rootHash []byte, identifier data.TriePruningIdentifier)
+	ResetOldHashesCalled            func() [][]byte
+	AppendToOldHashesCalled         func([][]byte)
+	GetSerializedNodesCalled        func([]byte, uint64) ([][]byte, uint64, error)
+	GetAllHashesCalled              func() ([][]byte, error)
+	GetProofCalled                  func(key []byte) ([][]byte, error)
+	DatabaseCalled                  func() data.DBWriteCacher
+	GetAllLeavesOnChannelCalled     func(rootHash []byte) (chan core.KeyValueHolder, error)
+	EnterPruningBufferingModeCalled func()
+	ExitPruningBufferingModeCalled  func()
 }
 
 // EnterPruningBufferingMode -
 func (ts *TrieStub) EnterPruningBufferingMode() {
+	if ts.EnterPruningBufferingModeCalled != nil {
+		ts.EnterPruningBufferingModeCalled()
+	}
 }
 
 // ExitPruningBufferingMode -
 func (ts *TrieStub) ExitPruningBufferingMode() {
+	if ts.ExitPruningBufferingModeCalled != nil {
+		ts.ExitPruningBufferingModeCalled()
+	}
 }
 
 // ClosePersister -
@@ -192,6 +201,15 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 	return nil, nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
+
 // GetSnapshotDbBatchDelay -
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
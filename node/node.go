@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -59,6 +60,21 @@ import (
 // SendTransactionsPipe is the pipe used for sending new transactions
 const SendTransactionsPipe = "send transactions pipe"
 
+// MaxTransactionsByAddressPageSize is the maximum number of transactions returned by a single transactions by address query
+const MaxTransactionsByAddressPageSize = 100
+
+// MaxKeyValuePairsPageSize is the maximum number of key-value pairs returned by a single account storage query
+const MaxKeyValuePairsPageSize = 1000
+
+// MaxKeyValuePairsSkip is the maximum number of key-value pairs that can be skipped by a single account storage query
+const MaxKeyValuePairsSkip = 100000
+
+// MaxESDTNFTsPerQuery is the maximum number of token instances returned by a single account NFTs query
+const MaxESDTNFTsPerQuery = 1000
+
+// defaultDataTrieTraversalTimeout is the maximum duration of a data trie traversal started by an API query
+const defaultDataTrieTraversalTimeout = time.Second * 10
+
 var log = logger.GetOrCreate("node")
 var numSecondsBetweenPrints = 20
 
@@ -75,6 +91,7 @@ type Node struct {
 	vmMarshalizer                 marshal.Marshalizer
 	txSignMarshalizer             marshal.Marshalizer
 	ctx                           context.Context
+	dataTrieTraversalTimeout      time.Duration
 	hasher                        hashing.Hasher
 	feeHandler                    process.FeeHandler
 	initialNodesPubkeys           map[uint32][]string
@@ -88,6 +105,7 @@ type Node struct {
 	epochStartTrigger             epochStart.TriggerHandler
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	accounts                      state.AccountsAdapter
+	accountsTrie                  data.Trie
 	addressPubkeyConverter        core.PubkeyConverter
 	validatorPubkeyConverter      core.PubkeyConverter
 	uint64ByteSliceConverter      typeConverters.Uint64ByteSliceConverter
@@ -168,6 +186,10 @@ type Node struct {
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
 	isInImportMode            bool
+
+	storageUsageCollector StorageUsageCollector
+	isCompactingStorage   int32
+	gasPriceOracle        GasPriceOracle
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -188,6 +210,7 @@ func NewNode(opts ...Option) (*Node, error) {
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
+		dataTrieTraversalTimeout: defaultDataTrieTraversalTimeout,
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -411,7 +434,18 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 
 // GetBalance gets the balance for a specific address
 func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+	return n.GetBalanceWithOptions(address, api.AccountQueryOptions{})
+}
+
+// GetBalanceWithOptions gets the balance for a specific address from the state selected by the provided options
+func (n *Node) GetBalanceWithOptions(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	accountsAdapter, release, err := n.getAccountsAdapterWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	account, err := n.getAccountHandler(address, accountsAdapter)
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +460,7 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 
 // GetUsername gets the username for a specific address
 func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, n.accounts)
 	if err != nil {
 		return "", err
 	}
@@ -442,12 +476,24 @@ func (n *Node) GetUsername(address string) (string, error) {
 
 // GetValueForKey will return the value for a key from a given account
 func (n *Node) GetValueForKey(address string, key string) (string, error) {
+	return n.GetValueForKeyWithOptions(address, key, api.AccountQueryOptions{})
+}
+
+// GetValueForKeyWithOptions will return the value for a key from a given account, read from the state selected by
+// the provided options
+func (n *Node) GetValueForKeyWithOptions(address string, key string, options api.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	accountsAdapter, release, err := n.getAccountsAdapterWithOptions(options)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	account, err := n.getAccountHandler(address, accountsAdapter)
 	if err != nil {
 		return "", err
 	}
@@ -467,7 +513,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 
 // GetESDTBalance returns the esdt balance and properties from a given account
 func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, n.accounts)
 	if err != nil {
 		return "", "", err
 	}
@@ -494,7 +540,7 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 
 // GetAllESDTTokens returns the value of a key from a given account
 func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, n.accounts)
 	if err != nil {
 		return nil, err
 	}
@@ -535,8 +581,8 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
-	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
+func (n *Node) getAccountHandler(address string, accountsAdapter state.AccountsAdapter) (state.AccountHandler, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(accountsAdapter) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
 
@@ -544,7 +590,7 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	return n.accounts.GetExistingAccount(addr)
+	return accountsAdapter.GetExistingAccount(addr)
 }
 
 func (n *Node) castAccountToUserAccount(ah state.AccountHandler) (state.UserAccountHandler, bool) {
@@ -1138,6 +1184,12 @@ func (n *Node) CreateTransaction(
 
 // GetAccount will return account details for a given address
 func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+	return n.GetAccountWithOptions(address, api.AccountQueryOptions{})
+}
+
+// GetAccountWithOptions will return the account details for a given address, read from the state selected by the
+// provided options
+func (n *Node) GetAccountWithOptions(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -1150,7 +1202,13 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accountsAdapter, release, err := n.getAccountsAdapterWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	accWrp, err := accountsAdapter.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -0,0 +1,466 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+func noRelease() {}
+
+// getAccountsAdapterWithOptions returns the accounts adapter positioned on the state selected by the provided options
+// along with the function that has to be called when the reads are done. While reading from a past state, the pruning
+// of the accounts trie is buffered so the state cannot be removed mid-read
+func (n *Node) getAccountsAdapterWithOptions(options api.AccountQueryOptions) (state.AccountsAdapter, func(), error) {
+	isHistoricalQuery, err := checkAccountQueryOptions(options)
+	if err != nil {
+		return nil, noRelease, err
+	}
+	if !isHistoricalQuery {
+		return n.accounts, noRelease, nil
+	}
+	if check.IfNil(n.accountsTrie) {
+		return nil, noRelease, ErrHistoricalStateNotSupported
+	}
+
+	rootHash, err := n.getRootHashForOptions(options)
+	if err != nil {
+		return nil, noRelease, err
+	}
+
+	n.accountsTrie.EnterPruningBufferingMode()
+	release := n.accountsTrie.ExitPruningBufferingMode
+
+	accountsAdapter, err := n.createAccountsAdapterOnRootHash(rootHash)
+	if err != nil {
+		release()
+		return nil, noRelease, err
+	}
+
+	return accountsAdapter, release, nil
+}
+
+func checkAccountQueryOptions(options api.AccountQueryOptions) (bool, error) {
+	hasBlockHash := len(options.BlockHash) > 0
+	if options.BlockNonce.HasValue && hasBlockHash {
+		return false, ErrInvalidAccountQueryOptions
+	}
+
+	return options.BlockNonce.HasValue || hasBlockHash, nil
+}
+
+func (n *Node) getRootHashForOptions(options api.AccountQueryOptions) ([]byte, error) {
+	var header data.HeaderHandler
+	var err error
+	if options.BlockNonce.HasValue {
+		header, _, err = process.GetHeaderFromStorageWithNonce(
+			options.BlockNonce.Value,
+			n.shardCoordinator.SelfId(),
+			n.store,
+			n.uint64ByteSliceConverter,
+			n.internalMarshalizer,
+		)
+	} else {
+		header, err = n.getHeaderFromStorage(options.BlockHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func (n *Node) getHeaderFromStorage(hash []byte) (data.HeaderHandler, error) {
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(hash, n.internalMarshalizer, n.store)
+	}
+
+	return process.GetShardHeaderFromStorage(hash, n.internalMarshalizer, n.store)
+}
+
+func (n *Node) createAccountsAdapterOnRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	recreatedTrie, err := n.accountsTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash %s: %s", ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	return state.NewAccountsDB(recreatedTrie, n.hasher, n.internalMarshalizer, factory.NewAccountCreator())
+}
+
+// GetKeyValuePairs returns at most maxSize key-value pairs stored in the data trie of the given address, in trie
+// order, after skipping the first "skip" ones. The trie traversal is cancelled as soon as the page is filled
+func (n *Node) GetKeyValuePairs(address string, skip uint64, maxSize uint64) (*api.AccountKeyValuePairsPage, error) {
+	if maxSize == 0 || maxSize > MaxKeyValuePairsPageSize {
+		return nil, ErrInvalidPageSize
+	}
+	if skip > MaxKeyValuePairsSkip {
+		return nil, ErrInvalidPageSkip
+	}
+
+	account, err := n.getAccountHandler(address, n.accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	page := &api.AccountKeyValuePairsPage{
+		Pairs: make([]*api.AccountKeyValuePair, 0),
+	}
+	if check.IfNil(userAccount.DataTrie()) {
+		return page, nil
+	}
+
+	index := uint64(0)
+	err = n.iterateDataTrieLeaves(userAccount.DataTrie(), func(leaf core.KeyValueHolder) bool {
+		if index < skip {
+			index++
+			return true
+		}
+		if uint64(len(page.Pairs)) == maxSize {
+			page.HasMore = true
+			return false
+		}
+
+		page.Pairs = append(page.Pairs, n.createAccountKeyValuePair(leaf, userAccount.AddressBytes()))
+		index++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// iterateDataTrieLeaves calls the handler for each leaf of the data trie until the handler returns false. The traversal
+// is cancelled when the iteration stops and fails with ErrDataTrieTraversalTimeout if it does not end in time
+func (n *Node) iterateDataTrieLeaves(dataTrie data.Trie, handler func(leaf core.KeyValueHolder) bool) error {
+	rootHash, err := dataTrie.Root()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.dataTrieTraversalTimeout)
+	defer cancel()
+
+	chLeaves, err := dataTrie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case leaf, ok := <-chLeaves:
+			if !ok {
+				return nil
+			}
+			if !handler(leaf) {
+				return nil
+			}
+		case <-ctx.Done():
+			return ErrDataTrieTraversalTimeout
+		}
+	}
+}
+
+func (n *Node) createAccountKeyValuePair(leaf core.KeyValueHolder, address []byte) *api.AccountKeyValuePair {
+	key := leaf.Key()
+	value := trimDataTrieValue(leaf, address)
+
+	return &api.AccountKeyValuePair{
+		Key:   hex.EncodeToString(key),
+		Value: hex.EncodeToString(value),
+		ESDT:  n.decodeESDTKeyValue(key, value),
+	}
+}
+
+func (n *Node) decodeESDTKeyValue(key []byte, value []byte) *api.ESDTKeyValueData {
+	esdtPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	if !bytes.HasPrefix(key, esdtPrefix) {
+		return nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := n.internalMarshalizer.Unmarshal(esdtToken, value)
+	if err != nil {
+		log.Debug("decodeESDTKeyValue: cannot unmarshal ESDT data", "key", key, "error", err)
+		return nil
+	}
+
+	balance := "0"
+	if esdtToken.Value != nil {
+		balance = esdtToken.Value.String()
+	}
+
+	return &api.ESDTKeyValueData{
+		TokenIdentifier: string(key[len(esdtPrefix):]),
+		Balance:         balance,
+		Properties:      hex.EncodeToString(esdtToken.Properties),
+	}
+}
+
+// trimDataTrieValue returns the value of a data trie leaf without the key and the address, which are appended to the
+// saved values
+func trimDataTrieValue(leaf core.KeyValueHolder, address []byte) []byte {
+	value := leaf.Value()
+	tailLength := len(leaf.Key()) + len(address)
+	if len(value) >= tailLength {
+		value = value[:len(value)-tailLength]
+	}
+
+	return value
+}
+
+// GetAllESDTNFTs returns the non fungible and semi fungible token instances held by the given address. If a token
+// identifier is provided, only the instances of that token are returned. The query fails with ErrTooManyESDTNFTs if
+// more than MaxESDTNFTsPerQuery instances are found
+func (n *Node) GetAllESDTNFTs(address string, tokenIdentifier string) ([]*api.ESDTNFTData, error) {
+	account, err := n.getAccountHandler(address, n.accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	foundNFTs := make([]*api.ESDTNFTData, 0)
+	if check.IfNil(userAccount.DataTrie()) {
+		return foundNFTs, nil
+	}
+
+	nftPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTKeyIdentifier + tokenIdentifier)
+	tooManyNFTs := false
+	err = n.iterateDataTrieLeaves(userAccount.DataTrie(), func(leaf core.KeyValueHolder) bool {
+		if !bytes.HasPrefix(leaf.Key(), nftPrefix) {
+			return true
+		}
+
+		nftData := n.decodeESDTNFTKeyValue(leaf.Key(), trimDataTrieValue(leaf, userAccount.AddressBytes()))
+		if nftData == nil {
+			return true
+		}
+		if len(tokenIdentifier) > 0 && nftData.TokenIdentifier != tokenIdentifier {
+			return true
+		}
+		if len(foundNFTs) == MaxESDTNFTsPerQuery {
+			tooManyNFTs = true
+			return false
+		}
+
+		foundNFTs = append(foundNFTs, nftData)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tooManyNFTs {
+		return nil, ErrTooManyESDTNFTs
+	}
+
+	return foundNFTs, nil
+}
+
+// decodeESDTNFTKeyValue decodes a token instance saved under a key formed by the NFT prefix, the token identifier and
+// the fixed width nonce of the instance
+func (n *Node) decodeESDTNFTKeyValue(key []byte, value []byte) *api.ESDTNFTData {
+	tokenID, nonce, ok := core.ParseESDTNFTTokenKey(key)
+	if !ok {
+		log.Debug("decodeESDTNFTKeyValue: invalid ESDT NFT key", "key", key)
+		return nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := n.internalMarshalizer.Unmarshal(esdtToken, value)
+	if err != nil || esdtToken.TokenMetaData == nil {
+		log.Debug("decodeESDTNFTKeyValue: cannot unmarshal ESDT NFT data", "key", key, "error", err)
+		return nil
+	}
+	if esdtToken.TokenMetaData.Nonce != nonce {
+		log.Debug("decodeESDTNFTKeyValue: key does not match the token nonce", "key", key)
+		return nil
+	}
+
+	balance := "0"
+	if esdtToken.Value != nil {
+		balance = esdtToken.Value.String()
+	}
+
+	metaData := esdtToken.TokenMetaData
+	uris := make([]string, 0, len(metaData.URIs))
+	for _, uri := range metaData.URIs {
+		uris = append(uris, string(uri))
+	}
+
+	return &api.ESDTNFTData{
+		TokenIdentifier: string(tokenID),
+		Nonce:           metaData.Nonce,
+		Type:            esdt.ESDTType(esdtToken.Type).String(),
+		Balance:         balance,
+		Name:            string(metaData.Name),
+		Creator:         n.addressPubkeyConverter.Encode(metaData.Creator),
+		Royalties:       metaData.Royalties,
+		Hash:            hex.EncodeToString(metaData.Hash),
+		Attributes:      hex.EncodeToString(metaData.Attributes),
+		URIs:            uris,
+	}
+}
+
+// GetAccountProof returns the Merkle proof of the given account in the accounts trie. The proof is computed against
+// the root hash of the block selected by the provided options or, when no option is set, of the current block
+func (n *Node) GetAccountProof(address string, options api.AccountQueryOptions) (*api.TrieProof, error) {
+	addressBytes, rootHash, err := n.prepareProof(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	n.accountsTrie.EnterPruningBufferingMode()
+	defer n.accountsTrie.ExitPruningBufferingMode()
+
+	proof, _, err := n.getTrieProof(rootHash, addressBytes)
+
+	return proof, err
+}
+
+// GetAccountKeyProof returns the Merkle proof of the given account in the accounts trie along with the Merkle proof
+// of the given hex encoded key in the data trie of the account. The proofs are computed against the root hash of the
+// block selected by the provided options or, when no option is set, of the current block
+func (n *Node) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	addressBytes, rootHash, err := n.prepareProof(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	n.accountsTrie.EnterPruningBufferingMode()
+	defer n.accountsTrie.ExitPruningBufferingMode()
+
+	accountProof, accountBytes, err := n.getTrieProof(rootHash, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHash, err := n.getDataTrieRootHash(addressBytes, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	keyProof, _, err := n.getTrieProof(dataTrieRootHash, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AccountKeyProof{
+		AccountProof: accountProof,
+		KeyProof:     keyProof,
+	}, nil
+}
+
+func (n *Node) prepareProof(address string, options api.AccountQueryOptions) ([]byte, []byte, error) {
+	if check.IfNil(n.accountsTrie) {
+		return nil, nil, ErrTrieProofsNotSupported
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, ErrNilPubkeyConverter
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHash, err := n.getProofRootHash(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return addressBytes, rootHash, nil
+}
+
+func (n *Node) getProofRootHash(options api.AccountQueryOptions) ([]byte, error) {
+	isHistoricalQuery, err := checkAccountQueryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	if isHistoricalQuery {
+		return n.getRootHashForOptions(options)
+	}
+
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilCurrentBlockHeader
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func (n *Node) getTrieProof(rootHash []byte, key []byte) (*api.TrieProof, []byte, error) {
+	tr, err := n.accountsTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for root hash %s: %s", ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	proof, err := tr.GetProof(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := tr.Get(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encodedProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encodedNode))
+	}
+
+	return &api.TrieProof{
+		RootHash: hex.EncodeToString(rootHash),
+		Key:      hex.EncodeToString(key),
+		Value:    hex.EncodeToString(value),
+		Proof:    encodedProof,
+	}, value, nil
+}
+
+func (n *Node) getDataTrieRootHash(addressBytes []byte, accountBytes []byte) ([]byte, error) {
+	account, err := state.NewUserAccount(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.internalMarshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(account.GetRootHash()) == 0 {
+		return nil, ErrAccountHasNoDataTrie
+	}
+
+	return account.GetRootHash(), nil
+}
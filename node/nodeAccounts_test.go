@@ -0,0 +1,527 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStoreWithHeader(t *testing.T, headerHash []byte, rootHash []byte) dataRetriever.StorageService {
+	marshalizer := &mock.MarshalizerFake{}
+	headerBytes, err := marshalizer.Marshal(&block.Header{Nonce: 7, RootHash: rootHash})
+	require.Nil(t, err)
+
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.BlockHeaderUnit && string(key) == string(headerHash) {
+						return headerBytes, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+	}
+}
+
+func createNodeForHistoricalQueries(
+	t *testing.T,
+	accountsTrie data.Trie,
+	store dataRetriever.StorageService,
+) *node.Node {
+	n, err := node.NewNode(
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(100))),
+		node.WithAccountsTrie(accountsTrie),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, testSizeCheckDelta),
+		node.WithHasher(&mock.HasherMock{}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithDataStore(store),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetBalanceWithOptionsBothNonceAndHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForHistoricalQueries(t, &mock.TrieStub{}, &mock.ChainStorerMock{})
+	options := api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: 7, HasValue: true},
+		BlockHash:  []byte("hash"),
+	}
+
+	balance, err := n.GetBalanceWithOptions(createDummyHexAddress(64), options)
+
+	assert.Nil(t, balance)
+	assert.Equal(t, node.ErrInvalidAccountQueryOptions, err)
+}
+
+func TestNode_GetBalanceWithOptionsWithoutAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(100))),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+	options := api.AccountQueryOptions{BlockHash: []byte("hash")}
+
+	balance, err := n.GetBalanceWithOptions(createDummyHexAddress(64), options)
+
+	assert.Nil(t, balance)
+	assert.Equal(t, node.ErrHistoricalStateNotSupported, err)
+}
+
+func TestNode_GetBalanceWithOptionsEmptyOptionsShouldReadCurrentState(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Fail(t, "should have not recreated the trie")
+			return nil, nil
+		},
+	}
+	n := createNodeForHistoricalQueries(t, accountsTrie, &mock.ChainStorerMock{})
+
+	balance, err := n.GetBalanceWithOptions(createDummyHexAddress(64), api.AccountQueryOptions{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+}
+
+func TestNode_GetBalanceWithOptionsStateNotAvailableShouldErrAndExitBufferingMode(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	rootHash := []byte("root hash")
+	numEnter, numExit := 0, 0
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return nil, errors.New("missing trie node")
+		},
+		EnterPruningBufferingModeCalled: func() {
+			numEnter++
+		},
+		ExitPruningBufferingModeCalled: func() {
+			numExit++
+		},
+	}
+	n := createNodeForHistoricalQueries(t, accountsTrie, createStoreWithHeader(t, headerHash, rootHash))
+
+	balance, err := n.GetBalanceWithOptions(createDummyHexAddress(64), api.AccountQueryOptions{BlockHash: headerHash})
+
+	assert.Nil(t, balance)
+	assert.True(t, errors.Is(err, node.ErrStateNotAvailable))
+	assert.Equal(t, 1, numEnter)
+	assert.Equal(t, 1, numExit)
+}
+
+func TestNode_GetAccountWithOptionsShouldReadFromRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	rootHash := []byte("root hash")
+	marshalizer := &mock.MarshalizerFake{}
+	address := []byte("12345678901234567890123456789012")
+	pastAccount, _ := state.NewUserAccount(address)
+	_ = pastAccount.AddToBalance(big.NewInt(37))
+	pastAccount.IncreaseNonce(3)
+	pastAccountBytes, _ := marshalizer.Marshal(pastAccount)
+
+	numEnter, numExit := 0, 0
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return &mock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return pastAccountBytes, nil
+				},
+			}, nil
+		},
+		EnterPruningBufferingModeCalled: func() {
+			numEnter++
+		},
+		ExitPruningBufferingModeCalled: func() {
+			numExit++
+		},
+	}
+	n := createNodeForHistoricalQueries(t, accountsTrie, createStoreWithHeader(t, headerHash, rootHash))
+	pubkeyConverter := createMockPubkeyConverter()
+
+	account, err := n.GetAccountWithOptions(pubkeyConverter.Encode(address), api.AccountQueryOptions{BlockHash: headerHash})
+
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), account.GetBalance())
+	assert.Equal(t, uint64(3), account.GetNonce())
+	assert.Equal(t, 1, numEnter)
+	assert.Equal(t, 1, numExit)
+}
+
+func createNodeWithDataTrieLeaves(address []byte, leaves []core.KeyValueHolder) *node.Node {
+	acc, _ := state.NewUserAccount(address)
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder, len(leaves))
+				for _, leaf := range leaves {
+					ch <- leaf
+				}
+				close(ch)
+
+				return ch, nil
+			},
+		})
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	return n
+}
+
+func TestNode_GetKeyValuePairsInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithDataTrieLeaves([]byte("address"), nil)
+
+	page, err := n.GetKeyValuePairs(createDummyHexAddress(64), 0, 0)
+	assert.Nil(t, page)
+	assert.Equal(t, node.ErrInvalidPageSize, err)
+
+	page, err = n.GetKeyValuePairs(createDummyHexAddress(64), 0, node.MaxKeyValuePairsPageSize+1)
+	assert.Nil(t, page)
+	assert.Equal(t, node.ErrInvalidPageSize, err)
+}
+
+func TestNode_GetKeyValuePairsTooManySkippedPairsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithDataTrieLeaves([]byte("address"), nil)
+
+	page, err := n.GetKeyValuePairs(createDummyHexAddress(64), node.MaxKeyValuePairsSkip+1, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, node.ErrInvalidPageSkip, err)
+}
+
+func TestNode_GetKeyValuePairsTraversalTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	acc, _ := state.NewUserAccount(address)
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder, 1)
+				ch <- keyValStorage.NewKeyValStorage([]byte("key"), append([]byte("key"), address...))
+
+				return ch, nil
+			},
+		})
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+	n.SetDataTrieTraversalTimeout(time.Millisecond * 10)
+
+	page, err := n.GetKeyValuePairs(createDummyHexAddress(64), 0, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, node.ErrDataTrieTraversalTimeout, err)
+}
+
+func TestNode_GetKeyValuePairsShouldTrimValuesAndDecodeESDT(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	key := []byte("key")
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-0001")
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(10), Properties: []byte{1}}
+	marshalledESDTData, _ := getMarshalizer().Marshal(esdtData)
+
+	leaves := []core.KeyValueHolder{
+		keyValStorage.NewKeyValStorage(key, append(append([]byte("value"), key...), address...)),
+		keyValStorage.NewKeyValStorage(esdtKey, append(append(marshalledESDTData, esdtKey...), address...)),
+	}
+	n := createNodeWithDataTrieLeaves(address, leaves)
+
+	page, err := n.GetKeyValuePairs(createDummyHexAddress(64), 0, 10)
+	require.Nil(t, err)
+	assert.False(t, page.HasMore)
+	require.Equal(t, 2, len(page.Pairs))
+
+	assert.Equal(t, hex.EncodeToString(key), page.Pairs[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), page.Pairs[0].Value)
+	assert.Nil(t, page.Pairs[0].ESDT)
+
+	assert.Equal(t, hex.EncodeToString(marshalledESDTData), page.Pairs[1].Value)
+	expectedESDT := &api.ESDTKeyValueData{
+		TokenIdentifier: "TKN-0001",
+		Balance:         "10",
+		Properties:      "01",
+	}
+	assert.Equal(t, expectedESDT, page.Pairs[1].ESDT)
+}
+
+func TestNode_GetKeyValuePairsShouldPaginate(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	leaves := make([]core.KeyValueHolder, 0)
+	for i := 0; i < 5; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		leaves = append(leaves, keyValStorage.NewKeyValStorage(key, append(key, address...)))
+	}
+	n := createNodeWithDataTrieLeaves(address, leaves)
+
+	page, err := n.GetKeyValuePairs(createDummyHexAddress(64), 1, 2)
+	require.Nil(t, err)
+	assert.True(t, page.HasMore)
+	require.Equal(t, 2, len(page.Pairs))
+	assert.Equal(t, hex.EncodeToString([]byte("key1")), page.Pairs[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("key2")), page.Pairs[1].Key)
+
+	page, err = n.GetKeyValuePairs(createDummyHexAddress(64), 3, 2)
+	require.Nil(t, err)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, 2, len(page.Pairs))
+}
+
+func createNodeForProofs(t *testing.T, accountsTrie data.Trie, currentRootHash []byte) *node.Node {
+	n, err := node.NewNode(
+		node.WithAccountsTrie(accountsTrie),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, testSizeCheckDelta),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: currentRootHash}
+			},
+		}),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetAccountProofWithoutAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithAddressPubkeyConverter(createMockPubkeyConverter()))
+
+	proof, err := n.GetAccountProof(createDummyHexAddress(64), api.AccountQueryOptions{})
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrTrieProofsNotSupported, err)
+}
+
+func TestNode_GetAccountProofShouldUseCurrentRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("12345678901234567890123456789012")
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return &mock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, error) {
+					assert.Equal(t, address, key)
+					return [][]byte{[]byte("root node"), []byte("leaf node")}, nil
+				},
+				GetCalled: func(key []byte) ([]byte, error) {
+					return []byte("account"), nil
+				},
+			}, nil
+		},
+	}
+	n := createNodeForProofs(t, accountsTrie, rootHash)
+
+	proof, err := n.GetAccountProof(createMockPubkeyConverter().Encode(address), api.AccountQueryOptions{})
+	require.Nil(t, err)
+
+	expectedProof := &api.TrieProof{
+		RootHash: hex.EncodeToString(rootHash),
+		Key:      hex.EncodeToString(address),
+		Value:    hex.EncodeToString([]byte("account")),
+		Proof:    []string{hex.EncodeToString([]byte("root node")), hex.EncodeToString([]byte("leaf node"))},
+	}
+	assert.Equal(t, expectedProof, proof)
+}
+
+func TestNode_GetAccountKeyProofAccountWithoutDataTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	account, _ := state.NewUserAccount(address)
+	accountBytes, _ := (&mock.MarshalizerFake{}).Marshal(account)
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return &mock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return accountBytes, nil
+				},
+			}, nil
+		},
+	}
+	n := createNodeForProofs(t, accountsTrie, []byte("root hash"))
+
+	proof, err := n.GetAccountKeyProof(createMockPubkeyConverter().Encode(address), "aa", api.AccountQueryOptions{})
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrAccountHasNoDataTrie, err)
+}
+
+func TestNode_GetAccountKeyProofShouldReturnBothProofs(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	dataTrieRootHash := []byte("data trie root hash")
+	address := []byte("12345678901234567890123456789012")
+	key := []byte{0xaa}
+	account, _ := state.NewUserAccount(address)
+	account.SetRootHash(dataTrieRootHash)
+	accountBytes, _ := (&mock.MarshalizerFake{}).Marshal(account)
+
+	numEnter, numExit := 0, 0
+	accountsTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			if bytes.Equal(root, rootHash) {
+				return &mock.TrieStub{
+					GetProofCalled: func(_ []byte) ([][]byte, error) {
+						return [][]byte{[]byte("account leaf")}, nil
+					},
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return accountBytes, nil
+					},
+				}, nil
+			}
+
+			assert.Equal(t, dataTrieRootHash, root)
+			return &mock.TrieStub{
+				GetProofCalled: func(k []byte) ([][]byte, error) {
+					assert.Equal(t, key, k)
+					return [][]byte{[]byte("key leaf")}, nil
+				},
+				GetCalled: func(_ []byte) ([]byte, error) {
+					return []byte("value"), nil
+				},
+			}, nil
+		},
+		EnterPruningBufferingModeCalled: func() {
+			numEnter++
+		},
+		ExitPruningBufferingModeCalled: func() {
+			numExit++
+		},
+	}
+	n := createNodeForProofs(t, accountsTrie, rootHash)
+
+	proof, err := n.GetAccountKeyProof(createMockPubkeyConverter().Encode(address), hex.EncodeToString(key), api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.AccountProof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("account leaf"))}, proof.AccountProof.Proof)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), proof.KeyProof.RootHash)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), proof.KeyProof.Value)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("key leaf"))}, proof.KeyProof.Proof)
+	assert.Equal(t, 1, numEnter)
+	assert.Equal(t, 1, numExit)
+}
+
+func createESDTNFTLeaf(address []byte, tokenIdentifier string, nonce uint64) core.KeyValueHolder {
+	key := core.ESDTNFTTokenKey([]byte(tokenIdentifier), nonce)
+	esdtData := &esdt.ESDigitalToken{
+		Value:         big.NewInt(1),
+		Type:          uint32(esdt.NonFungible),
+		TokenMetaData: &esdt.ESDTokenMetaData{Nonce: nonce},
+	}
+	marshalledData, _ := getMarshalizer().Marshal(esdtData)
+
+	return keyValStorage.NewKeyValStorage(key, append(append(marshalledData, key...), address...))
+}
+
+func TestNode_GetAllESDTNFTsTooManyInstancesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	leaves := make([]core.KeyValueHolder, 0, node.MaxESDTNFTsPerQuery+1)
+	for i := 1; i <= node.MaxESDTNFTsPerQuery; i++ {
+		leaves = append(leaves, createESDTNFTLeaf(address, "NFT-0001", uint64(i)))
+	}
+
+	n := createNodeWithDataTrieLeaves(address, leaves)
+	nfts, err := n.GetAllESDTNFTs(createDummyHexAddress(64), "")
+	assert.Nil(t, err)
+	assert.Equal(t, node.MaxESDTNFTsPerQuery, len(nfts))
+
+	leaves = append(leaves, createESDTNFTLeaf(address, "NFT-0002", 1))
+	n = createNodeWithDataTrieLeaves(address, leaves)
+	nfts, err = n.GetAllESDTNFTs(createDummyHexAddress(64), "")
+	assert.Nil(t, nfts)
+	assert.Equal(t, node.ErrTooManyESDTNFTs, err)
+
+	nfts, err = n.GetAllESDTNFTs(createDummyHexAddress(64), "NFT-0002")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(nfts))
+}
+
+func TestNode_GetAllESDTNFTsTraversalTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	acc, _ := state.NewUserAccount(address)
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder, 1)
+				ch <- createESDTNFTLeaf(address, "NFT-0001", 1)
+
+				return ch, nil
+			},
+		})
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+	n.SetDataTrieTraversalTimeout(time.Millisecond * 10)
+
+	nfts, err := n.GetAllESDTNFTs(createDummyHexAddress(64), "")
+	assert.Nil(t, nfts)
+	assert.Equal(t, node.ErrDataTrieTraversalTimeout, err)
+}
@@ -26,28 +26,54 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlockByRound returns the block committed in the given round
+func (n *Node) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetBlockByRound(round, withTxs)
+}
+
+// GetHyperblockByNonce returns the hyperblock of the metablock with the given nonce
+func (n *Node) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	apiHyperblockProcessor := blockAPI.NewMetaApiBlockProcessor(n.createAPIBlockProcessorArg())
+	return apiHyperblockProcessor.GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the hyperblock of the metablock with the given hash
+func (n *Node) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiHyperblockProcessor := blockAPI.NewMetaApiBlockProcessor(n.createAPIBlockProcessorArg())
+	return apiHyperblockProcessor.GetHyperblockByHash(decodedHash)
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
-		return blockAPI.NewShardApiBlockProcessor(
-			&blockAPI.APIBlockProcessorArg{
-				SelfShardID:              n.shardCoordinator.SelfId(),
-				Store:                    n.store,
-				Marshalizer:              n.internalMarshalizer,
-				Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
-				HistoryRepo:              n.historyRepository,
-				UnmarshalTx:              n.unmarshalTransaction,
-			},
-		)
+		return blockAPI.NewShardApiBlockProcessor(n.createAPIBlockProcessorArg())
 	}
 
-	return blockAPI.NewMetaApiBlockProcessor(
-		&blockAPI.APIBlockProcessorArg{
-			SelfShardID:              n.shardCoordinator.SelfId(),
-			Store:                    n.store,
-			Marshalizer:              n.internalMarshalizer,
-			Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
-			HistoryRepo:              n.historyRepository,
-			UnmarshalTx:              n.unmarshalTransaction,
-		},
-	)
+	return blockAPI.NewMetaApiBlockProcessor(n.createAPIBlockProcessorArg())
+}
+
+func (n *Node) createAPIBlockProcessorArg() *blockAPI.APIBlockProcessorArg {
+	return &blockAPI.APIBlockProcessorArg{
+		SelfShardID:              n.shardCoordinator.SelfId(),
+		Store:                    n.store,
+		Marshalizer:              n.internalMarshalizer,
+		Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
+		HistoryRepo:              n.historyRepository,
+		ChainHandler:             n.blkc,
+		UnmarshalTx:              n.unmarshalTransaction,
+	}
 }
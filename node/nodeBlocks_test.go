@@ -3,13 +3,16 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -263,3 +266,114 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func createNodeWithHeadersInRounds(selfShardID uint32, rounds []uint64) *node.Node {
+	nonceConverter := mock.NewNonceHashConverterMock()
+	marshalizer := &mock.MarshalizerFake{}
+	nonceToHash := make(map[string][]byte)
+	headers := make(map[string][]byte)
+	for nonce, round := range rounds {
+		hash := []byte(fmt.Sprintf("hash%d", nonce))
+		nonceToHash[string(nonceConverter.ToByteSlice(uint64(nonce)))] = hash
+
+		var hdr interface{} = &block.Header{Nonce: uint64(nonce), Round: round, ShardID: selfShardID}
+		if selfShardID == core.MetachainShardId {
+			hdr = &block.MetaBlock{Nonce: uint64(nonce), Round: round}
+		}
+		headers[string(hash)], _ = marshalizer.Marshal(hdr)
+	}
+
+	n, _ := node.NewNode(
+		node.WithUint64ByteSliceConverter(nonceConverter),
+		node.WithInternalMarshalizer(marshalizer, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: selfShardID}),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: uint64(len(rounds) - 1)}
+			},
+		}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				switch unitType {
+				case dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(selfShardID), dataRetriever.MetaHdrNonceHashDataUnit:
+					hash, ok := nonceToHash[string(key)]
+					if !ok {
+						return nil, storage.ErrKeyNotFound
+					}
+					return hash, nil
+				default:
+					hdrBytes, ok := headers[string(key)]
+					if !ok {
+						return nil, storage.ErrKeyNotFound
+					}
+					return hdrBytes, nil
+				}
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestGetBlockByRound_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rounds := []uint64{0, 1, 3, 4, 7, 8, 10}
+	n := createNodeWithHeadersInRounds(0, rounds)
+
+	for nonce, round := range rounds {
+		blk, err := n.GetBlockByRound(round, false)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(nonce), blk.Nonce)
+		assert.Equal(t, round, blk.Round)
+		assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("hash%d", nonce))), blk.Hash)
+	}
+}
+
+func TestGetBlockByRound_RoundWithoutBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithHeadersInRounds(0, []uint64{0, 1, 3, 4, 7})
+
+	for _, round := range []uint64{2, 5, 6, 100} {
+		blk, err := n.GetBlockByRound(round, false)
+		assert.Equal(t, blockAPI.ErrNoBlockForRound, err)
+		assert.Nil(t, blk)
+	}
+}
+
+func TestGetHyperblockByNonce_ShardNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithHeadersInRounds(0, []uint64{0, 1})
+
+	hyperblock, err := n.GetHyperblockByNonce(1)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, hyperblock)
+
+	hyperblock, err = n.GetHyperblockByHash(hex.EncodeToString([]byte("hash1")))
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, hyperblock)
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithHeadersInRounds(core.MetachainShardId, []uint64{0, 2, 5})
+
+	hyperblock, err := n.GetHyperblockByNonce(2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), hyperblock.Nonce)
+	assert.Equal(t, uint64(5), hyperblock.Round)
+	assert.Equal(t, hex.EncodeToString([]byte("hash2")), hyperblock.Hash)
+	assert.Equal(t, 0, len(hyperblock.Transactions))
+
+	hyperblockByHash, err := n.GetHyperblockByHash(hex.EncodeToString([]byte("hash2")))
+	assert.Nil(t, err)
+	assert.Equal(t, hyperblock, hyperblockByHash)
+}
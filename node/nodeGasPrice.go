@@ -0,0 +1,15 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetGasPriceSuggestion returns the low, median and high gas prices suggested for the transactions sent now
+func (n *Node) GetGasPriceSuggestion() (*api.GasPriceSuggestion, error) {
+	if check.IfNil(n.gasPriceOracle) {
+		return nil, ErrNilGasPriceOracle
+	}
+
+	return n.gasPriceOracle.GetGasPriceSuggestion()
+}
@@ -0,0 +1,37 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_GetGasPriceSuggestionWithoutOracleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	suggestion, err := n.GetGasPriceSuggestion()
+	assert.Nil(t, suggestion)
+	assert.Equal(t, node.ErrNilGasPriceOracle, err)
+}
+
+func TestNode_GetGasPriceSuggestionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSuggestion := &api.GasPriceSuggestion{Low: 1, Median: 2, High: 3}
+	n, _ := node.NewNode(
+		node.WithGasPriceOracle(&mock.GasPriceOracleStub{
+			GetGasPriceSuggestionCalled: func() (*api.GasPriceSuggestion, error) {
+				return expectedSuggestion, nil
+			},
+		}),
+	)
+
+	suggestion, err := n.GetGasPriceSuggestion()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSuggestion, suggestion)
+}
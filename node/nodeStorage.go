@@ -0,0 +1,74 @@
+package node
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// GetStorageUsage returns the disk space used by the node's databases, per shard, per epoch and per storage unit,
+// as computed by the last periodic collection
+func (n *Node) GetStorageUsage() (*api.StorageUsage, error) {
+	if check.IfNil(n.storageUsageCollector) {
+		return nil, ErrNilStorageUsageCollector
+	}
+
+	return n.storageUsageCollector.LastUsage()
+}
+
+// CompactStorageUnit starts the compaction of the storage unit with the provided name (e.g. BlockHeaderUnit) in
+// background. Only one compaction can be in progress at a time and the outcome is written in the node's log
+func (n *Node) CompactStorageUnit(unitName string) error {
+	unitType, found := n.getStorageUnitType(unitName)
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownStorageUnit, unitName)
+	}
+
+	storer := n.store.GetStorer(unitType)
+	if check.IfNil(storer) {
+		return fmt.Errorf("%w: %s", ErrUnknownStorageUnit, unitName)
+	}
+	compacter, ok := storer.(storage.Compacter)
+	if !ok {
+		return storage.ErrCompactionNotSupported
+	}
+
+	if !atomic.CompareAndSwapInt32(&n.isCompactingStorage, 0, 1) {
+		return ErrStorageCompactionInProgress
+	}
+
+	go func() {
+		log.Info("storage unit compaction started", "unit", unitName)
+		err := compacter.Compact()
+		atomic.StoreInt32(&n.isCompactingStorage, 0)
+		if err != nil {
+			log.Error("storage unit compaction failed", "unit", unitName, "error", err)
+			return
+		}
+
+		log.Info("storage unit compaction finished", "unit", unitName)
+	}()
+
+	return nil
+}
+
+func (n *Node) getStorageUnitType(unitName string) (dataRetriever.UnitType, bool) {
+	for unitType := dataRetriever.TransactionUnit; unitType <= dataRetriever.TxPoolUnit; unitType++ {
+		if unitType.String() == unitName {
+			return unitType, true
+		}
+	}
+
+	for shardID := uint32(0); shardID < n.shardCoordinator.NumberOfShards(); shardID++ {
+		unitType := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+		if unitType.String() == unitName {
+			return unitType, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,120 @@
+package node_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type compactableStorerStub struct {
+	*mock.StorerStub
+	compactCalled func() error
+}
+
+func (css *compactableStorerStub) Compact() error {
+	return css.compactCalled()
+}
+
+func createNodeWithStore(t *testing.T, store dataRetriever.StorageService) *node.Node {
+	n, err := node.NewNode(
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+		node.WithDataStore(store),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStorageUsageWithoutCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	usage, err := n.GetStorageUsage()
+	assert.Nil(t, usage)
+	assert.Equal(t, node.ErrNilStorageUsageCollector, err)
+}
+
+func TestNode_GetStorageUsageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedUsage := &api.StorageUsage{TotalBytes: 1024}
+	n, _ := node.NewNode(
+		node.WithStorageUsageCollector(&mock.StorageUsageCollectorStub{
+			LastUsageCalled: func() (*api.StorageUsage, error) {
+				return expectedUsage, nil
+			},
+		}),
+	)
+
+	usage, err := n.GetStorageUsage()
+	assert.Nil(t, err)
+	assert.True(t, usage == expectedUsage)
+}
+
+func TestNode_CompactStorageUnitUnknownUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithStore(t, &mock.ChainStorerMock{})
+
+	err := n.CompactStorageUnit("NotAUnit")
+	assert.True(t, errors.Is(err, node.ErrUnknownStorageUnit))
+
+	err = n.CompactStorageUnit("ShardHdrNonceHashDataUnit5")
+	assert.True(t, errors.Is(err, node.ErrUnknownStorageUnit))
+}
+
+func TestNode_CompactStorageUnitNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithStore(t, &mock.ChainStorerMock{})
+
+	err := n.CompactStorageUnit(dataRetriever.BlockHeaderUnit.String())
+	assert.Equal(t, storage.ErrCompactionNotSupported, err)
+}
+
+func TestNode_CompactStorageUnitShouldCompactInBackground(t *testing.T) {
+	t.Parallel()
+
+	numCompactions := int32(0)
+	chanRelease := make(chan struct{})
+	var requestedUnit dataRetriever.UnitType
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnit = unitType
+			return &compactableStorerStub{
+				StorerStub: &mock.StorerStub{},
+				compactCalled: func() error {
+					atomic.AddInt32(&numCompactions, 1)
+					<-chanRelease
+					return nil
+				},
+			}
+		},
+	}
+	n := createNodeWithStore(t, store)
+
+	err := n.CompactStorageUnit("ShardHdrNonceHashDataUnit1")
+	require.Nil(t, err)
+	assert.Equal(t, dataRetriever.ShardHdrNonceHashDataUnit+1, requestedUnit)
+
+	err = n.CompactStorageUnit(dataRetriever.TxHashesByAddressUnit.String())
+	assert.Equal(t, node.ErrStorageCompactionInProgress, err)
+
+	close(chanRelease)
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numCompactions))
+
+	err = n.CompactStorageUnit(dataRetriever.TxHashesByAddressUnit.String())
+	assert.Nil(t, err)
+	assert.Equal(t, dataRetriever.TxHashesByAddressUnit, requestedUnit)
+}
@@ -36,6 +36,54 @@ func (n *Node) GetTransaction(txHash string, withResults bool) (*transaction.Api
 	return n.getTransactionFromStorage(hash)
 }
 
+// GetTransactionStatus gets the transaction status based on the given hash. The status is computed from the
+// transactions pool and, when the db lookup extensions are enabled, from the notarization details of the transaction's miniblock.
+// A failed execution is reported only if it was signaled by the processor in the transaction logs of this node's shard
+func (n *Node) GetTransactionStatus(txHash string) (string, error) {
+	tx, err := n.GetTransaction(txHash, false)
+	if err != nil {
+		return "", err
+	}
+
+	return tx.Status.String(), nil
+}
+
+// GetTransactionsByAddress returns at most maxSize transactions that touched the given address (as sender or receiver),
+// most recent first, after skipping the first "skip" most recent ones. It also returns the total number of transactions
+// recorded for the address. It requires the db lookup extensions, along with the transactions hashes by address index.
+func (n *Node) GetTransactionsByAddress(address string, skip uint64, maxSize uint64) ([]*transaction.ApiTransactionResult, uint64, error) {
+	if !n.historyRepository.IsEnabled() {
+		return nil, 0, ErrDbLookupExtensionsNotEnabled
+	}
+	if maxSize == 0 || maxSize > MaxTransactionsByAddressPageSize {
+		return nil, 0, ErrInvalidPageSize
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries, total, err := n.historyRepository.GetTxHashesByAddress(addressBytes, skip, maxSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txs := make([]*transaction.ApiTransactionResult, 0, len(entries))
+	for _, entry := range entries {
+		tx, errLookup := n.lookupHistoricalTransaction(entry.TxHash, false)
+		if errLookup != nil {
+			log.Debug("GetTransactionsByAddress(): cannot lookup transaction", "txHash", entry.TxHash, "error", errLookup)
+			continue
+		}
+
+		tx.Hash = hex.EncodeToString(entry.TxHash)
+		txs = append(txs, tx)
+	}
+
+	return txs, total, nil
+}
+
 func (n *Node) optionallyGetTransactionFromPool(hash []byte) (*transaction.ApiTransactionResult, error) {
 	txObj, txType, found := n.getTxObjFromDataPool(hash)
 	if !found {
@@ -86,6 +134,7 @@ func (n *Node) lookupHistoricalTransaction(hash []byte, withResults bool) (*tran
 		Receiver:             tx.Tx.GetRcvAddr(),
 		TransactionData:      tx.Data,
 		SelfShard:            n.shardCoordinator.SelfId(),
+		HasSignalError:       n.hasSignalError(hash, miniblockMetadata.Epoch),
 	}).ComputeStatusWhenInStorageKnowingMiniblock()
 
 	if withResults {
@@ -0,0 +1,142 @@
+package node
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// GetTransactionsPool returns the number of transactions (and their size) found in each cache of the transactions pool
+func (n *Node) GetTransactionsPool() (*api.TransactionsPool, error) {
+	if check.IfNil(n.dataPool) {
+		return nil, ErrNilDataPool
+	}
+
+	countsByCache := n.dataPool.Transactions().GetCounts().GetCountsByShard()
+	pool := &api.TransactionsPool{
+		Caches: make([]*api.TransactionsPoolCache, 0, len(countsByCache)),
+	}
+	for cacheID, counts := range countsByCache {
+		pool.NumTxs += counts.Counter
+		pool.NumBytes += counts.SizeInBytes
+		pool.Caches = append(pool.Caches, &api.TransactionsPoolCache{
+			CacheID:  cacheID,
+			NumTxs:   counts.Counter,
+			NumBytes: counts.SizeInBytes,
+		})
+	}
+
+	sort.Slice(pool.Caches, func(i, j int) bool {
+		return pool.Caches[i].CacheID < pool.Caches[j].CacheID
+	})
+
+	return pool, nil
+}
+
+// GetTransactionsPoolForSender returns the pending transactions of the provided sender, along with the nonce gaps
+// computed against the current account nonce
+func (n *Node) GetTransactionsPoolForSender(sender string) (*api.TransactionsPoolForSender, error) {
+	inspector, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+	account, err := n.getAccountInSelfShard(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	accountNonce := account.GetNonce()
+	txs := inspector.GetTransactionsForSender(account.AddressBytes())
+	gaps := inspector.GetNonceGapsForSender(account.AddressBytes(), accountNonce)
+
+	poolForSender := &api.TransactionsPoolForSender{
+		Sender:       sender,
+		AccountNonce: accountNonce,
+		PendingNonce: computePendingNonce(accountNonce, txs),
+		Transactions: make([]*api.PoolTransaction, 0, len(txs)),
+		NonceGaps:    make([]*api.NonceGap, 0, len(gaps)),
+	}
+	for _, tx := range txs {
+		poolForSender.Transactions = append(poolForSender.Transactions, &api.PoolTransaction{
+			Hash:     hex.EncodeToString(tx.TxHash),
+			Nonce:    tx.Tx.GetNonce(),
+			Receiver: n.addressPubkeyConverter.Encode(tx.Tx.GetRcvAddr()),
+			Value:    tx.Tx.GetValue().String(),
+			GasPrice: tx.Tx.GetGasPrice(),
+			GasLimit: tx.Tx.GetGasLimit(),
+			Data:     tx.Tx.GetData(),
+		})
+	}
+	for _, gap := range gaps {
+		poolForSender.NonceGaps = append(poolForSender.NonceGaps, &api.NonceGap{
+			From: gap.From,
+			To:   gap.To,
+		})
+	}
+
+	return poolForSender, nil
+}
+
+// GetPendingNonce returns the nonce to be used by the next transaction of the provided sender, taking into account
+// both the account nonce and the consecutive transactions already waiting in the pool
+func (n *Node) GetPendingNonce(sender string) (uint64, error) {
+	inspector, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return 0, err
+	}
+	account, err := n.getAccountInSelfShard(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	txs := inspector.GetTransactionsForSender(account.AddressBytes())
+
+	return computePendingNonce(account.GetNonce(), txs), nil
+}
+
+func (n *Node) getTransactionsPoolInspector() (TransactionsPoolInspector, error) {
+	if check.IfNil(n.dataPool) {
+		return nil, ErrNilDataPool
+	}
+
+	inspector, ok := n.dataPool.Transactions().(TransactionsPoolInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolInspectionNotSupported
+	}
+
+	return inspector, nil
+}
+
+// getAccountInSelfShard returns the account of the provided address, as the transactions of a sender are indexed
+// only by the pool of the sender's shard
+func (n *Node) getAccountInSelfShard(address string) (state.UserAccountHandler, error) {
+	account, err := n.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if n.shardCoordinator.ComputeId(account.AddressBytes()) != n.shardCoordinator.SelfId() {
+		return nil, ErrDifferentSenderShardId
+	}
+
+	return account, nil
+}
+
+// computePendingNonce walks the transactions, sorted by nonce, and returns the first nonce not covered by them
+func computePendingNonce(accountNonce uint64, txs []*txcache.WrappedTransaction) uint64 {
+	pendingNonce := accountNonce
+	for _, tx := range txs {
+		txNonce := tx.Tx.GetNonce()
+		if txNonce > pendingNonce {
+			break
+		}
+		if txNonce == pendingNonce {
+			pendingNonce++
+		}
+	}
+
+	return pendingNonce
+}
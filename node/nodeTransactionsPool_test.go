@@ -0,0 +1,189 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionsPool(t *testing.T) dataRetriever.ShardedDataCacherNotifier {
+	pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 2,
+		SelfShardID:    0,
+	})
+	require.Nil(t, err)
+
+	return pool
+}
+
+func addTransactionToPool(pool dataRetriever.ShardedDataCacherNotifier, hash string, sender []byte, nonce uint64, cacheID string) {
+	tx := &transaction.Transaction{
+		SndAddr:  sender,
+		RcvAddr:  []byte("receiver"),
+		Nonce:    nonce,
+		Value:    big.NewInt(10),
+		GasPrice: 200000000000,
+		GasLimit: 50000,
+	}
+	pool.AddData([]byte(hash), tx, tx.Size(), cacheID)
+}
+
+func createNodeWithTransactionsPool(
+	t *testing.T,
+	pool dataRetriever.ShardedDataCacherNotifier,
+	accountNonce uint64,
+	shardCoordinator *mock.ShardCoordinatorMock,
+) *node.Node {
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(address)
+			acc.Nonce = accountNonce
+
+			return acc, nil
+		},
+	}
+	dataPool := &testscommon.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return pool
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithDataPool(dataPool),
+		node.WithShardCoordinator(shardCoordinator),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetTransactionsPoolWithoutDataPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	pool, err := n.GetTransactionsPool()
+	assert.Nil(t, pool)
+	assert.Equal(t, node.ErrNilDataPool, err)
+}
+
+func TestNode_GetTransactionsPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pool := createTransactionsPool(t)
+	addTransactionToPool(pool, "hash-1", []byte("alice"), 1, "0")
+	addTransactionToPool(pool, "hash-2", []byte("alice"), 2, "0_1")
+	addTransactionToPool(pool, "hash-3", []byte("bob"), 7, "1_0")
+	n := createNodeWithTransactionsPool(t, pool, 0, &mock.ShardCoordinatorMock{})
+
+	poolInfo, err := n.GetTransactionsPool()
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), poolInfo.NumTxs)
+	require.Len(t, poolInfo.Caches, 2)
+	assert.Equal(t, "0", poolInfo.Caches[0].CacheID)
+	assert.Equal(t, int64(2), poolInfo.Caches[0].NumTxs)
+	assert.Equal(t, "1_0", poolInfo.Caches[1].CacheID)
+	assert.Equal(t, int64(1), poolInfo.Caches[1].NumTxs)
+	assert.Equal(t, poolInfo.NumBytes, poolInfo.Caches[0].NumBytes+poolInfo.Caches[1].NumBytes)
+}
+
+func TestNode_GetTransactionsPoolForSenderNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t, testscommon.NewShardedDataStub(), 0, &mock.ShardCoordinatorMock{})
+
+	poolForSender, err := n.GetTransactionsPoolForSender(createDummyHexAddress(64))
+	assert.Nil(t, poolForSender)
+	assert.Equal(t, node.ErrTransactionsPoolInspectionNotSupported, err)
+}
+
+func TestNode_GetTransactionsPoolForSenderInOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := &mock.ShardCoordinatorMock{
+		ComputeIdCalled: func(_ []byte) uint32 {
+			return 1
+		},
+	}
+	n := createNodeWithTransactionsPool(t, createTransactionsPool(t), 0, shardCoordinator)
+
+	poolForSender, err := n.GetTransactionsPoolForSender(createDummyHexAddress(64))
+	assert.Nil(t, poolForSender)
+	assert.Equal(t, node.ErrDifferentSenderShardId, err)
+
+	nonce, err := n.GetPendingNonce(createDummyHexAddress(64))
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, node.ErrDifferentSenderShardId, err)
+}
+
+func TestNode_GetTransactionsPoolForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	senderHex := createDummyHexAddress(64)
+	sender, _ := hex.DecodeString(senderHex)
+	pool := createTransactionsPool(t)
+	addTransactionToPool(pool, "hash-5", sender, 5, "0")
+	addTransactionToPool(pool, "hash-6", sender, 6, "0_1")
+	addTransactionToPool(pool, "hash-9", sender, 9, "0")
+	n := createNodeWithTransactionsPool(t, pool, 5, &mock.ShardCoordinatorMock{})
+
+	poolForSender, err := n.GetTransactionsPoolForSender(senderHex)
+	require.Nil(t, err)
+	assert.Equal(t, senderHex, poolForSender.Sender)
+	assert.Equal(t, uint64(5), poolForSender.AccountNonce)
+	assert.Equal(t, uint64(7), poolForSender.PendingNonce)
+	require.Len(t, poolForSender.Transactions, 3)
+	assert.Equal(t, hex.EncodeToString([]byte("hash-5")), poolForSender.Transactions[0].Hash)
+	assert.Equal(t, uint64(6), poolForSender.Transactions[1].Nonce)
+	assert.Equal(t, "10", poolForSender.Transactions[2].Value)
+	assert.Equal(t, []*api.NonceGap{{From: 7, To: 8}}, poolForSender.NonceGaps)
+}
+
+func TestNode_GetPendingNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	senderHex := createDummyHexAddress(64)
+	sender, _ := hex.DecodeString(senderHex)
+	pool := createTransactionsPool(t)
+	n := createNodeWithTransactionsPool(t, pool, 3, &mock.ShardCoordinatorMock{})
+
+	nonce, err := n.GetPendingNonce(senderHex)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), nonce)
+
+	addTransactionToPool(pool, "hash-2", sender, 2, "0")
+	addTransactionToPool(pool, "hash-3", sender, 3, "0")
+	addTransactionToPool(pool, "hash-4", sender, 4, "0")
+	addTransactionToPool(pool, "hash-6", sender, 6, "0")
+
+	nonce, err = n.GetPendingNonce(senderHex)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), nonce)
+}
@@ -3,6 +3,9 @@ package node
 import (
 	"encoding/hex"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -10,9 +13,59 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
 
-func (n *Node) putResultsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+// hasSignalError tells whether the processor signaled a failed execution of the transaction, or of one of its
+// results, by saving a signal error event in the transaction logs
+func (n *Node) hasSignalError(hash []byte, epoch uint32) bool {
+	if n.hasSignalErrorLog(hash, epoch) {
+		return true
+	}
+
 	resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+	if err != nil || resultsHashes == nil {
+		return false
+	}
+
+	for _, scrHashesE := range resultsHashes.ScResultsHashesAndEpoch {
+		for _, scrHash := range scrHashesE.ScResultsHashes {
+			if n.hasSignalErrorLog(scrHash, scrHashesE.Epoch) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (n *Node) hasSignalErrorLog(hash []byte, epoch uint32) bool {
+	logsStorer := n.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(logsStorer) {
+		return false
+	}
+
+	logBytes, err := logsStorer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return false
+	}
+
+	txLog := &transaction.Log{}
+	err = n.internalMarshalizer.Unmarshal(txLog, logBytes)
 	if err != nil {
+		log.Debug("hasSignalErrorLog: cannot unmarshal transaction log", "hash", hash, "error", err)
+		return false
+	}
+
+	for _, event := range txLog.Events {
+		if event != nil && string(event.Identifier) == core.SignalErrorOperation {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *Node) putResultsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+	resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+	if err != nil || resultsHashes == nil {
 		return
 	}
 
@@ -126,7 +126,7 @@ func TestNode_GetTransaction_FromStorage(t *testing.T) {
 	require.Equal(t, txA.Nonce, actualA.Nonce)
 	require.Equal(t, txB.Nonce, actualB.Nonce)
 	require.Equal(t, txC.Nonce, actualC.Nonce)
-	require.Equal(t, transaction.TxStatusPending, actualA.Status)
+	require.Equal(t, transaction.TxStatusPartiallyExecuted, actualA.Status)
 	require.Equal(t, transaction.TxStatusSuccess, actualB.Status)
 	require.Equal(t, transaction.TxStatusSuccess, actualC.Status)
 
@@ -162,7 +162,7 @@ func TestNode_GetTransaction_FromStorage(t *testing.T) {
 	require.Equal(t, txE.GasLimit, actualE.GasLimit)
 	require.Equal(t, txF.GasLimit, actualF.GasLimit)
 	require.Equal(t, txG.GasLimit, actualG.GasLimit)
-	require.Equal(t, transaction.TxStatusPending, actualE.Status)
+	require.Equal(t, transaction.TxStatusPartiallyExecuted, actualE.Status)
 	require.Equal(t, transaction.TxStatusSuccess, actualF.Status)
 	require.Equal(t, transaction.TxStatusSuccess, actualG.Status)
 
@@ -275,7 +275,7 @@ func TestNode_lookupHistoricalTransaction(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, txA.Nonce, actualA.Nonce)
 	require.Equal(t, 42, int(actualA.Epoch))
-	require.Equal(t, transaction.TxStatusPending, actualA.Status)
+	require.Equal(t, transaction.TxStatusPartiallyExecuted, actualA.Status)
 
 	// Cross-shard, we are destination
 	txB := &transaction.Transaction{Nonce: 7, SndAddr: []byte("bob"), RcvAddr: []byte("alice")}
@@ -335,7 +335,7 @@ func TestNode_lookupHistoricalTransaction(t *testing.T) {
 	require.Equal(t, 42, int(actualE.Epoch))
 	require.Equal(t, txE.GasLimit, actualE.GasLimit)
 	require.Equal(t, string(transaction.TxTypeUnsigned), actualE.Type)
-	require.Equal(t, transaction.TxStatusPending, actualE.Status)
+	require.Equal(t, transaction.TxStatusPartiallyExecuted, actualE.Status)
 
 	// Cross-shard, we are destination
 	txF := &smartContractResult.SmartContractResult{GasLimit: 15, SndAddr: []byte("bob"), RcvAddr: []byte("alice")}
@@ -412,6 +412,107 @@ func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	require.Equal(t, "0c", tx.NotarizedAtDestinationInMetaHash)
 }
 
+func TestNode_GetTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, dataPool, historyRepo := createNode(t, 42, true)
+
+	// In pool
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	dataPool.Transactions().AddData([]byte("a"), txA, 42, "1")
+
+	status, err := n.GetTransactionStatus(hex.EncodeToString([]byte("a")))
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusPending.String(), status)
+
+	// Intra-shard, executed with a smart contract result carrying a return message, but no signaled error
+	txB := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("b"), txB, n.internalMarshalizer)
+	scr := &smartContractResult.SmartContractResult{OriginalTxHash: []byte("b"), ReturnMessage: []byte("gas refund for relayer")}
+	_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr"), scr, n.internalMarshalizer)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 42)
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		return &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr")}},
+			},
+		}, nil
+	}
+
+	status, err = n.GetTransactionStatus(hex.EncodeToString([]byte("b")))
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess.String(), status)
+
+	// Intra-shard, with an error signaled in the logs of one of its results
+	signalErrorLog := &transaction.Log{
+		Events: []*transaction.Event{{Identifier: []byte(core.SignalErrorOperation)}},
+	}
+	_ = chainStorer.Logs.PutWithMarshalizer([]byte("scr"), signalErrorLog, n.internalMarshalizer)
+
+	status, err = n.GetTransactionStatus(hex.EncodeToString([]byte("b")))
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusFail.String(), status)
+
+	// Missing transaction
+	_, err = n.GetTransactionStatus(hex.EncodeToString([]byte("missing")))
+	require.Error(t, err)
+}
+
+func TestNode_GetTransactionsByAddress_DbLookupExtensionsNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, false)
+
+	txs, _, err := n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, 10)
+	require.Nil(t, txs)
+	require.Equal(t, ErrDbLookupExtensionsNotEnabled, err)
+}
+
+func TestNode_GetTransactionsByAddress_InvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, true)
+
+	_, _, err := n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, 0)
+	require.Equal(t, ErrInvalidPageSize, err)
+
+	_, _, err = n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, MaxTransactionsByAddressPageSize+1)
+	require.Equal(t, ErrInvalidPageSize, err)
+}
+
+func TestNode_GetTransactionsByAddress_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 0, true)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+	txB := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("b"), txB, n.internalMarshalizer)
+
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 2, 0)
+	historyRepo.GetTxHashesByAddressCalled = func(address []byte, skip uint64, maxSize uint64) ([]*dblookupext.TxHashByAddressEntry, uint64, error) {
+		require.Equal(t, []byte("alice"), address)
+		require.Equal(t, uint64(3), skip)
+		require.Equal(t, uint64(10), maxSize)
+
+		return []*dblookupext.TxHashByAddressEntry{
+			{TxHash: []byte("b")},
+			{TxHash: []byte("a")},
+			{TxHash: []byte("missing")},
+		}, 5, nil
+	}
+
+	txs, total, err := n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 3, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Len(t, txs, 2)
+	require.Equal(t, hex.EncodeToString([]byte("b")), txs[0].Hash)
+	require.Equal(t, txB.Nonce, txs[0].Nonce)
+	require.Equal(t, hex.EncodeToString([]byte("a")), txs[1].Hash)
+	require.Equal(t, txA.Nonce, txs[1].Nonce)
+}
+
 func createNode(t *testing.T, epoch uint32, withDbLookupExt bool) (*Node, *genericmocks.ChainStorerMock, *testscommon.PoolsHolderMock, *testscommon.HistoryRepositoryStub) {
 	chainStorer := genericmocks.NewChainStorerMock(epoch)
 	dataPool := testscommon.NewPoolsHolderMock()
@@ -286,6 +286,79 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 	assert.Equal(t, esdtToken, value[0])
 }
 
+func TestNode_GetAllESDTNFTs(t *testing.T) {
+	address := []byte("newaddress")
+	acc, _ := state.NewUserAccount(address)
+
+	createLeaf := func(tokenIdentifier string, nonce uint64) core.KeyValueHolder {
+		key := core.ESDTNFTTokenKey([]byte(tokenIdentifier), nonce)
+		esdtData := &esdt.ESDigitalToken{
+			Value: big.NewInt(10),
+			Type:  uint32(esdt.SemiFungible),
+			TokenMetaData: &esdt.ESDTokenMetaData{
+				Nonce:   nonce,
+				Name:    []byte("name"),
+				Creator: []byte("creator"),
+				Hash:    []byte("hash"),
+				URIs:    [][]byte{[]byte("uri")},
+			},
+		}
+		marshalledData, _ := getMarshalizer().Marshal(esdtData)
+		value := append(append(marshalledData, key...), address...)
+
+		return keyValStorage.NewKeyValStorage(key, value)
+	}
+	fungibleKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "fungible")
+	leaves := []core.KeyValueHolder{
+		createLeaf("nftToken", 1),
+		createLeaf("nftTokenB", 2),
+		keyValStorage.NewKeyValStorage(fungibleKey, []byte("data")),
+	}
+
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder)
+
+				go func() {
+					for _, leaf := range leaves {
+						ch <- leaf
+					}
+					close(ch)
+				}()
+
+				return ch, nil
+			},
+		})
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	nfts, err := n.GetAllESDTNFTs(createDummyHexAddress(64), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(nfts))
+
+	nfts, err = n.GetAllESDTNFTs(createDummyHexAddress(64), "nftToken")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(nfts))
+	assert.Equal(t, "nftToken", nfts[0].TokenIdentifier)
+	assert.Equal(t, uint64(1), nfts[0].Nonce)
+	assert.Equal(t, "10", nfts[0].Balance)
+	assert.Equal(t, core.SemiFungibleESDT, nfts[0].Type)
+	assert.Equal(t, "name", nfts[0].Name)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), nfts[0].Hash)
+	assert.Equal(t, []string{"uri"}, nfts[0].URIs)
+}
+
 //------- GenerateTransaction
 
 func TestGenerateTransaction_NoAddrConverterShouldError(t *testing.T) {
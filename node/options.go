@@ -105,6 +105,18 @@ func WithAccountsAdapter(accounts state.AccountsAdapter) Option {
 	}
 }
 
+// WithAccountsTrie sets up the user accounts trie option for the Node. It is used to read the accounts from a
+// past state
+func WithAccountsTrie(accountsTrie data.Trie) Option {
+	return func(n *Node) error {
+		if check.IfNil(accountsTrie) {
+			return ErrNilAccountsTrie
+		}
+		n.accountsTrie = accountsTrie
+		return nil
+	}
+}
+
 // WithAddressPubkeyConverter sets up the address public key converter adapter option for the Node
 func WithAddressPubkeyConverter(pubkeyConverter core.PubkeyConverter) Option {
 	return func(n *Node) error {
@@ -791,3 +803,25 @@ func WithImportMode(importMode bool) Option {
 		return nil
 	}
 }
+
+// WithStorageUsageCollector sets up the storage usage collector for the node
+func WithStorageUsageCollector(storageUsageCollector StorageUsageCollector) Option {
+	return func(n *Node) error {
+		if check.IfNil(storageUsageCollector) {
+			return ErrNilStorageUsageCollector
+		}
+		n.storageUsageCollector = storageUsageCollector
+		return nil
+	}
+}
+
+// WithGasPriceOracle sets up the gas price oracle for the node
+func WithGasPriceOracle(gasPriceOracle GasPriceOracle) Option {
+	return func(n *Node) error {
+		if check.IfNil(gasPriceOracle) {
+			return ErrNilGasPriceOracle
+		}
+		n.gasPriceOracle = gasPriceOracle
+		return nil
+	}
+}
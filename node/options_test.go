@@ -1509,3 +1509,81 @@ func TestWithTxVersionChecker_OkTxVersionCheckerShoulWork(t *testing.T) {
 	assert.Equal(t, txVersionChecker, node.txVersionChecker)
 	assert.Nil(t, err)
 }
+
+func TestWithAccountsTrie_NilAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAccountsTrie(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.accountsTrie)
+	assert.Equal(t, ErrNilAccountsTrie, err)
+}
+
+func TestWithAccountsTrie_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	accountsTrie := &mock.TrieStub{}
+
+	opt := WithAccountsTrie(accountsTrie)
+	err := opt(node)
+
+	assert.True(t, node.accountsTrie == accountsTrie)
+	assert.Nil(t, err)
+}
+
+func TestWithStorageUsageCollector_NilCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithStorageUsageCollector(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.storageUsageCollector)
+	assert.Equal(t, ErrNilStorageUsageCollector, err)
+}
+
+func TestWithStorageUsageCollector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	collector := &mock.StorageUsageCollectorStub{}
+
+	opt := WithStorageUsageCollector(collector)
+	err := opt(node)
+
+	assert.True(t, node.storageUsageCollector == collector)
+	assert.Nil(t, err)
+}
+
+func TestWithGasPriceOracle_NilOracleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithGasPriceOracle(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.gasPriceOracle)
+	assert.Equal(t, ErrNilGasPriceOracle, err)
+}
+
+func TestWithGasPriceOracle_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	oracle := &mock.GasPriceOracleStub{}
+
+	opt := WithGasPriceOracle(oracle)
+	err := opt(node)
+
+	assert.True(t, node.gasPriceOracle == oracle)
+	assert.Nil(t, err)
+}
@@ -42,6 +42,7 @@ type ArgBaseProcessor struct {
 	Indexer                 indexer.Indexer
 	TpsBenchmark            statistics.TPSBenchmark
 	HistoryRepository       dblookupext.HistoryRepository
+	EventsNotifier          process.EventsNotifier
 	EpochNotifier           process.EpochNotifier
 	HeaderIntegrityVerifier process.HeaderIntegrityVerifier
 }
@@ -76,10 +76,11 @@ type baseProcessor struct {
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
 
-	indexer       indexer.Indexer
-	tpsBenchmark  statistics.TPSBenchmark
-	historyRepo   dblookupext.HistoryRepository
-	epochNotifier process.EpochNotifier
+	indexer        indexer.Indexer
+	tpsBenchmark   statistics.TPSBenchmark
+	historyRepo    dblookupext.HistoryRepository
+	eventsNotifier process.EventsNotifier
+	epochNotifier  process.EpochNotifier
 }
 
 type bootStorerDataArgs struct {
@@ -421,6 +422,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.HistoryRepository) {
 		return process.ErrNilHistoryRepository
 	}
+	if check.IfNil(arguments.EventsNotifier) {
+		return process.ErrNilEventsNotifier
+	}
 	if check.IfNil(arguments.HeaderIntegrityVerifier) {
 		return process.ErrNilHeaderIntegrityVerifier
 	}
@@ -1232,15 +1236,57 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	for _, blockType := range []block.Type{block.RewardsBlock, block.InvalidBlock} {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txsFromPool[txHash] = tx
+		}
+	}
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
 }
 
+// notifyCommittedBlock gathers the transactions of the committed block only if someone listens: all of them for the
+// events subscribers, only the user transactions if there are just registered handlers
+func (bp *baseProcessor) notifyCommittedBlock(headerHash []byte, header data.HeaderHandler) {
+	hasSubscribers := bp.eventsNotifier.HasSubscribers()
+	if !hasSubscribers && !bp.eventsNotifier.HasHandlers() {
+		return
+	}
+
+	blockTypes := []block.Type{block.TxBlock}
+	if hasSubscribers {
+		blockTypes = []block.Type{block.TxBlock, block.RewardsBlock, block.SmartContractResultBlock, block.InvalidBlock}
+	}
+
+	txs := make(map[string]data.TransactionHandler)
+	for _, blockType := range blockTypes {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txs[txHash] = tx
+		}
+	}
+
+	bp.eventsNotifier.NotifyCommittedBlock(headerHash, header, txs)
+}
+
+// notifyRevertedBlock signals the history repository and the events notifier that a committed block was reverted,
+// so that the components which recorded or tracked its data can discard it
+func (bp *baseProcessor) notifyRevertedBlock(header data.HeaderHandler) {
+	headerHash, err := core.CalculateHash(bp.marshalizer, bp.hasher, header)
+	if err != nil {
+		log.Debug("baseProcessor.notifyRevertedBlock", "error", err.Error())
+		return
+	}
+
+	bp.historyRepo.RevertBlock(headerHash)
+	bp.eventsNotifier.NotifyRevertedBlock(headerHash, header)
+}
+
 func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	headersPool := bp.dataPool.Headers()
 	headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
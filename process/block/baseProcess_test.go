@@ -316,6 +316,7 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			EventsNotifier:          &testscommon.EventsNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
@@ -1205,3 +1206,46 @@ func TestAddHeaderIntoTrackerPool_ShouldWork(t *testing.T) {
 	sp.AddHeaderIntoTrackerPool(nonce, shardID)
 	assert.True(t, wasCalled)
 }
+
+func TestBaseProcessor_NotifyCommittedBlockShouldGatherOnlyTheTxsNeededByTheListeners(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	gatheredBlockTypes := make([]block.Type, 0)
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			gatheredBlockTypes = append(gatheredBlockTypes, blockType)
+			return map[string]data.TransactionHandler{"tx": &transaction.Transaction{}}
+		},
+	}
+	notified := false
+	hasSubscribers := false
+	hasHandlers := false
+	arguments.EventsNotifier = &testscommon.EventsNotifierStub{
+		HasSubscribersCalled: func() bool {
+			return hasSubscribers
+		},
+		HasHandlersCalled: func() bool {
+			return hasHandlers
+		},
+		NotifyCommittedBlockCalled: func(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+			notified = true
+			assert.Equal(t, 1, len(txs))
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	bp.NotifyCommittedBlock([]byte("hash"), &block.Header{})
+	assert.Equal(t, 0, len(gatheredBlockTypes))
+	assert.False(t, notified)
+
+	hasHandlers = true
+	bp.NotifyCommittedBlock([]byte("hash"), &block.Header{})
+	assert.Equal(t, []block.Type{block.TxBlock}, gatheredBlockTypes)
+	assert.True(t, notified)
+
+	gatheredBlockTypes = gatheredBlockTypes[:0]
+	hasSubscribers = true
+	bp.NotifyCommittedBlock([]byte("hash"), &block.Header{})
+	assert.Equal(t, 4, len(gatheredBlockTypes))
+}
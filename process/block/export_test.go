@@ -107,6 +107,7 @@ func NewShardProcessorEmptyWith3shards(
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			EventsNotifier:          &testscommon.EventsNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
@@ -369,3 +370,7 @@ func (mp *metaProcessor) RequestShardHeadersIfNeeded(hdrsAddedForShard map[uint3
 func (bp *baseProcessor) AddHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	bp.addHeaderIntoTrackerPool(nonce, shardID)
 }
+
+func (bp *baseProcessor) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler) {
+	bp.notifyCommittedBlock(headerHash, header)
+}
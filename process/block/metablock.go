@@ -108,6 +108,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		genesisNonce:            genesisHdr.GetNonce(),
 		headerIntegrityVerifier: arguments.HeaderIntegrityVerifier,
 		historyRepo:             arguments.HistoryRepository,
+		eventsNotifier:          arguments.EventsNotifier,
 		epochNotifier:           arguments.EpochNotifier,
 	}
 
@@ -664,6 +665,8 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
+	mp.notifyRevertedBlock(headerHandler)
+
 	return nil
 }
 
@@ -1200,6 +1203,7 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
 	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	mp.notifyCommittedBlock(headerHash, headerHandler)
 
 	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
 	saveMetricsForCommitMetachainBlock(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator, highestFinalBlockNonce)
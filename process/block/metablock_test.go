@@ -79,6 +79,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			EventsNotifier:          &testscommon.EventsNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
@@ -2963,6 +2963,20 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	arguments.Hasher = hasherMock
 	arguments.Marshalizer = marshalizerMock
 	arguments.TxCoordinator = tc
+	var revertedHeaderHash []byte
+	var revertedHeader data.HeaderHandler
+	arguments.EventsNotifier = &testscommon.EventsNotifierStub{
+		NotifyRevertedBlockCalled: func(headerHash []byte, header data.HeaderHandler) {
+			revertedHeaderHash = headerHash
+			revertedHeader = header
+		},
+	}
+	var revertedHistoryHeaderHash []byte
+	arguments.HistoryRepository = &testscommon.HistoryRepositoryStub{
+		RevertBlockCalled: func(blockHeaderHash []byte) {
+			revertedHistoryHeaderHash = blockHeaderHash
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	txHashes := make([][]byte, 0)
@@ -3000,8 +3014,12 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		ReceiverShardID: miniblock.ReceiverShardID,
 	}
 
-	err = sp.RestoreBlockIntoPools(&block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}, body)
+	header := &block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}
+	err = sp.RestoreBlockIntoPools(header, body)
 	assert.Nil(t, err)
+	assert.Equal(t, miniblockHash, revertedHeaderHash)
+	assert.Equal(t, header, revertedHeader)
+	assert.Equal(t, miniblockHash, revertedHistoryHeaderHash)
 
 	miniblockFromPool, _ := datapool.MiniBlocks().Get(miniblockHash)
 	txFromPool, _ := datapool.Transactions().SearchFirstData(txHash)
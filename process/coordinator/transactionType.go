@@ -4,29 +4,38 @@ import (
 	"bytes"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 var _ process.TxTypeHandler = (*txTypeHandler)(nil)
 
 type txTypeHandler struct {
-	pubkeyConv       core.PubkeyConverter
-	shardCoordinator sharding.Coordinator
-	builtInFuncNames map[string]struct{}
-	argumentParser   process.CallArgumentsParser
+	pubkeyConv                   core.PubkeyConverter
+	shardCoordinator             sharding.Coordinator
+	builtInFuncNames             map[string]struct{}
+	argumentParser               process.CallArgumentsParser
+	esdtMultiTransferEnableEpoch uint32
+	esdtNFTEnableEpoch           uint32
+	flagESDTMultiTransfer        atomic.Flag
+	flagESDTNFT                  atomic.Flag
 }
 
 // ArgNewTxTypeHandler defines the arguments needed to create a new tx type handler
 type ArgNewTxTypeHandler struct {
-	PubkeyConverter  core.PubkeyConverter
-	ShardCoordinator sharding.Coordinator
-	BuiltInFuncNames map[string]struct{}
-	ArgumentParser   process.CallArgumentsParser
+	PubkeyConverter              core.PubkeyConverter
+	ShardCoordinator             sharding.Coordinator
+	BuiltInFuncNames             map[string]struct{}
+	ArgumentParser               process.CallArgumentsParser
+	EpochNotifier                process.EpochNotifier
+	ESDTMultiTransferEnableEpoch uint32
+	ESDTNFTEnableEpoch           uint32
 }
 
 // NewTxTypeHandler creates a transaction type handler
@@ -45,14 +54,21 @@ func NewTxTypeHandler(
 	if args.BuiltInFuncNames == nil {
 		return nil, process.ErrNilBuiltInFunction
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	tc := &txTypeHandler{
-		pubkeyConv:       args.PubkeyConverter,
-		shardCoordinator: args.ShardCoordinator,
-		argumentParser:   args.ArgumentParser,
-		builtInFuncNames: args.BuiltInFuncNames,
+		pubkeyConv:                   args.PubkeyConverter,
+		shardCoordinator:             args.ShardCoordinator,
+		argumentParser:               args.ArgumentParser,
+		builtInFuncNames:             args.BuiltInFuncNames,
+		esdtMultiTransferEnableEpoch: args.ESDTMultiTransferEnableEpoch,
+		esdtNFTEnableEpoch:           args.ESDTNFTEnableEpoch,
 	}
 
+	args.EpochNotifier.RegisterNotifyHandler(tc)
+
 	return tc, nil
 }
 
@@ -122,6 +138,10 @@ func (tth *txTypeHandler) isSCCallAfterBuiltIn(function string, args [][]byte, t
 	if !core.IsSmartContractAddress(tx.GetRcvAddr()) {
 		return false
 	}
+	if function == core.BuiltInFunctionESDTMultiTransfer {
+		numTransferArgs := builtInFunctions.ComputeESDTMultiTransferNumArgs(args)
+		return numTransferArgs > 0 && len(args) > numTransferArgs
+	}
 	if len(args) <= 2 {
 		return false
 	}
@@ -149,11 +169,32 @@ func (tth *txTypeHandler) isBuiltInFunctionCall(functionName string) bool {
 	if len(tth.builtInFuncNames) == 0 {
 		return false
 	}
+	if !tth.isBuiltInFunctionActive(functionName) {
+		return false
+	}
 
 	_, ok := tth.builtInFuncNames[functionName]
 	return ok
 }
 
+// isBuiltInFunctionActive returns false for the epoch gated built in functions before their activation, so that
+// the transactions calling them keep the type they had before the functions were registered
+func (tth *txTypeHandler) isBuiltInFunctionActive(functionName string) bool {
+	switch functionName {
+	case core.BuiltInFunctionESDTMultiTransfer:
+		return tth.flagESDTMultiTransfer.IsSet()
+	case core.BuiltInFunctionSetESDTRole,
+		core.BuiltInFunctionUnSetESDTRole,
+		core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity,
+		core.BuiltInFunctionESDTNFTBurn,
+		core.BuiltInFunctionESDTNFTTransfer:
+		return tth.flagESDTNFT.IsSet()
+	default:
+		return true
+	}
+}
+
 func (tth *txTypeHandler) isRelayedTransaction(functionName string) bool {
 	return functionName == core.RelayedTransaction
 }
@@ -182,6 +223,15 @@ func (tth *txTypeHandler) checkTxValidity(tx data.TransactionHandler) error {
 	return nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (tth *txTypeHandler) EpochConfirmed(epoch uint32) {
+	tth.flagESDTMultiTransfer.Toggle(epoch >= tth.esdtMultiTransferEnableEpoch)
+	log.Debug("txTypeHandler: ESDT multi transfer", "enabled", tth.flagESDTMultiTransfer.IsSet())
+
+	tth.flagESDTNFT.Toggle(epoch >= tth.esdtNFTEnableEpoch)
+	log.Debug("txTypeHandler: ESDT NFT", "enabled", tth.flagESDTNFT.IsSet())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tth *txTypeHandler) IsInterfaceNil() bool {
 	return tth == nil
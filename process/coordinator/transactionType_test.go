@@ -20,6 +20,7 @@ func createMockArguments() ArgNewTxTypeHandler {
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
 		BuiltInFuncNames: make(map[string]struct{}),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 }
 
@@ -71,6 +72,17 @@ func TestNewTxTypeHandler_NilBuiltInFuncs(t *testing.T) {
 	assert.Equal(t, process.ErrNilBuiltInFunction, err)
 }
 
+func TestNewTxTypeHandler_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.EpochNotifier = nil
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.Nil(t, tth)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
 func TestNewTxTypeHandler_ValsOk(t *testing.T) {
 	t.Parallel()
 
@@ -254,6 +266,112 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeESDTMultiTransferWithSCCall(t *testing.T) {
+	t.Parallel()
+
+	scAddress := make([]byte, 32)
+	scAddress[10] = 1
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = scAddress
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	arg.BuiltInFuncNames[core.BuiltInFunctionESDTMultiTransfer] = struct{}{}
+	tth, _ := NewTxTypeHandler(arg)
+
+	tx.Data = []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@746f6b656e@0a")
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
+
+	tx.Data = []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@746f6b656e@0a@6465706f736974")
+	txTypeIn, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.SCInvoking, txTypeCross)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeESDTMultiTransferBeforeActivation(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@746f6b656e@0a")
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	arg.BuiltInFuncNames[core.BuiltInFunctionESDTMultiTransfer] = struct{}{}
+	arg.ESDTMultiTransferEnableEpoch = 1
+	tth, _ := NewTxTypeHandler(arg)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txTypeIn)
+	assert.Equal(t, process.MoveBalance, txTypeCross)
+
+	tth.EpochConfirmed(1)
+	txTypeIn, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeESDTNFTBeforeActivation(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	nftFunctions := []string{
+		core.BuiltInFunctionSetESDTRole,
+		core.BuiltInFunctionUnSetESDTRole,
+		core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity,
+		core.BuiltInFunctionESDTNFTBurn,
+		core.BuiltInFunctionESDTNFTTransfer,
+	}
+	for _, function := range nftFunctions {
+		arg.BuiltInFuncNames[function] = struct{}{}
+	}
+	arg.ESDTNFTEnableEpoch = 1
+	tth, _ := NewTxTypeHandler(arg)
+
+	for _, function := range nftFunctions {
+		tx.Data = []byte(function + "@746f6b656e")
+		txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+		assert.Equal(t, process.MoveBalance, txTypeIn, function)
+		assert.Equal(t, process.MoveBalance, txTypeCross, function)
+	}
+
+	tth.EpochConfirmed(1)
+	for _, function := range nftFunctions {
+		tx.Data = []byte(function + "@746f6b656e")
+		txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+		assert.Equal(t, process.BuiltInFunctionCall, txTypeIn, function)
+		assert.Equal(t, process.BuiltInFunctionCall, txTypeCross, function)
+	}
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeRelayedFunc(t *testing.T) {
 	t.Parallel()
 
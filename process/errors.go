@@ -725,6 +725,9 @@ var ErrNilBlockSizeThrottler = errors.New("block size throttler is nil")
 // ErrNilHistoryRepository signals that history processor is nil
 var ErrNilHistoryRepository = errors.New("history repository is nil")
 
+// ErrNilEventsNotifier signals that a nil events notifier has been provided
+var ErrNilEventsNotifier = errors.New("nil events notifier")
+
 // ErrInvalidMetaTransaction signals that meta transaction is invalid
 var ErrInvalidMetaTransaction = errors.New("meta transaction is invalid")
 
@@ -940,3 +943,39 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
+
+// ErrHistoricalQueriesNotSupported signals that the query service is not able to execute queries against a past state
+var ErrHistoricalQueriesNotSupported = errors.New("queries against a past state are not supported")
+
+// ErrInvalidHistoricalQuery signals that more than one of block nonce, block hash or root hash was provided in a query
+var ErrInvalidHistoricalQuery = errors.New("only one of block nonce, block hash or root hash can be provided")
+
+// ErrStateNotAvailable signals that the requested state is no longer available in storage, most probably pruned
+var ErrStateNotAvailable = errors.New("state is not available, it was probably pruned")
+
+// ErrBuiltInFunctionIsNotActive signals that the called built in function is not yet active
+var ErrBuiltInFunctionIsNotActive = errors.New("built in function is not active")
+
+// ErrActionNotAllowed signals that action is not allowed
+var ErrActionNotAllowed = errors.New("action is not allowed")
+
+// ErrNFTTokenDoesNotExist signals that the NFT token does not exist
+var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+
+// ErrNFTDoesNotHaveMetadata signals that the NFT data does not have metadata
+var ErrNFTDoesNotHaveMetadata = errors.New("NFT does not have metadata")
+
+// ErrInvalidNFTQuantity signals that invalid NFT quantity was provided
+var ErrInvalidNFTQuantity = errors.New("invalid NFT quantity")
+
+// ErrInvalidNFTTokenType signals that the token type is not a non fungible or a semi fungible one
+var ErrInvalidNFTTokenType = errors.New("invalid NFT token type")
+
+// ErrWrongNFTOnDestination signals that a different NFT than the transferred one was found on destination
+var ErrWrongNFTOnDestination = errors.New("wrong NFT on destination")
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNoHeaderForRootHash signals that no recent header was committed on the provided root hash
+var ErrNoHeaderForRootHash = errors.New("no recent header found for the provided root hash")
@@ -37,6 +37,7 @@ type vmContainerFactory struct {
 	systemSCConfig         *config.SystemSmartContractsConfig
 	epochNotifier          process.EpochNotifier
 	addressPubKeyConverter core.PubkeyConverter
+	esdtNFTEnableEpoch     uint32
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
@@ -52,6 +53,7 @@ type ArgsNewVMContainerFactory struct {
 	ValidatorAccountsDB state.AccountsAdapter
 	ChanceComputer      sharding.ChanceComputer
 	EpochNotifier       process.EpochNotifier
+	ESDTNFTEnableEpoch  uint32
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -107,6 +109,7 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 		chanceComputer:         args.ChanceComputer,
 		epochNotifier:          args.EpochNotifier,
 		addressPubKeyConverter: args.ArgBlockChainHook.PubkeyConv,
+		esdtNFTEnableEpoch:     args.ESDTNFTEnableEpoch,
 	}, nil
 }
 
@@ -171,6 +174,7 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		Economics:              vmf.economics,
 		EpochNotifier:          vmf.epochNotifier,
 		AddressPubKeyConverter: vmf.addressPubKeyConverter,
+		ESDTNFTEnableEpoch:     vmf.esdtNFTEnableEpoch,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
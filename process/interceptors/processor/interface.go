@@ -21,3 +21,8 @@ type InterceptedTransactionHandler interface {
 type ShardedPool interface {
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheID string)
 }
+
+// ShardedPoolWithReplacement is a perspective of the sharded data pool able to replace the pending same-nonce data
+type ShardedPoolWithReplacement interface {
+	AddDataWithReplacement(key []byte, data interface{}, sizeInBytes int, cacheID string)
+}
@@ -11,8 +11,9 @@ var _ process.InterceptorProcessor = (*TxInterceptorProcessor)(nil)
 // TxInterceptorProcessor is the processor used when intercepting transactions
 // (smart contract results, receipts, transaction) structs which satisfy TransactionHandler interface.
 type TxInterceptorProcessor struct {
-	shardedPool ShardedPool
-	txValidator process.TxValidator
+	shardedPool      ShardedPool
+	txValidator      process.TxValidator
+	whiteListHandler process.WhiteListHandler
 }
 
 // NewTxInterceptorProcessor creates a new TxInterceptorProcessor instance
@@ -26,10 +27,14 @@ func NewTxInterceptorProcessor(argument *ArgTxInterceptorProcessor) (*TxIntercep
 	if check.IfNil(argument.TxValidator) {
 		return nil, process.ErrNilTxValidator
 	}
+	if check.IfNil(argument.WhiteListHandler) {
+		return nil, process.ErrNilWhiteListHandler
+	}
 
 	return &TxInterceptorProcessor{
-		shardedPool: argument.ShardedDataCache,
-		txValidator: argument.TxValidator,
+		shardedPool:      argument.ShardedDataCache,
+		txValidator:      argument.TxValidator,
+		whiteListHandler: argument.WhiteListHandler,
 	}, nil
 }
 
@@ -63,6 +68,20 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, _ core.Pe
 	}
 
 	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	poolWithReplacement, ok := txip.shardedPool.(ShardedPoolWithReplacement)
+	// the requested transactions are needed for processing, so they should never be rejected in favor of the pending
+	// transactions with the same sender and nonce
+	isRequested := txip.whiteListHandler.IsWhiteListed(data)
+	if ok && !isRequested {
+		poolWithReplacement.AddDataWithReplacement(
+			data.Hash(),
+			interceptedTx.Transaction(),
+			interceptedTx.Transaction().Size(),
+			cacherIdentifier,
+		)
+		return nil
+	}
+
 	txip.shardedPool.AddData(
 		data.Hash(),
 		interceptedTx.Transaction(),
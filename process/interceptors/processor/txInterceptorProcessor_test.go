@@ -19,6 +19,42 @@ func createMockTxArgument() *processor.ArgTxInterceptorProcessor {
 	return &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: testscommon.NewShardedDataStub(),
 		TxValidator:      &mock.TxValidatorStub{},
+		WhiteListHandler: &mock.WhiteListHandlerStub{},
+	}
+}
+
+type shardedDataWithReplacementStub struct {
+	*testscommon.ShardedDataStub
+	AddDataWithReplacementCalled func(key []byte, data interface{}, sizeInBytes int, cacheId string)
+}
+
+func (stub *shardedDataWithReplacementStub) AddDataWithReplacement(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+	if stub.AddDataWithReplacementCalled != nil {
+		stub.AddDataWithReplacementCalled(key, data, sizeInBytes, cacheId)
+	}
+}
+
+func createInterceptedTxToSave() process.InterceptedData {
+	return &struct {
+		mock.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return make([]byte, 0)
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
 	}
 }
 
@@ -53,6 +89,17 @@ func TestNewTxInterceptorProcessor_NilTxValidatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxValidator, err)
 }
 
+func TestNewTxInterceptorProcessor_NilWhiteListHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTxArgument()
+	arg.WhiteListHandler = nil
+	txip, err := processor.NewTxInterceptorProcessor(arg)
+
+	assert.Nil(t, txip)
+	assert.Equal(t, process.ErrNilWhiteListHandler, err)
+}
+
 func TestNewTxInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +214,57 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 	assert.True(t, addedWasCalled)
 }
 
+func TestTxInterceptorProcessor_SaveBroadcastTxShouldAddWithReplacement(t *testing.T) {
+	t.Parallel()
+
+	addedWithReplacementWasCalled := false
+	shardedDataCache := &shardedDataWithReplacementStub{
+		ShardedDataStub: testscommon.NewShardedDataStub(),
+		AddDataWithReplacementCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+			addedWithReplacementWasCalled = true
+		},
+	}
+	shardedDataCache.AddDataCalled = func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+		assert.Fail(t, "should have not been called")
+	}
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = shardedDataCache
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	err := txip.Save(createInterceptedTxToSave(), "", "")
+
+	assert.Nil(t, err)
+	assert.True(t, addedWithReplacementWasCalled)
+}
+
+func TestTxInterceptorProcessor_SaveRequestedTxShouldAddWithoutReplacement(t *testing.T) {
+	t.Parallel()
+
+	addedWasCalled := false
+	shardedDataCache := &shardedDataWithReplacementStub{
+		ShardedDataStub: testscommon.NewShardedDataStub(),
+		AddDataWithReplacementCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	shardedDataCache.AddDataCalled = func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+		addedWasCalled = true
+	}
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = shardedDataCache
+	arg.WhiteListHandler = &mock.WhiteListHandlerStub{
+		IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+			return true
+		},
+	}
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	err := txip.Save(createInterceptedTxToSave(), "", "")
+
+	assert.Nil(t, err)
+	assert.True(t, addedWasCalled)
+}
+
 //------- IsInterfaceNil
 
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
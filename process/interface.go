@@ -266,6 +266,15 @@ type TransactionLogProcessorDatabase interface {
 	IsInterfaceNil() bool
 }
 
+// EventsNotifier defines the behavior of a component able to push the events of the committed blocks
+type EventsNotifier interface {
+	HasSubscribers() bool
+	HasHandlers() bool
+	NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler)
+	NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
 // ValidatorsProvider is the main interface for validators' provider
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*state.ValidatorApiResponse
@@ -675,6 +684,12 @@ type SCQuery struct {
 	CallerAddr []byte
 	CallValue  *big.Int
 	Arguments  [][]byte
+	// BlockNonce, BlockHash and RootHash are optional and mutually exclusive. When one of them is set, the query is
+	// executed against the state of that block (or root hash) instead of the current state. A root hash is accepted
+	// only if one of the recent headers was committed on it, as that header provides the block information of the query
+	BlockNonce core.OptionalUint64
+	BlockHash  []byte
+	RootHash   []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -0,0 +1,287 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtMultiTransfer)(nil)
+
+// minArgsForMultiTransfer is the number of arguments needed for a single token transfer: the number of tokens, the
+// token identifier and the value
+const minArgsForMultiTransfer = 3
+
+type esdtTransferData struct {
+	tokenKey []byte
+	value    *big.Int
+}
+
+type esdtMultiTransfer struct {
+	funcGasCost     uint64
+	marshalizer     marshal.Marshalizer
+	keyPrefix       []byte
+	pauseHandler    process.ESDTPauseHandler
+	payableHandler  process.PayableHandler
+	activationEpoch uint32
+	flagEnabled     atomic.Flag
+	mutExecution    sync.RWMutex
+}
+
+// NewESDTMultiTransferFunc returns the esdt multi transfer built-in function component. The function moves several
+// tokens from the sender to a single receiver: either all the transfers are executed or none of them is
+func NewESDTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtMultiTransfer{
+		funcGasCost:     funcGasCost,
+		marshalizer:     marshalizer,
+		keyPrefix:       []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		pauseHandler:    pauseHandler,
+		payableHandler:  &disabledPayableHandler{},
+		activationEpoch: activationEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtMultiTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTTransfer
+	e.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtMultiTransfer) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.activationEpoch)
+	log.Debug("ESDT multi transfer", "enabled", e.flagEnabled.IsSet())
+}
+
+// ProcessBuiltinFunction resolves ESDT multi transfer function calls. The arguments are the number of transferred
+// tokens, the token identifier and value pairs and, optionally, the smart contract function to be called afterwards
+// together with its arguments
+func (e *esdtMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	transfers, err := e.getTransfers(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// gas is paid for each of the transferred tokens
+	funcGasCost := e.funcGasCost * uint64(len(transfers))
+	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, funcGasCost)
+	log.Trace("esdtMultiTransfer", "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "num tokens", len(transfers))
+
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		if vmInput.GasProvided < funcGasCost {
+			return nil, process.ErrNotEnoughGas
+		}
+
+		err = e.moveTokens(vmInput.CallerAddr, acntSnd, transfers, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numTransferArgs := 1 + 2*len(transfers)
+	isSCCallAfter := core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > numTransferArgs
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	if !check.IfNil(acntDst) {
+		mustVerifyPayable := vmInput.CallType != vmcommon.AsynchronousCallBack && !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress)
+		if mustVerifyPayable && len(vmInput.Arguments) == numTransferArgs {
+			isPayable, errPayable := e.payableHandler.IsPayable(vmInput.RecipientAddr)
+			if errPayable != nil {
+				e.revertSenderTransfers(vmInput.CallerAddr, acntSnd, transfers)
+				return nil, errPayable
+			}
+			if !isPayable {
+				e.revertSenderTransfers(vmInput.CallerAddr, acntSnd, transfers)
+				return nil, process.ErrAccountNotPayable
+			}
+		}
+
+		err = e.moveTokens(vmInput.CallerAddr, acntDst, transfers, true)
+		if err != nil {
+			e.revertSenderTransfers(vmInput.CallerAddr, acntSnd, transfers)
+			return nil, err
+		}
+
+		if isSCCallAfter {
+			vmOutput.GasRemaining, err = core.SafeSubUint64(vmInput.GasProvided, funcGasCost)
+			log.LogIfError(err, "esdtMultiTransfer", "isSCCallAfter")
+			var callArgs [][]byte
+			if len(vmInput.Arguments) > numTransferArgs+1 {
+				callArgs = vmInput.Arguments[numTransferArgs+1:]
+			}
+
+			addOutPutTransferToVMOutput(
+				string(vmInput.Arguments[numTransferArgs]),
+				callArgs,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmOutput)
+
+			return vmOutput, nil
+		}
+
+		if vmInput.CallType == vmcommon.AsynchronousCallBack && check.IfNil(acntSnd) {
+			// gas was already consumed on sender shard
+			vmOutput.GasRemaining = vmInput.GasProvided
+		}
+
+		return vmOutput, nil
+	}
+
+	// cross-shard ESDT multi transfer call through a smart contract
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		addOutPutTransferToVMOutput(
+			core.BuiltInFunctionESDTMultiTransfer,
+			vmInput.Arguments,
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+// ComputeESDTMultiTransferNumArgs returns the number of arguments which describe the transfers of an ESDT multi
+// transfer call, the following ones being the smart contract call, or 0 if the arguments are malformed
+func ComputeESDTMultiTransferNumArgs(arguments [][]byte) int {
+	if len(arguments) < minArgsForMultiTransfer {
+		return 0
+	}
+
+	numTransfers := big.NewInt(0).SetBytes(arguments[0])
+	maxNumTransfers := big.NewInt(int64((len(arguments) - 1) / 2))
+	if numTransfers.Cmp(zero) <= 0 || numTransfers.Cmp(maxNumTransfers) > 0 {
+		return 0
+	}
+
+	return 1 + 2*int(numTransfers.Int64())
+}
+
+func (e *esdtMultiTransfer) getTransfers(arguments [][]byte) ([]*esdtTransferData, error) {
+	numTransferArgs := ComputeESDTMultiTransferNumArgs(arguments)
+	if numTransferArgs == 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	numTransfers := (numTransferArgs - 1) / 2
+	transfers := make([]*esdtTransferData, 0, numTransfers)
+	for i := 0; i < numTransfers; i++ {
+		tokenID := arguments[1+2*i]
+		value := big.NewInt(0).SetBytes(arguments[2+2*i])
+		if value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		transfers = append(transfers, &esdtTransferData{
+			tokenKey: append(append([]byte{}, e.keyPrefix...), tokenID...),
+			value:    value,
+		})
+	}
+
+	return transfers, nil
+}
+
+// moveTokens adds or subtracts all the transferred values to or from the provided account. If one of the operations
+// fails, the already executed ones are reverted so that the account is left untouched
+func (e *esdtMultiTransfer) moveTokens(
+	senderAddr []byte,
+	userAcnt state.UserAccountHandler,
+	transfers []*esdtTransferData,
+	isCredit bool,
+) error {
+	for i, transfer := range transfers {
+		err := addToESDTBalance(senderAddr, userAcnt, transfer.tokenKey, signedValue(transfer.value, isCredit), e.marshalizer, e.pauseHandler)
+		if err == nil {
+			continue
+		}
+
+		for _, executed := range transfers[:i] {
+			errRevert := addToESDTBalance(senderAddr, userAcnt, executed.tokenKey, signedValue(executed.value, !isCredit), e.marshalizer, e.pauseHandler)
+			log.LogIfError(errRevert, "esdtMultiTransfer", "moveTokens revert")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (e *esdtMultiTransfer) revertSenderTransfers(senderAddr []byte, acntSnd state.UserAccountHandler, transfers []*esdtTransferData) {
+	if check.IfNil(acntSnd) {
+		return
+	}
+
+	for _, transfer := range transfers {
+		err := addToESDTBalance(senderAddr, acntSnd, transfer.tokenKey, transfer.value, e.marshalizer, e.pauseHandler)
+		log.LogIfError(err, "esdtMultiTransfer", "revertSenderTransfers")
+	}
+}
+
+func signedValue(value *big.Int, isPositive bool) *big.Int {
+	if isPositive {
+		return big.NewInt(0).Set(value)
+	}
+
+	return big.NewInt(0).Neg(value)
+}
+
+func (e *esdtMultiTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,244 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTMultiTransferInput(gasProvided uint64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: gasProvided,
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+		},
+	}
+}
+
+func setESDTBalance(acnt state.UserAccountHandler, esdtKey []byte, value int64, marshalizer marshal.Marshalizer) {
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(value)}
+	marshaledData, _ := marshalizer.Marshal(esdtToken)
+	_ = acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshaledData)
+}
+
+func getESDTBalance(acnt state.UserAccountHandler, esdtKey []byte, marshalizer marshal.Marshalizer) *big.Int {
+	esdtToken, _ := getESDTDataFromKey(acnt, esdtKey, marshalizer)
+	return esdtToken.Value
+}
+
+func TestNewESDTMultiTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, err := NewESDTMultiTransferFunc(10, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiTransferFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	multiTransferFunc, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiTransferFunc))
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	multiTransferFunc, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(multiTransferFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	multiTransferFunc, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(multiTransferFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionNotActiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("token"), big.NewInt(10).Bytes())
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	multiTransferFunc.EpochConfirmed(1)
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_, err := multiTransferFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("token"))
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(50, big.NewInt(0).Bytes(), []byte("token"), big.NewInt(10).Bytes())
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(50, big.NewInt(2).Bytes(), []byte("token"), big.NewInt(10).Bytes())
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("token"), big.NewInt(0).Bytes())
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("token"), big.NewInt(10).Bytes())
+	input.CallValue = big.NewInt(1)
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createESDTMultiTransferInput(19, big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("token2"), big.NewInt(10).Bytes())
+	accSnd := state.NewEmptyUserAccount()
+	_, err = multiTransferFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("token2"), big.NewInt(20).Bytes())
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey1 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token1")...)
+	esdtKey2 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token2")...)
+	setESDTBalance(accSnd, esdtKey1, 100, marshalizer)
+	setESDTBalance(accSnd, esdtKey2, 100, marshalizer)
+
+	vmOutput, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(30), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, esdtKey2, marshalizer))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, esdtKey2, marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionInsufficientFundsShouldRevertAll(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("token2"), big.NewInt(200).Bytes())
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey1 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token1")...)
+	esdtKey2 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token2")...)
+	setESDTBalance(accSnd, esdtKey1, 100, marshalizer)
+	setESDTBalance(accSnd, esdtKey2, 100, marshalizer)
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, esdtKey2, marshalizer))
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accDst, esdtKey1, marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionNotPayableShouldRevertAll(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(address []byte) (bool, error) {
+			return false, nil
+		},
+	})
+
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("token2"), big.NewInt(20).Bytes())
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey1 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token1")...)
+	esdtKey2 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token2")...)
+	setESDTBalance(accSnd, esdtKey1, 100, marshalizer)
+	setESDTBalance(accSnd, esdtKey2, 100, marshalizer)
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, esdtKey2, marshalizer))
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accDst, esdtKey2, marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionWithSCCallAfter(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	scAddress := make([]byte, 32)
+	scAddress[10] = 1
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("token"), big.NewInt(10).Bytes(), []byte("deposit"), []byte("arg"))
+	input.RecipientAddr = scAddress
+	assert.True(t, core.IsSmartContractAddress(scAddress))
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount(scAddress)
+	esdtKey := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token")...)
+	setESDTBalance(accSnd, esdtKey, 100, marshalizer)
+
+	vmOutput, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, esdtKey, marshalizer))
+
+	outputAccount := vmOutput.OutputAccounts[string(scAddress)]
+	assert.NotNil(t, outputAccount)
+	assert.Equal(t, 1, len(outputAccount.OutputTransfers))
+	assert.Equal(t, []byte("deposit@617267"), outputAccount.OutputTransfers[0].Data)
+	assert.Equal(t, uint64(40), outputAccount.OutputTransfers[0].GasLimit)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("token2"), big.NewInt(20).Bytes())
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey1 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token1")...)
+	esdtKey2 := append(append([]byte{}, multiTransferFunc.keyPrefix...), []byte("token2")...)
+	setESDTBalance(accSnd, esdtKey1, 100, marshalizer)
+	setESDTBalance(accSnd, esdtKey2, 100, marshalizer)
+
+	vmOutput, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(30), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, esdtKey2, marshalizer))
+
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	vmOutput, err = multiTransferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, esdtKey1, marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, esdtKey2, marshalizer))
+}
+
+func TestComputeESDTMultiTransferNumArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, ComputeESDTMultiTransferNumArgs(nil))
+	assert.Equal(t, 0, ComputeESDTMultiTransferNumArgs([][]byte{{1}, []byte("token")}))
+	assert.Equal(t, 0, ComputeESDTMultiTransferNumArgs([][]byte{{2}, []byte("token"), {10}}))
+	assert.Equal(t, 3, ComputeESDTMultiTransferNumArgs([][]byte{{1}, []byte("token"), {10}}))
+	assert.Equal(t, 5, ComputeESDTMultiTransferNumArgs([][]byte{{2}, []byte("token1"), {10}, []byte("token2"), {20}, []byte("func")}))
+}
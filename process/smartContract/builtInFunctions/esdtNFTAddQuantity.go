@@ -0,0 +1,120 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTAddQuantity)(nil)
+
+type esdtNFTAddQuantity struct {
+	funcGasCost     uint64
+	marshalizer     marshal.Marshalizer
+	activationEpoch uint32
+	flagEnabled     atomic.Flag
+	mutExecution    sync.RWMutex
+}
+
+// NewESDTNFTAddQuantityFunc returns the esdt NFT add quantity built-in function component
+func NewESDTNFTAddQuantityFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTAddQuantity, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTAddQuantity{
+		funcGasCost:     funcGasCost,
+		marshalizer:     marshalizer,
+		activationEpoch: activationEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTAddQuantity) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTAddQuantity
+	e.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTAddQuantity) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.activationEpoch)
+	log.Debug("ESDT NFT add quantity", "enabled", e.flagEnabled.IsSet())
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT add quantity function calls. The arguments are the token identifier, the
+// nonce of the semi fungible token instance held by the caller and the added quantity
+func (e *esdtNFTAddQuantity) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkAllowedToExecute(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, tokenID, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	esdtData.Value.Add(esdtData.Value, value)
+
+	err = saveESDTNFTToken(acntSnd, tokenID, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtNFTAddQuantity", "address", vmInput.CallerAddr, "token", tokenID, "nonce", nonce, "added", value)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTAddQuantity) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,97 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func saveTestNFT(acnt state.UserAccountHandler, tokenID []byte, nonce uint64, quantity int64, marshalizer marshal.Marshalizer) {
+	esdtData := &esdt.ESDigitalToken{
+		Value: big.NewInt(quantity),
+		Type:  uint32(esdt.SemiFungible),
+		TokenMetaData: &esdt.ESDTokenMetaData{
+			Nonce:   nonce,
+			Name:    []byte("name"),
+			Creator: []byte("creator"),
+			Hash:    []byte("hash"),
+		},
+	}
+	_ = saveESDTNFTToken(acnt, tokenID, esdtData, marshalizer)
+}
+
+func TestNewESDTNFTAddQuantityFunc(t *testing.T) {
+	t.Parallel()
+
+	addQuantityFunc, err := NewESDTNFTAddQuantityFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(addQuantityFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	addQuantityFunc, err = NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, 0, nil)
+	assert.True(t, check.IfNil(addQuantityFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	addQuantityFunc, err = NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(addQuantityFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, 1, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	input := createESDTNFTInput(caller, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(10).Bytes())
+
+	_, err := addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	addQuantityFunc.EpochConfirmed(1)
+	input.Arguments = input.Arguments[:2]
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(10).Bytes()}
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setESDTRoles(acnt, tokenID, core.SemiFungibleESDT, marshalizer, core.ESDTRoleNFTAddQuantity)
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	saveTestNFT(acnt, tokenID, 1, 5, marshalizer)
+	input.Arguments[2] = big.NewInt(0).Bytes()
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	setESDTRoles(acnt, tokenID, core.SemiFungibleESDT, marshalizer, core.ESDTRoleNFTAddQuantity)
+	saveTestNFT(acnt, tokenID, 1, 5, marshalizer)
+
+	input := createESDTNFTInput(caller, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(10).Bytes())
+	vmOutput, err := addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acnt, tokenID, 1, marshalizer)
+	assert.Equal(t, big.NewInt(15), esdtData.Value)
+}
@@ -0,0 +1,83 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTBurnFunc(t *testing.T) {
+	t.Parallel()
+
+	burnFunc, err := NewESDTNFTBurnFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(burnFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	burnFunc, err = NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, 0, nil)
+	assert.True(t, check.IfNil(burnFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	burnFunc, err = NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(burnFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burnFunc, _ := NewESDTNFTBurnFunc(10, marshalizer, 1, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	input := createESDTNFTInput(caller, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(10).Bytes())
+
+	_, err := burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	burnFunc.EpochConfirmed(1)
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setESDTRoles(acnt, tokenID, core.NonFungibleESDT, marshalizer, core.ESDTRoleNFTBurn)
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	saveTestNFT(acnt, tokenID, 1, 5, marshalizer)
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burnFunc, _ := NewESDTNFTBurnFunc(10, marshalizer, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	setESDTRoles(acnt, tokenID, core.NonFungibleESDT, marshalizer, core.ESDTRoleNFTBurn)
+	saveTestNFT(acnt, tokenID, 1, 5, marshalizer)
+
+	input := createESDTNFTInput(caller, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(2).Bytes())
+	vmOutput, err := burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acnt, tokenID, 1, marshalizer)
+	assert.Equal(t, big.NewInt(3), esdtData.Value)
+
+	// burning the whole quantity removes the token instance
+	input.Arguments[2] = big.NewInt(3).Bytes()
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+
+	_, err = getESDTNFTToken(acnt, tokenID, 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
@@ -0,0 +1,293 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+// minArgsForNFTCreate is the number of arguments needed to create a token instance: the token identifier, the initial
+// quantity, the name, the royalties, the hash, the attributes and at least one URI
+const minArgsForNFTCreate = 7
+
+// maxRoyalty is the maximum value of the royalties, expressed in hundredths of a percent
+const maxRoyalty = 10000
+
+var latestNonceKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier)
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+type esdtNFTCreate struct {
+	funcGasCost     uint64
+	marshalizer     marshal.Marshalizer
+	activationEpoch uint32
+	flagEnabled     atomic.Flag
+	mutExecution    sync.RWMutex
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTCreate{
+		funcGasCost:     funcGasCost,
+		marshalizer:     marshalizer,
+		activationEpoch: activationEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTCreate) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTCreate
+	e.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTCreate) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.activationEpoch)
+	log.Debug("ESDT NFT create", "enabled", e.flagEnabled.IsSet())
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function calls. The arguments are the token identifier, the initial
+// quantity, the name, the royalties, the hash, the attributes and the URIs of the new token instance, which is
+// saved in the data trie of the caller under the next nonce of the token
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < minArgsForNFTCreate {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	roles, err := getRolesAllowedToExecute(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	// the type of the token was sent by the ESDT system SC together with the role
+	esdtType, err := getNFTTypeFromRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if esdtType == esdt.NonFungible && quantity.Cmp(big.NewInt(1)) != 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	royalties := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if royalties.Cmp(big.NewInt(maxRoyalty)) > 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	nonce, err := getLatestNonce(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	nonce++
+
+	esdtData := &esdt.ESDigitalToken{
+		Value: quantity,
+		Type:  uint32(esdtType),
+		TokenMetaData: &esdt.ESDTokenMetaData{
+			Nonce:      nonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  uint32(royalties.Uint64()),
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+
+	err = saveESDTNFTToken(acntSnd, tokenID, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveLatestNonce(acntSnd, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtNFTCreate", "creator", vmInput.CallerAddr, "token", tokenID, "nonce", nonce, "quantity", quantity)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()},
+	}
+
+	return vmOutput, nil
+}
+
+// checkESDTNFTCreateBurnAddInput checks the input of the NFT functions which can only be called by an account on itself
+func checkESDTNFTCreateBurnAddInput(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	funcGasCost uint64,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntSnd) {
+		return process.ErrNilUserAccount
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+func getNFTTypeFromRoles(roles *esdt.ESDTRoles) (esdt.ESDTType, error) {
+	switch string(roles.TokenType) {
+	case core.NonFungibleESDT:
+		return esdt.NonFungible, nil
+	case core.SemiFungibleESDT:
+		return esdt.SemiFungible, nil
+	}
+
+	return esdt.Fungible, process.ErrInvalidNFTTokenType
+}
+
+// computeESDTNFTTokenKey returns the key under which the instance with the provided nonce of the token is saved
+func computeESDTNFTTokenKey(tokenID []byte, nonce uint64) []byte {
+	return core.ESDTNFTTokenKey(tokenID, nonce)
+}
+
+func getESDTNFTToken(
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, error) {
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(computeESDTNFTTokenKey(tokenID, nonce))
+	if err != nil || len(marshaledData) == 0 {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	err = marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+
+	return esdtData, nil
+}
+
+// saveESDTNFTToken saves the token instance in the data trie of the account, removing it once its quantity is zero
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	if esdtData.TokenMetaData == nil {
+		return process.ErrNFTDoesNotHaveMetadata
+	}
+
+	key := computeESDTNFTTokenKey(tokenID, esdtData.TokenMetaData.Nonce)
+	if esdtData.Value.Cmp(zero) <= 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// checkFrozenAndPaused returns an error if the token is paused or if the account is frozen for it
+func checkFrozenAndPaused(
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) error {
+	esdtTokenKey := append(append([]byte{}, esdtKeyPrefix...), tokenID...)
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+	if pauseHandler.IsPaused(esdtTokenKey) {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	return nil
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, tokenID []byte) (uint64, error) {
+	key := append(append([]byte{}, latestNonceKeyPrefix...), tokenID...)
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(nonceData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64(), nil
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, tokenID []byte, nonce uint64) error {
+	key := append(append([]byte{}, latestNonceKeyPrefix...), tokenID...)
+	return acnt.DataTrieTracker().SaveKeyValue(key, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,198 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTNFTInput(caller []byte, gasProvided uint64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			GasProvided: gasProvided,
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func createNFTCreateArguments(tokenID []byte, quantity int64) [][]byte {
+	return [][]byte{
+		tokenID,
+		big.NewInt(quantity).Bytes(),
+		[]byte("name"),
+		big.NewInt(500).Bytes(),
+		[]byte("hash"),
+		[]byte("attributes"),
+		[]byte("uri1"),
+		[]byte("uri2"),
+	}
+}
+
+func setESDTRoles(
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	tokenType string,
+	marshalizer marshal.Marshalizer,
+	roles ...string,
+) {
+	esdtRoles := &esdt.ESDTRoles{TokenType: []byte(tokenType)}
+	for _, role := range roles {
+		esdtRoles.Roles = append(esdtRoles.Roles, []byte(role))
+	}
+
+	_ = saveRolesToAccount(marshalizer, acnt, append(append([]byte{}, roleKeyPrefix...), tokenID...), esdtRoles)
+}
+
+func TestNewESDTNFTCreateFunc(t *testing.T) {
+	t.Parallel()
+
+	nftCreateFunc, err := NewESDTNFTCreateFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, &mock.MarshalizerMock{}, 0, nil)
+	assert.True(t, check.IfNil(nftCreateFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(nftCreateFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, marshalizer, 1, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	input := createESDTNFTInput(caller, 50, createNFTCreateArguments(tokenID, 1)...)
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	nftCreateFunc.EpochConfirmed(1)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input.RecipientAddr = []byte("other")
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input.RecipientAddr = caller
+	_, err = nftCreateFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	input.GasProvided = 1
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input.GasProvided = 50
+	input.Arguments = input.Arguments[:minArgsForNFTCreate-1]
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = createNFTCreateArguments(tokenID, 1)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setESDTRoles(acnt, tokenID, core.NonFungibleESDT, marshalizer, core.ESDTRoleNFTCreate)
+	input.Arguments = createNFTCreateArguments(tokenID, 2)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments = createNFTCreateArguments(tokenID, 0)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments = createNFTCreateArguments(tokenID, 1)
+	input.Arguments[3] = big.NewInt(maxRoyalty + 1).Bytes()
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionNonFungibleShouldIncrementNonce(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, marshalizer, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	setESDTRoles(acnt, tokenID, core.NonFungibleESDT, marshalizer, core.ESDTRoleNFTCreate)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		input := createESDTNFTInput(caller, 50, createNFTCreateArguments(tokenID, 1)...)
+		vmOutput, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+		assert.Equal(t, [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()}, vmOutput.ReturnData)
+
+		esdtData, err := getESDTNFTToken(acnt, tokenID, nonce, marshalizer)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1), esdtData.Value)
+		assert.Equal(t, uint32(esdt.NonFungible), esdtData.Type)
+		assert.Equal(t, nonce, esdtData.TokenMetaData.Nonce)
+		assert.Equal(t, caller, esdtData.TokenMetaData.Creator)
+		assert.Equal(t, uint32(500), esdtData.TokenMetaData.Royalties)
+		assert.Equal(t, []byte("attributes"), esdtData.TokenMetaData.Attributes)
+		assert.Equal(t, [][]byte{[]byte("uri1"), []byte("uri2")}, esdtData.TokenMetaData.URIs)
+	}
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionSemiFungible(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, marshalizer, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+	setESDTRoles(acnt, tokenID, core.SemiFungibleESDT, marshalizer, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+
+	input := createESDTNFTInput(caller, 50, createNFTCreateArguments(tokenID, 100)...)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+
+	esdtData, err := getESDTNFTToken(acnt, tokenID, 1, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), esdtData.Value)
+	assert.Equal(t, uint32(esdt.SemiFungible), esdtData.Type)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionShouldTakeTheTypeFromTheRolesData(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, marshalizer, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	caller := []byte("caller")
+	acnt, _ := state.NewUserAccount(caller)
+
+	setESDTRoles(acnt, tokenID, core.FungibleESDT, marshalizer, core.ESDTRoleNFTCreate)
+	input := createESDTNFTInput(caller, 50, createNFTCreateArguments(tokenID, 1)...)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidNFTTokenType, err)
+
+	// a semi fungible token instance can be created without the add quantity role
+	setESDTRoles(acnt, tokenID, core.SemiFungibleESDT, marshalizer, core.ESDTRoleNFTCreate)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+
+	esdtData, err := getESDTNFTToken(acnt, tokenID, 1, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(esdt.SemiFungible), esdtData.Type)
+}
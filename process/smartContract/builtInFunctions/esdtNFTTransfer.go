@@ -0,0 +1,286 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+// numArgsForNFTTransfer is the number of arguments of an ESDT NFT transfer: the token identifier, the nonce, the
+// quantity and either the destination address, on the sender shard, or the transferred token, on the destination shard
+const numArgsForNFTTransfer = 4
+
+type esdtNFTTransfer struct {
+	funcGasCost      uint64
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	activationEpoch  uint32
+	flagEnabled      atomic.Flag
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTTransfer{
+		funcGasCost:      funcGasCost,
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		activationEpoch:  activationEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTTransfer
+	e.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTTransfer) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.activationEpoch)
+	log.Debug("ESDT NFT transfer", "enabled", e.flagEnabled.IsSet())
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function calls. The function is called by the holder on itself
+// with the token identifier, the nonce, the quantity and the destination address as arguments. If the destination is
+// in another shard, the transferred token instance, metadata included, is sent there within a smart contract result
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != numArgsForNFTTransfer {
+		return nil, process.ErrInvalidArguments
+	}
+
+	if check.IfNil(acntSnd) {
+		return e.processNFTTransferOnDestinationShard(acntDst, vmInput)
+	}
+
+	return e.processNFTTransferOnSenderShard(acntSnd, vmInput)
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnSenderShard(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) || bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	err := checkFrozenAndPaused(acntSnd, tokenID, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData, err := getESDTNFTToken(acntSnd, tokenID, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.Value.Cmp(quantity) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	esdtData.Value.Sub(esdtData.Value, quantity)
+	err = saveESDTNFTToken(acntSnd, tokenID, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value = quantity
+	log.Trace("esdtNFTTransfer", "sender", vmInput.CallerAddr, "receiver", dstAddress, "token", tokenID, "nonce", nonce, "quantity", quantity)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+
+	if e.shardCoordinator.ComputeId(dstAddress) == e.shardCoordinator.SelfId() {
+		err = e.addNFTToDestinationInSelfShard(dstAddress, tokenID, esdtData)
+		if err != nil {
+			return nil, err
+		}
+
+		return vmOutput, nil
+	}
+
+	marshaledNFTTransfer, err := e.marshalizer.Marshal(esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	nftTransferTxData := core.BuiltInFunctionESDTNFTTransfer + "@" + hex.EncodeToString(tokenID) +
+		"@" + hex.EncodeToString(vmInput.Arguments[1]) + "@" + hex.EncodeToString(vmInput.Arguments[2]) +
+		"@" + hex.EncodeToString(marshaledNFTTransfer)
+
+	// the remaining gas is refunded on the sender shard as the destination shard only saves the transferred token
+	vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	vmOutput.OutputAccounts[string(dstAddress)] = &vmcommon.OutputAccount{
+		Address: dstAddress,
+		OutputTransfers: []vmcommon.OutputTransfer{
+			{
+				Value:    big.NewInt(0),
+				Data:     []byte(nftTransferTxData),
+				CallType: vmcommon.DirectCall,
+			},
+		},
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) addNFTToDestinationInSelfShard(dstAddress []byte, tokenID []byte, esdtData *esdt.ESDigitalToken) error {
+	accountHandler, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+
+	acntDst, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addNFTToDestination(acntDst, tokenID, esdtData)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(acntDst)
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnDestinationShard(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTransferData := &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	err := e.marshalizer.Unmarshal(esdtTransferData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if esdtTransferData.TokenMetaData == nil {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if esdtTransferData.TokenMetaData.Nonce != nonce || esdtTransferData.Value.Cmp(quantity) != 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	err = e.addNFTToDestination(acntDst, vmInput.Arguments[0], esdtTransferData)
+	if err != nil {
+		return nil, err
+	}
+
+	// gas was already consumed on sender shard
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// addNFTToDestination adds the transferred quantity to the token instance already held by the destination, if any,
+// or saves the transferred token instance otherwise
+func (e *esdtNFTTransfer) addNFTToDestination(
+	acntDst state.UserAccountHandler,
+	tokenID []byte,
+	esdtTransferData *esdt.ESDigitalToken,
+) error {
+	err := checkFrozenAndPaused(acntDst, tokenID, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	currentESDTData, err := getESDTNFTToken(acntDst, tokenID, esdtTransferData.TokenMetaData.Nonce, e.marshalizer)
+	if err == process.ErrNFTTokenDoesNotExist {
+		return saveESDTNFTToken(acntDst, tokenID, esdtTransferData, e.marshalizer)
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(currentESDTData.TokenMetaData.Hash, esdtTransferData.TokenMetaData.Hash) {
+		return process.ErrWrongNFTOnDestination
+	}
+
+	currentESDTData.Value.Add(currentESDTData.Value, esdtTransferData.Value)
+
+	return saveESDTNFTToken(acntDst, tokenID, currentESDTData, e.marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,202 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNFTTransferFuncWithAccounts(accounts state.AccountsAdapter, dstShardID uint32) *esdtNFTTransfer {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == "destination" {
+			return dstShardID
+		}
+		return 0
+	}
+
+	nftTransferFunc, _ := NewESDTNFTTransferFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.PauseHandlerStub{},
+		accounts,
+		shardCoordinator,
+		0,
+		&mock.EpochNotifierStub{},
+	)
+
+	return nftTransferFunc
+}
+
+func TestNewESDTNFTTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	nftTransferFunc, err := NewESDTNFTTransferFunc(10, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftTransferFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftTransferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftTransferFunc))
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	nftTransferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, mock.NewMultiShardsCoordinatorMock(2), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftTransferFunc))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	nftTransferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftTransferFunc))
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+
+	nftTransferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), 0, nil)
+	assert.True(t, check.IfNil(nftTransferFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	nftTransferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(nftTransferFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	nftTransferFunc := createNFTTransferFuncWithAccounts(&mock.AccountsStub{}, 0)
+	tokenID := []byte("token")
+	sender := []byte("sender-addr")
+	acnt, _ := state.NewUserAccount(sender)
+	input := createESDTNFTInput(sender, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), []byte("destination"))
+
+	_, err := nftTransferFunc.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input.Arguments = input.Arguments[:3]
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), []byte("destination")}
+	input.RecipientAddr = []byte("destination")
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input.RecipientAddr = sender
+	input.GasProvided = 1
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input.GasProvided = 50
+	input.Arguments[3] = sender
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments[3] = []byte("destination")
+	input.Arguments[2] = big.NewInt(0).Bytes()
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments[2] = big.NewInt(1).Bytes()
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	saveTestNFT(acnt, tokenID, 1, 1, nftTransferFunc.marshalizer)
+	input.Arguments[2] = big.NewInt(2).Bytes()
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	nftTransferFunc.pauseHandler = &mock.PauseHandlerStub{
+		IsPausedCalled: func(token []byte) bool {
+			return true
+		},
+	}
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionSameShard(t *testing.T) {
+	t.Parallel()
+
+	dstAcnt, _ := state.NewUserAccount([]byte("destination"))
+	savedAccount := false
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return dstAcnt, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccount = true
+			return nil
+		},
+	}
+	nftTransferFunc := createNFTTransferFuncWithAccounts(accounts, 0)
+	tokenID := []byte("token")
+	sender := []byte("sender-addr")
+	acnt, _ := state.NewUserAccount(sender)
+	saveTestNFT(acnt, tokenID, 1, 10, nftTransferFunc.marshalizer)
+	saveTestNFT(dstAcnt, tokenID, 1, 1, nftTransferFunc.marshalizer)
+
+	input := createESDTNFTInput(sender, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(4).Bytes(), []byte("destination"))
+	vmOutput, err := nftTransferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.True(t, savedAccount)
+
+	esdtData, _ := getESDTNFTToken(acnt, tokenID, 1, nftTransferFunc.marshalizer)
+	assert.Equal(t, big.NewInt(6), esdtData.Value)
+	esdtData, _ = getESDTNFTToken(dstAcnt, tokenID, 1, nftTransferFunc.marshalizer)
+	assert.Equal(t, big.NewInt(5), esdtData.Value)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	nftTransferFuncSenderShard := createNFTTransferFuncWithAccounts(&mock.AccountsStub{}, 1)
+	tokenID := []byte("token")
+	sender := []byte("sender-addr")
+	acnt, _ := state.NewUserAccount(sender)
+	saveTestNFT(acnt, tokenID, 1, 1, nftTransferFuncSenderShard.marshalizer)
+
+	input := createESDTNFTInput(sender, 50, tokenID, big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), []byte("destination"))
+	vmOutput, err := nftTransferFuncSenderShard.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	_, err = getESDTNFTToken(acnt, tokenID, 1, nftTransferFuncSenderShard.marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	outAcc := vmOutput.OutputAccounts["destination"]
+	assert.NotNil(t, outAcc)
+	assert.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, vmcommon.DirectCall, outAcc.OutputTransfers[0].CallType)
+
+	tokens := strings.Split(string(outAcc.OutputTransfers[0].Data), "@")
+	assert.Equal(t, core.BuiltInFunctionESDTNFTTransfer, tokens[0])
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, _ := hex.DecodeString(token)
+		arguments = append(arguments, argument)
+	}
+
+	nftTransferFuncDstShard := createNFTTransferFuncWithAccounts(&mock.AccountsStub{}, 1)
+	dstAcnt, _ := state.NewUserAccount([]byte("destination"))
+	dstInput := createESDTNFTInput(sender, 0, arguments...)
+	dstInput.RecipientAddr = []byte("destination")
+	vmOutput, err = nftTransferFuncDstShard.ProcessBuiltinFunction(nil, dstAcnt, dstInput)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	esdtData, err := getESDTNFTToken(dstAcnt, tokenID, 1, nftTransferFuncDstShard.marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), esdtData.Value)
+	assert.Equal(t, []byte("hash"), esdtData.TokenMetaData.Hash)
+
+	dstInput.Arguments[2] = big.NewInt(2).Bytes()
+	_, err = nftTransferFuncDstShard.ProcessBuiltinFunction(nil, dstAcnt, dstInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
@@ -0,0 +1,230 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+
+var roleKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier)
+
+type esdtRoles struct {
+	set             bool
+	marshalizer     marshal.Marshalizer
+	activationEpoch uint32
+	flagEnabled     atomic.Flag
+}
+
+// NewESDTRolesFunc returns the esdt set/unset special role built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtRoles{
+		set:             set,
+		marshalizer:     marshalizer,
+		activationEpoch: activationEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtRoles) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtRoles) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.activationEpoch)
+	log.Debug("ESDT set/unset roles", "enabled", e.flagEnabled.IsSet())
+}
+
+// ProcessBuiltinFunction resolves ESDT set/unset special role function calls. The arguments are the token identifier,
+// the token type (only when setting roles) and the roles which are set or unset for the destination account
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	minNumArguments := 2
+	if e.set {
+		minNumArguments = 3
+	}
+	if len(vmInput.Arguments) < minNumArguments {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := append(append([]byte{}, roleKeyPrefix...), vmInput.Arguments[0]...)
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenRoleKey)
+
+	roles, err := getESDTRolesForAcnt(e.marshalizer, acntDst, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		roles.TokenType = vmInput.Arguments[1]
+		addRoles(roles, vmInput.Arguments[2:])
+	} else {
+		deleteRoles(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveRolesToAccount(e.marshalizer, acntDst, esdtTokenRoleKey, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func addRoles(roles *esdt.ESDTRoles, newRoles [][]byte) {
+	for _, newRole := range newRoles {
+		_, exists := doesRoleExist(roles, newRole)
+		if exists {
+			continue
+		}
+
+		roles.Roles = append(roles.Roles, newRole)
+	}
+}
+
+func deleteRoles(roles *esdt.ESDTRoles, deletedRoles [][]byte) {
+	for _, deletedRole := range deletedRoles {
+		index, exists := doesRoleExist(roles, deletedRole)
+		if !exists {
+			continue
+		}
+
+		copy(roles.Roles[index:], roles.Roles[index+1:])
+		roles.Roles[len(roles.Roles)-1] = nil
+		roles.Roles = roles.Roles[:len(roles.Roles)-1]
+	}
+}
+
+func doesRoleExist(roles *esdt.ESDTRoles, role []byte) (int, bool) {
+	for i, currentRole := range roles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func getESDTRolesForAcnt(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	key []byte,
+) (*esdt.ESDTRoles, error) {
+	roles := &esdt.ESDTRoles{
+		Roles: make([][]byte, 0),
+	}
+
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func saveRolesToAccount(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	key []byte,
+	roles *esdt.ESDTRoles,
+) error {
+	if len(roles.Roles) == 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// checkAllowedToExecute returns nil if the account holds the provided role for the given token
+func checkAllowedToExecute(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	action []byte,
+) error {
+	_, err := getRolesAllowedToExecute(marshalizer, acnt, tokenID, action)
+	return err
+}
+
+// getRolesAllowedToExecute returns the roles data the account holds for the given token, if the provided role is
+// among them
+func getRolesAllowedToExecute(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	action []byte,
+) (*esdt.ESDTRoles, error) {
+	if check.IfNil(acnt) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := append(append([]byte{}, roleKeyPrefix...), tokenID...)
+	roles, err := getESDTRolesForAcnt(marshalizer, acnt, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, exists := doesRoleExist(roles, action)
+	if !exists {
+		return nil, process.ErrActionNotAllowed
+	}
+
+	return roles, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,114 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTRolesInput(arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  arguments,
+		},
+		RecipientAddr: []byte("dst"),
+	}
+}
+
+func TestNewESDTRolesFunc(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, err := NewESDTRolesFunc(nil, true, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(rolesFunc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	rolesFunc, err = NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, nil)
+	assert.True(t, check.IfNil(rolesFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	rolesFunc, err = NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(rolesFunc))
+	assert.Nil(t, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 1, &mock.EpochNotifierStub{})
+	input := createESDTRolesInput([]byte("token"), []byte(core.NonFungibleESDT), []byte(core.ESDTRoleNFTCreate))
+	acnt, _ := state.NewUserAccount(input.RecipientAddr)
+
+	_, err := rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	rolesFunc.EpochConfirmed(1)
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input.CallValue = big.NewInt(1)
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.NonFungibleESDT)}
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.NonFungibleESDT), []byte(core.ESDTRoleNFTCreate)}
+	input.CallerAddr = []byte("caller")
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionSetAndUnSet(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setRoleFunc, _ := NewESDTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
+	unSetRoleFunc, _ := NewESDTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+
+	input := createESDTRolesInput(tokenID, []byte(core.NonFungibleESDT), []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn))
+	vmOutput, err := setRoleFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Nil(t, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTCreate)))
+	assert.Nil(t, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+	assert.Equal(t, process.ErrActionNotAllowed, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTAddQuantity)))
+	assert.Equal(t, process.ErrActionNotAllowed, checkAllowedToExecute(marshalizer, acnt, []byte("other"), []byte(core.ESDTRoleNFTCreate)))
+
+	// setting an already set role does not duplicate it
+	input = createESDTRolesInput(tokenID, []byte(core.NonFungibleESDT), []byte(core.ESDTRoleNFTBurn))
+	_, err = setRoleFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	roles, _ := getESDTRolesForAcnt(marshalizer, acnt, append(append([]byte{}, roleKeyPrefix...), tokenID...))
+	assert.Equal(t, 2, len(roles.Roles))
+	assert.Equal(t, []byte(core.NonFungibleESDT), roles.TokenType)
+
+	input = createESDTRolesInput(tokenID, []byte(core.ESDTRoleNFTBurn))
+	_, err = unSetRoleFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Nil(t, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTCreate)))
+	assert.Equal(t, process.ErrActionNotAllowed, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+
+	input = createESDTRolesInput(tokenID, []byte(core.ESDTRoleNFTCreate))
+	_, err = unSetRoleFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	marshaledRoles, _ := acnt.DataTrieTracker().RetrieveValue(append(append([]byte{}, roleKeyPrefix...), tokenID...))
+	assert.Equal(t, 0, len(marshaledRoles))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,20 +15,28 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule                  core.GasScheduleNotifier
+	MapDNSAddresses              map[string]struct{}
+	EnableUserNameChange         bool
+	Marshalizer                  marshal.Marshalizer
+	Accounts                     state.AccountsAdapter
+	EpochNotifier                process.EpochNotifier
+	ShardCoordinator             sharding.Coordinator
+	ESDTMultiTransferEnableEpoch uint32
+	ESDTNFTEnableEpoch           uint32
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses              map[string]struct{}
+	enableUserNameChange         bool
+	marshalizer                  marshal.Marshalizer
+	accounts                     state.AccountsAdapter
+	epochNotifier                process.EpochNotifier
+	shardCoordinator             sharding.Coordinator
+	esdtMultiTransferEnableEpoch uint32
+	esdtNFTEnableEpoch           uint32
+	builtInFunctions             process.BuiltInFunctionContainer
+	gasConfig                    *process.GasCost
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -44,12 +53,22 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:              args.MapDNSAddresses,
+		enableUserNameChange:         args.EnableUserNameChange,
+		marshalizer:                  args.Marshalizer,
+		accounts:                     args.Accounts,
+		epochNotifier:                args.EpochNotifier,
+		shardCoordinator:             args.ShardCoordinator,
+		esdtMultiTransferEnableEpoch: args.ESDTMultiTransferEnableEpoch,
+		esdtNFTEnableEpoch:           args.ESDTNFTEnableEpoch,
 	}
 
 	var err error
@@ -135,6 +154,21 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewESDTMultiTransferFunc(
+		b.gasConfig.BuiltInCost.ESDTTransfer,
+		b.marshalizer,
+		pauseFunc,
+		b.esdtMultiTransferEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTMultiTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	newFunc, err = NewESDTBurnFunc(b.gasConfig.BuiltInCost.ESDTBurn, b.marshalizer, pauseFunc)
 	if err != nil {
 		return nil, err
@@ -180,6 +214,68 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, true, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetESDTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, false, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionUnSetESDTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTCreateFunc(b.gasConfig.BuiltInCost.ESDTNFTCreate, b.marshalizer, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTCreate, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.ESDTNFTAddQuantity, b.marshalizer, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTAddQuantity, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTBurnFunc(b.gasConfig.BuiltInCost.ESDTNFTBurn, b.marshalizer, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTTransferFunc(
+		b.gasConfig.BuiltInCost.ESDTNFTTransfer,
+		b.marshalizer,
+		pauseFunc,
+		b.accounts,
+		b.shardCoordinator,
+		b.esdtNFTEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -228,7 +324,24 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 		return process.ErrWrongTypeAssertion
 	}
 
-	return esdtTransferFunc.setPayableHandler(payableHandler)
+	err = esdtTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.Get(core.BuiltInFunctionESDTMultiTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtMultiTransferFunc, ok := builtInFunc.(*esdtMultiTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	return esdtMultiTransferFunc.setPayableHandler(payableHandler)
 }
 
 // IsInterfaceNil returns true if underlying object is nil
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
@@ -51,10 +52,14 @@ type scProcessor struct {
 	builtinEnableEpoch             uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	repairCallBackEnableEpoch      uint32
+	esdtMultiTransferEnableEpoch   uint32
+	esdtNFTEnableEpoch             uint32
 	flagDeploy                     atomic.Flag
 	flagBuiltin                    atomic.Flag
 	flagPenalizedTooMuchGas        atomic.Flag
 	flagRepairCallBackData         atomic.Flag
+	flagESDTMultiTransfer          atomic.Flag
+	flagESDTNFT                    atomic.Flag
 	isGenesisProcessing            bool
 
 	badTxForwarder process.IntermediateTransactionHandler
@@ -95,6 +100,8 @@ type ArgsNewSmartContractProcessor struct {
 	BuiltinEnableEpoch             uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	RepairCallbackEnableEpoch      uint32
+	ESDTMultiTransferEnableEpoch   uint32
+	ESDTNFTEnableEpoch             uint32
 	EpochNotifier                  process.EpochNotifier
 	IsGenesisProcessing            bool
 }
@@ -182,6 +189,8 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		builtinEnableEpoch:             args.BuiltinEnableEpoch,
 		repairCallBackEnableEpoch:      args.RepairCallbackEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
+		esdtMultiTransferEnableEpoch:   args.ESDTMultiTransferEnableEpoch,
+		esdtNFTEnableEpoch:             args.ESDTNFTEnableEpoch,
 		isGenesisProcessing:            args.IsGenesisProcessing,
 	}
 
@@ -1073,17 +1082,27 @@ func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) (str
 		return "", false
 	}
 
-	if function != core.BuiltInFunctionESDTTransfer {
+	if !sc.isBuiltInFunctionActive(function) {
 		return "", false
 	}
-	if len(args) < 2 {
+
+	numTransferArgs := 0
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		numTransferArgs = 2
+	case core.BuiltInFunctionESDTMultiTransfer:
+		numTransferArgs = builtInFunctions.ComputeESDTMultiTransferNumArgs(args)
+	case core.BuiltInFunctionESDTNFTTransfer:
+		numTransferArgs = 4
+	}
+	if numTransferArgs == 0 || len(args) < numTransferArgs {
 		return "", false
 	}
 
-	returnData := ""
-	returnData += function + "@"
-	returnData += hex.EncodeToString(args[0]) + "@"
-	returnData += hex.EncodeToString(args[1])
+	returnData := function
+	for _, arg := range args[:numTransferArgs] {
+		returnData += "@" + hex.EncodeToString(arg)
+	}
 
 	return returnData, true
 }
@@ -1119,6 +1138,8 @@ func (sc *scProcessor) ProcessIfError(
 		return err
 	}
 
+	sc.saveSignalErrorLog(txHash, tx, returnMessage)
+
 	err = sc.processForRelayerWhenError(tx, txHash, returnMessage)
 	if err != nil {
 		return err
@@ -1136,6 +1157,22 @@ func (sc *scProcessor) ProcessIfError(
 	return nil
 }
 
+// saveSignalErrorLog records the failed execution in the transaction logs, so that the outcome of the transaction
+// can be told without parsing the generated smart contract results
+func (sc *scProcessor) saveSignalErrorLog(txHash []byte, tx data.TransactionHandler, returnMessage []byte) {
+	signalErrorLog := &vmcommon.LogEntry{
+		Identifier: []byte(core.SignalErrorOperation),
+		Address:    tx.GetRcvAddr(),
+		Topics:     [][]byte{tx.GetSndAddr()},
+		Data:       returnMessage,
+	}
+
+	ignorableError := sc.txLogsProcessor.SaveLog(txHash, tx, []*vmcommon.LogEntry{signalErrorLog})
+	if ignorableError != nil {
+		log.Debug("txLogsProcessor.SaveLog() error", "error", ignorableError.Error())
+	}
+}
+
 func (sc *scProcessor) processForRelayerWhenError(
 	originalTx data.TransactionHandler,
 	txHash []byte,
@@ -1818,7 +1855,13 @@ func (sc *scProcessor) isTransferWithNoAdditionalData(data []byte) bool {
 	if err != nil {
 		return false
 	}
+	if !sc.isBuiltInFunctionActive(function) {
+		return false
+	}
 
+	if function == core.BuiltInFunctionESDTMultiTransfer {
+		return len(args) == builtInFunctions.ComputeESDTMultiTransferNumArgs(args)
+	}
 	if function != core.BuiltInFunctionESDTTransfer {
 		return true
 	}
@@ -1826,6 +1869,24 @@ func (sc *scProcessor) isTransferWithNoAdditionalData(data []byte) bool {
 	return len(args) == 2
 }
 
+// isBuiltInFunctionActive returns false for the epoch gated built in functions before their activation, so that
+// they are treated as any unknown function, as before their registration
+func (sc *scProcessor) isBuiltInFunctionActive(function string) bool {
+	switch function {
+	case core.BuiltInFunctionESDTMultiTransfer:
+		return sc.flagESDTMultiTransfer.IsSet()
+	case core.BuiltInFunctionSetESDTRole,
+		core.BuiltInFunctionUnSetESDTRole,
+		core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity,
+		core.BuiltInFunctionESDTNFTBurn,
+		core.BuiltInFunctionESDTNFTTransfer:
+		return sc.flagESDTNFT.IsSet()
+	default:
+		return true
+	}
+}
+
 // createSCRForSender(vmOutput, tx, txHash, acntSnd)
 // give back the user the unused gas money
 func (sc *scProcessor) createSCRForSenderAndRelayer(
@@ -2240,6 +2301,12 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 
 	sc.flagRepairCallBackData.Toggle(epoch >= sc.repairCallBackEnableEpoch)
 	log.Debug("scProcessor: repair call back", "enabled", sc.flagRepairCallBackData.IsSet())
+
+	sc.flagESDTMultiTransfer.Toggle(epoch >= sc.esdtMultiTransferEnableEpoch)
+	log.Debug("scProcessor: ESDT multi transfer", "enabled", sc.flagESDTMultiTransfer.IsSet())
+
+	sc.flagESDTNFT.Toggle(epoch >= sc.esdtNFTEnableEpoch)
+	log.Debug("scProcessor: ESDT NFT", "enabled", sc.flagESDTNFT.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
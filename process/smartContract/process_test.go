@@ -3290,6 +3290,64 @@ func TestScProcessor_penalizeUserIfNeededShouldWorkOnFlagActivation(t *testing.T
 	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
 }
 
+func TestScProcessor_isCrossShardESDTTransferShouldWorkOnFlagActivation(t *testing.T) {
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = NewArgumentParser()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(5)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	arguments.ShardCoordinator = shardCoordinator
+	arguments.ESDTMultiTransferEnableEpoch = 1
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("sender1"),
+		RcvAddr: []byte("receiver2"),
+		Data:    []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@746f6b656e@0a"),
+	}
+	tx.SndAddr[len(tx.SndAddr)-1] = 1
+	tx.RcvAddr[len(tx.RcvAddr)-1] = 2
+
+	sc.EpochConfirmed(0)
+	_, isCrossShardESDTTransfer := sc.isCrossShardESDTTransfer(tx)
+	assert.False(t, isCrossShardESDTTransfer)
+
+	sc.EpochConfirmed(1)
+	returnData, isCrossShardESDTTransfer := sc.isCrossShardESDTTransfer(tx)
+	assert.True(t, isCrossShardESDTTransfer)
+	assert.Equal(t, core.BuiltInFunctionESDTMultiTransfer+"@01@746f6b656e@0a", returnData)
+}
+
+func TestScProcessor_isCrossShardESDTNFTTransferShouldWorkOnFlagActivation(t *testing.T) {
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = NewArgumentParser()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(5)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	arguments.ShardCoordinator = shardCoordinator
+	arguments.ESDTNFTEnableEpoch = 1
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("sender1"),
+		RcvAddr: []byte("receiver2"),
+		Data:    []byte(core.BuiltInFunctionESDTNFTTransfer + "@746f6b656e@01@0a@6164647265737332"),
+	}
+	tx.SndAddr[len(tx.SndAddr)-1] = 1
+	tx.RcvAddr[len(tx.RcvAddr)-1] = 2
+
+	sc.EpochConfirmed(0)
+	_, isCrossShardESDTTransfer := sc.isCrossShardESDTTransfer(tx)
+	assert.False(t, isCrossShardESDTTransfer)
+
+	sc.EpochConfirmed(1)
+	returnData, isCrossShardESDTTransfer := sc.isCrossShardESDTTransfer(tx)
+	assert.True(t, isCrossShardESDTTransfer)
+	assert.Equal(t, string(tx.Data), returnData)
+}
+
 func TestSCProcessor_createSCRWhenError(t *testing.T) {
 	arguments := createMockSmartContractProcessorArguments()
 	sc, _ := NewSmartContractProcessor(arguments)
@@ -3648,6 +3706,35 @@ func TestScProcessor_ProcessIfErrorRevertAccountFails(t *testing.T) {
 	require.Equal(t, expectedError, err)
 }
 
+func TestScProcessor_ProcessIfErrorShouldSaveSignalErrorLog(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	var savedLogs []*vmcommon.LogEntry
+	arguments.TxLogsProcessor = &mock.TxLogsProcessorStub{
+		SaveLogCalled: func(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error {
+			savedLogs = vmLogs
+			return nil
+		},
+	}
+
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("snd"),
+		RcvAddr: []byte("rcv"),
+		Value:   big.NewInt(15),
+	}
+
+	err := sc.ProcessIfError(&mock.UserAccountStub{}, []byte("txHash"), tx, "0", []byte("message"), 1, 100)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(savedLogs))
+	require.Equal(t, []byte(core.SignalErrorOperation), savedLogs[0].Identifier)
+	require.Equal(t, tx.RcvAddr, savedLogs[0].Address)
+	require.Equal(t, [][]byte{tx.SndAddr}, savedLogs[0].Topics)
+	require.Equal(t, []byte("message"), savedLogs[0].Data)
+}
+
 func TestProcessIfErrorCheckBackwardsCompatibilityProcessTransactionFeeCalledShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
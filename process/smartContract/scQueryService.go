@@ -1,57 +1,111 @@
 package smartContract
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/pkg/errors"
 )
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
+// maxHeadersToSearchForRootHash bounds the number of past headers inspected when resolving the header of a query
+// executed on an explicit root hash. Older states are pruned on most nodes anyway, and can still be queried by
+// providing the block nonce or the block hash instead
+const maxHeadersToSearchForRootHash = 50
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer    process.VirtualMachinesContainer
-	economicsFee   process.FeeHandler
-	mutRunSc       sync.Mutex
-	blockChainHook process.BlockChainHookHandler
-	blockChain     data.ChainHandler
-	numQueries     int
+	vmContainer     process.VirtualMachinesContainer
+	economicsFee    process.FeeHandler
+	mutRunSc        sync.Mutex
+	blockChainHook  process.BlockChainHookHandler
+	blockChain      data.ChainHandler
+	numQueries      int
+	accounts        state.AccountsAdapter
+	accountsTrie    data.Trie
+	storageService  dataRetriever.StorageService
+	marshalizer     marshal.Marshalizer
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+	shardID         uint32
+	currentRootHash []byte
+}
+
+// ArgsNewSCQueryService defines the arguments needed for the sc query service
+type ArgsNewSCQueryService struct {
+	VmContainer    process.VirtualMachinesContainer
+	EconomicsFee   process.FeeHandler
+	BlockChainHook process.BlockChainHookHandler
+	BlockChain     data.ChainHandler
+	// Accounts is optional. When provided, it must be the accounts adapter used by the blockchain hook and it must
+	// not be shared with the processing components, as its trie is recreated on the state each query is executed on.
+	// Only in this case the queries against a past state are supported
+	Accounts state.AccountsAdapter
+	// AccountsTrie is required together with Accounts. It is the trie sharing the trie storage with the processing
+	// components and is used to hold the pruning while a query is executed, so that the queried state is not removed
+	// from storage in the meantime
+	AccountsTrie             data.Trie
+	StorageService           dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardID                  uint32
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
-func NewSCQueryService(
-	vmContainer process.VirtualMachinesContainer,
-	economicsFee process.FeeHandler,
-	blockChainHook process.BlockChainHookHandler,
-	blockChain data.ChainHandler,
-) (*SCQueryService, error) {
-	if check.IfNil(vmContainer) {
+func NewSCQueryService(args ArgsNewSCQueryService) (*SCQueryService, error) {
+	if check.IfNil(args.VmContainer) {
 		return nil, process.ErrNoVM
 	}
-	if check.IfNil(economicsFee) {
+	if check.IfNil(args.EconomicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
-	if check.IfNil(blockChainHook) {
+	if check.IfNil(args.BlockChainHook) {
 		return nil, process.ErrNilBlockChainHook
 	}
-	if check.IfNil(blockChain) {
+	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if !check.IfNil(args.Accounts) {
+		if check.IfNil(args.AccountsTrie) {
+			return nil, process.ErrNilTrie
+		}
+		if check.IfNil(args.StorageService) {
+			return nil, process.ErrNilStorage
+		}
+		if check.IfNil(args.Marshalizer) {
+			return nil, process.ErrNilMarshalizer
+		}
+		if check.IfNil(args.Uint64ByteSliceConverter) {
+			return nil, process.ErrNilUint64Converter
+		}
+	}
 
 	return &SCQueryService{
-		vmContainer:    vmContainer,
-		economicsFee:   economicsFee,
-		blockChain:     blockChain,
-		blockChainHook: blockChainHook,
+		vmContainer:     args.VmContainer,
+		economicsFee:    args.EconomicsFee,
+		blockChain:      args.BlockChain,
+		blockChainHook:  args.BlockChainHook,
+		accounts:        args.Accounts,
+		accountsTrie:    args.AccountsTrie,
+		storageService:  args.StorageService,
+		marshalizer:     args.Marshalizer,
+		uint64Converter: args.Uint64ByteSliceConverter,
+		shardID:         args.ShardID,
 	}, nil
 }
 
@@ -64,17 +118,37 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 		return nil, process.ErrEmptyFunctionName
 	}
 
+	// the header of a historical query is resolved before taking the lock and holding the pruning, as it might
+	// need to load several headers from storage
+	historicalHeader, err := service.getHistoricalHeader(query)
+	if err != nil {
+		return nil, err
+	}
+
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	return service.executeScCall(query, 0)
+	return service.executeScCall(query, historicalHeader, 0)
 }
 
-func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+func (service *SCQueryService) executeScCall(
+	query *process.SCQuery,
+	historicalHeader data.HeaderHandler,
+	gasPrice uint64,
+) (*vmcommon.VMOutput, error) {
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	if !check.IfNil(service.accountsTrie) {
+		service.accountsTrie.EnterPruningBufferingMode()
+		defer service.accountsTrie.ExitPruningBufferingMode()
+	}
+
+	header, err := service.prepareState(historicalHeader)
+	if err != nil {
+		return nil, err
+	}
+	service.blockChainHook.SetCurrentHeader(header)
 
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -105,6 +179,149 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+// prepareState recreates, if needed, the accounts trie on the state the query should be executed on and returns the
+// header that should be used as the current header by the blockchain hook. A nil historical header selects the
+// current state
+func (service *SCQueryService) prepareState(historicalHeader data.HeaderHandler) (data.HeaderHandler, error) {
+	if check.IfNil(service.accounts) {
+		return service.blockChain.GetCurrentBlockHeader(), nil
+	}
+
+	header := historicalHeader
+	if check.IfNil(header) {
+		header = service.blockChain.GetCurrentBlockHeader()
+	}
+	if check.IfNil(header) {
+		header = service.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, process.ErrNilHeaderHandler
+	}
+
+	err := service.recreateTrie(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// getHistoricalHeader returns the header selected by the block nonce, the block hash or the root hash of the query,
+// or nil if the query should be executed on the current state
+func (service *SCQueryService) getHistoricalHeader(query *process.SCQuery) (data.HeaderHandler, error) {
+	isHistoricalQuery, err := checkHistoricalQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if !isHistoricalQuery {
+		return nil, nil
+	}
+	if check.IfNil(service.accounts) {
+		return nil, process.ErrHistoricalQueriesNotSupported
+	}
+
+	if query.BlockNonce.HasValue {
+		header, _, errGet := process.GetHeaderFromStorageWithNonce(
+			query.BlockNonce.Value,
+			service.shardID,
+			service.storageService,
+			service.uint64Converter,
+			service.marshalizer,
+		)
+		return header, errGet
+	}
+
+	if len(query.BlockHash) > 0 {
+		return service.getHeaderFromStorage(query.BlockHash)
+	}
+
+	return service.getHeaderWithRootHash(query.RootHash)
+}
+
+func checkHistoricalQuery(query *process.SCQuery) (bool, error) {
+	numOptions := 0
+	if query.BlockNonce.HasValue {
+		numOptions++
+	}
+	if len(query.BlockHash) > 0 {
+		numOptions++
+	}
+	if len(query.RootHash) > 0 {
+		numOptions++
+	}
+	if numOptions > 1 {
+		return false, process.ErrInvalidHistoricalQuery
+	}
+
+	return numOptions == 1, nil
+}
+
+// getHeaderWithRootHash searches, starting with the current header and going backwards, the most recent header
+// committed on the provided root hash, so that the blockchain hook exposes the block information matching the state
+func (service *SCQueryService) getHeaderWithRootHash(rootHash []byte) (data.HeaderHandler, error) {
+	header := service.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = service.blockChain.GetGenesisHeader()
+		if !check.IfNil(header) && bytes.Equal(header.GetRootHash(), rootHash) {
+			return header, nil
+		}
+
+		return nil, process.ErrNoHeaderForRootHash
+	}
+
+	for i := 0; i < maxHeadersToSearchForRootHash; i++ {
+		if bytes.Equal(header.GetRootHash(), rootHash) {
+			return header, nil
+		}
+		if header.GetNonce() == 0 {
+			break
+		}
+
+		var err error
+		header, _, err = process.GetHeaderFromStorageWithNonce(
+			header.GetNonce()-1,
+			service.shardID,
+			service.storageService,
+			service.uint64Converter,
+			service.marshalizer,
+		)
+		if err != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", process.ErrNoHeaderForRootHash, hex.EncodeToString(rootHash))
+}
+
+func (service *SCQueryService) getHeaderFromStorage(hash []byte) (data.HeaderHandler, error) {
+	if service.shardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(hash, service.marshalizer, service.storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(hash, service.marshalizer, service.storageService)
+}
+
+// recreateTrie recreates the accounts trie on the provided root hash. The state must still be available in storage,
+// so only the states that were not pruned yet (the ones of the recent blocks, unless the pruning is disabled) can be
+// queried. Once the trie is recreated, the pruning is held by executeScCall until the query is finished
+func (service *SCQueryService) recreateTrie(rootHash []byte) error {
+	// the trie is always recreated when the previous query was executed on another state as any changes done by the
+	// previous query (e.g. by the built in functions) have to be discarded
+	if bytes.Equal(service.currentRootHash, rootHash) && service.accounts.JournalLen() == 0 {
+		return nil
+	}
+
+	err := service.accounts.RecreateTrie(rootHash)
+	if err != nil {
+		service.currentRootHash = nil
+		return fmt.Errorf("%w for root hash %s: %s", process.ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	service.currentRootHash = rootHash
+
+	return nil
+}
+
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
 	if query.CallerAddr == nil {
 		query.CallerAddr = query.ScAddress
@@ -168,7 +385,7 @@ func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	vmOutput, err := service.executeScCall(query, 1)
+	vmOutput, err := service.executeScCall(query, nil, 1)
 	if err != nil {
 		return 0, err
 	}
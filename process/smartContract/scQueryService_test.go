@@ -2,6 +2,8 @@ package smartContract
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -9,10 +11,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +31,12 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:    nil,
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -31,7 +45,12 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:    &mock.VMContainerMock{},
+		EconomicsFee:   nil,
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -40,7 +59,12 @@ func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:    &mock.VMContainerMock{},
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -50,7 +74,12 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:    &mock.VMContainerMock{},
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -67,7 +96,12 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer:    &mock.VMContainerMock{},
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -103,20 +137,20 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	dataArgs := make([][]byte, len(args))
 	for i, arg := range args {
@@ -146,20 +180,20 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -185,20 +219,20 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 			}, nil
 		},
 	}
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -235,20 +269,20 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	noOfGoRoutines := 50
 	wg := sync.WaitGroup{}
@@ -285,20 +319,20 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -329,20 +363,20 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress:  []byte(DummyScAddress),
@@ -370,20 +404,20 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	tx := &transaction.Transaction{
 		RcvAddr: []byte(DummyScAddress),
@@ -393,3 +427,328 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func createMockArgsForHistoricalQueries(
+	accounts *mock.AccountsStub,
+	hook *mock.BlockChainHookHandlerMock,
+	storageService dataRetriever.StorageService,
+) ArgsNewSCQueryService {
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+
+	return ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: hook,
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 10, RootHash: []byte("current root hash")}
+			},
+		},
+		Accounts:                 accounts,
+		AccountsTrie:             &mock.TrieStub{},
+		StorageService:           storageService,
+		Marshalizer:              &marshal.GogoProtoMarshalizer{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardID:                  0,
+	}
+}
+
+func TestNewSCQueryService_AccountsWithNilStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForHistoricalQueries(&mock.AccountsStub{}, &mock.BlockChainHookHandlerMock{}, nil)
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewSCQueryService_AccountsWithNilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForHistoricalQueries(&mock.AccountsStub{}, &mock.BlockChainHookHandlerMock{}, &mock.ChainStorerMock{})
+	args.AccountsTrie = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilTrie, err)
+}
+
+func TestExecuteQuery_HistoricalQueryWithoutAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForHistoricalQueries(nil, &mock.BlockChainHookHandlerMock{}, nil)
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: core.OptionalUint64{Value: 5, HasValue: true},
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+}
+
+func TestExecuteQuery_MultipleHistoricalOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForHistoricalQueries(&mock.AccountsStub{}, &mock.BlockChainHookHandlerMock{}, &mock.ChainStorerMock{})
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		BlockHash: []byte("hash"),
+		RootHash:  []byte("root hash"),
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Equal(t, process.ErrInvalidHistoricalQuery, err)
+}
+
+func TestExecuteQuery_CurrentStateShouldRecreateTrieOncePerRootHash(t *testing.T) {
+	t.Parallel()
+
+	recreatedRootHashes := make([][]byte, 0)
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHashes = append(recreatedRootHashes, rootHash)
+			return nil
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, &mock.BlockChainHookHandlerMock{}, &mock.ChainStorerMock{})
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+	_, err := target.ExecuteQuery(&query)
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&query)
+	require.Nil(t, err)
+
+	assert.Equal(t, [][]byte{[]byte("current root hash")}, recreatedRootHashes)
+}
+
+func TestExecuteQuery_BlockNonceShouldExecuteOnThatBlockState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	blockHash := []byte("block hash")
+	historicalHeader := &block.Header{Nonce: 5, RootHash: []byte("historical root hash")}
+	headerBytes, _ := marshalizer.Marshal(historicalHeader)
+	storageService := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.ShardHdrNonceHashDataUnit && bytes.Equal(key, converter.ToByteSlice(5)) {
+						return blockHash, nil
+					}
+					if unitType == dataRetriever.BlockHeaderUnit && bytes.Equal(key, blockHash) {
+						return headerBytes, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+	}
+
+	var recreatedRootHash []byte
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	var currentHeader data.HeaderHandler
+	hook := &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeader = hdr
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, hook, storageService)
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: core.OptionalUint64{Value: 5, HasValue: true},
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, historicalHeader.RootHash, recreatedRootHash)
+	assert.Equal(t, historicalHeader.Nonce, currentHeader.GetNonce())
+}
+
+func TestExecuteQuery_PrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return errors.New("missing trie node")
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, &mock.BlockChainHookHandlerMock{}, &mock.ChainStorerMock{})
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("current root hash"),
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.True(t, errors.Is(err, process.ErrStateNotAvailable))
+}
+
+func TestExecuteQuery_RootHashShouldExecuteWithTheMatchingHeader(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	headers := map[uint64]*block.Header{
+		9: {Nonce: 9, RootHash: []byte("current root hash")},
+		8: {Nonce: 8, RootHash: []byte("historical root hash")},
+	}
+	storageService := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.ShardHdrNonceHashDataUnit {
+						nonce, _ := converter.ToUint64(key)
+						return []byte(fmt.Sprintf("hash %d", nonce)), nil
+					}
+					for nonce, header := range headers {
+						if unitType == dataRetriever.BlockHeaderUnit && string(key) == fmt.Sprintf("hash %d", nonce) {
+							return marshalizer.Marshal(header)
+						}
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+	}
+
+	var recreatedRootHash []byte
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	var currentHeader data.HeaderHandler
+	hook := &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeader = hdr
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, hook, storageService)
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("historical root hash"),
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("historical root hash"), recreatedRootHash)
+	assert.Equal(t, uint64(8), currentHeader.GetNonce())
+}
+
+func TestExecuteQuery_RootHashWithoutMatchingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storageService := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return nil, errors.New("not found")
+				},
+			}
+		},
+	}
+	recreateCalled := false
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreateCalled = true
+			return nil
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, &mock.BlockChainHookHandlerMock{}, storageService)
+	pruningHeld := false
+	args.AccountsTrie = &mock.TrieStub{
+		EnterPruningBufferingModeCalled: func() {
+			pruningHeld = true
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("unknown root hash"),
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.True(t, errors.Is(err, process.ErrNoHeaderForRootHash))
+	assert.False(t, recreateCalled)
+	assert.False(t, pruningHeld)
+}
+
+func TestExecuteQuery_ShouldHoldThePruningWhileExecuting(t *testing.T) {
+	t.Parallel()
+
+	numHeldOperations := 0
+	accounts := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			assert.Equal(t, 1, numHeldOperations)
+			return nil
+		},
+	}
+	args := createMockArgsForHistoricalQueries(accounts, &mock.BlockChainHookHandlerMock{}, &mock.ChainStorerMock{})
+	args.AccountsTrie = &mock.TrieStub{
+		EnterPruningBufferingModeCalled: func() {
+			numHeldOperations++
+		},
+		ExitPruningBufferingModeCalled: func() {
+			numHeldOperations--
+		},
+	}
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			assert.Equal(t, 1, numHeldOperations)
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numHeldOperations)
+}
@@ -0,0 +1,95 @@
+package boltdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+// batch keeps the pending writes and the pending removals in separate maps so that a stored value can
+// never be mistaken for a removal marker
+type batch struct {
+	cachedData  map[string][]byte
+	removedKeys map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		cachedData:  make(map[string][]byte),
+		removedKeys: make(map[string]struct{}),
+		mutBatch:    sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.cachedData[string(key)] = val
+	delete(b.removedKeys, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	delete(b.cachedData, string(key))
+	b.removedKeys[string(key)] = struct{}{}
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.cachedData = make(map[string][]byte)
+	b.removedKeys = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) isRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, isRemoved := b.removedKeys[string(key)]
+	return isRemoved
+}
+
+// writeTo applies all the batched operations on the provided bucket
+func (b *batch) writeTo(bucket *bolt.Bucket) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for key := range b.removedKeys {
+		err := bucket.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+	}
+	for key, val := range b.cachedData {
+		err := bucket.Put([]byte(key), val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
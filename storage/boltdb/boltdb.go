@@ -0,0 +1,305 @@
+package boltdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// read + write for owner only
+const rwOwner = 0600
+
+const dbFileName = "data.db"
+const openTimeout = 10 * time.Second
+
+var bucketName = []byte("data")
+
+var log = logger.GetOrCreate("storage/boltdb")
+
+// DB holds a pointer to the bbolt database and the path to where it is stored.
+// All the key-value pairs are kept in a single bucket of a single file, in the directory given as path
+type DB struct {
+	db                *bolt.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             storage.Batcher
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+}
+
+// NewDB is a constructor for the bbolt persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (*DB, error) {
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &bolt.Options{
+		Timeout:      openTimeout,
+		FreelistType: bolt.FreelistMapType,
+	}
+	db, err := bolt.Open(filepath.Join(path, dbFileName), rwOwner, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, errCreate := tx.CreateBucketIfNotExists(bucketName)
+		return errCreate
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		dbClosed:          make(chan struct{}),
+	}
+
+	dbStore.batch = dbStore.createBatch()
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.writeTimedBatch()
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) writeTimedBatch() {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("boltdb putBatch", "error", err.Error())
+		return
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("boltdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutBatch.RLock()
+	data, isRemoved := s.getFromBatch(key)
+	s.mutBatch.RUnlock()
+	if isRemoved {
+		return nil, storage.ErrKeyNotFound
+	}
+	if data != nil {
+		return data, nil
+	}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		val := tx.Bucket(bucketName).Get(key)
+		if val == nil {
+			return nil
+		}
+
+		// the value returned by bbolt is only valid during the transaction
+		data = make([]byte, len(val))
+		copy(data, val)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return data, nil
+}
+
+func (s *DB) getFromBatch(key []byte) ([]byte, bool) {
+	dbBatch, ok := s.batch.(*batch)
+	if !ok {
+		return s.batch.Get(key), false
+	}
+	if dbBatch.isRemoved(key) {
+		return nil, true
+	}
+
+	return dbBatch.Get(key), false
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// createBatch returns a batcher to be used for batch writing data to the database
+func (s *DB) createBatch() storage.Batcher {
+	return NewBatch()
+}
+
+// putBatch writes the Batch data into the database, in a single transaction
+func (s *DB) putBatch(b storage.Batcher) error {
+	dbBatch, ok := b.(*batch)
+	if !ok {
+		return storage.ErrInvalidBatch
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return dbBatch.writeTo(tx.Bucket(bucketName))
+	})
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bucketName).Cursor()
+		for key, val := cursor.First(); key != nil; key, val = cursor.Next() {
+			clonedKey := make([]byte, len(key))
+			copy(clonedKey, key)
+
+			clonedVal := make([]byte, len(val))
+			copy(clonedVal, val)
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("boltdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	err := s.db.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,271 @@
+package boltdb_test
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBoltDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) (p *boltdb.DB) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	bdb, err := boltdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+
+	assert.Nil(t, err, "Failed creating boltdb database file")
+	return bdb
+}
+
+func TestNewDB_ShouldWork(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	assert.False(t, check.IfNil(bdb))
+	assert.Nil(t, bdb.Init())
+}
+
+func TestDB_DoubleOpenButClosedInTimeShouldWork(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	bdb1, err := boltdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	go func() {
+		_ = bdb1.Close()
+	}()
+
+	bdb2, err := boltdb.NewDB(dir, 10, 1)
+	assert.Nil(t, err)
+	assert.NotNil(t, bdb2)
+
+	_ = bdb2.Close()
+}
+
+func TestDB_GetAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	assert.False(t, bdb.IsBatchEmpty())
+
+	bdb.WriteTimedBatch()
+	assert.True(t, bdb.IsBatchEmpty())
+
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	v, err := bdb.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_HasPresent(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Put(key, val)
+
+	assert.Nil(t, bdb.Has(key))
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has([]byte("missing key")))
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Put(key, val)
+	_ = bdb.Remove(key)
+	bdb.WriteTimedBatch()
+	assert.True(t, bdb.IsBatchEmpty())
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemovePresentAfterWriteOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Put(key, val)
+	assert.Nil(t, bdb.Has(key))
+
+	err := bdb.Remove(key)
+	assert.Nil(t, err)
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has(key))
+}
+
+func TestDB_PutAfterRemoveInSameBatchShouldKeepValue(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key := []byte("key")
+	bdb, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	_ = bdb.Put(key, []byte("value1"))
+	_ = bdb.Remove(key)
+	_ = bdb.Put(key, []byte("value2"))
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), v)
+
+	_ = bdb.Close()
+	reopened, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	v, err = reopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), v)
+}
+
+func TestDB_CloseShouldWriteBatch(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	bdb, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	_ = bdb.Put(key, val)
+	_ = bdb.Remove([]byte("other key"))
+	err = bdb.Close()
+	require.Nil(t, err)
+
+	reopened, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	v, err := reopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	bdb, err := boltdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	err = bdb.Destroy()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+		"key5": []byte("value5"),
+		"key6": []byte("value6"),
+		"key7": []byte("value7"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+	assert.True(t, bdb.IsBatchEmpty())
+
+	recovered := make(map[string][]byte)
+
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+
+	numIterated := 0
+	bdb.RangeKeys(func(key []byte, val []byte) bool {
+		numIterated++
+		return false
+	})
+	assert.Equal(t, 1, numIterated)
+}
+
+func TestDB_PutGetLargeValue(t *testing.T) {
+	t.Parallel()
+
+	buffLargeValue := make([]byte, 32*1000000) //equivalent to ~1000000 hashes
+	key := []byte("key")
+	_, _ = rand.Read(buffLargeValue)
+
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, buffLargeValue)
+	assert.Nil(t, err)
+	assert.True(t, bdb.IsBatchEmpty())
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+
+	assert.Equal(t, buffLargeValue, recovered)
+}
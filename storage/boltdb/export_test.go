@@ -0,0 +1,14 @@
+package boltdb
+
+// WriteTimedBatch -
+func (s *DB) WriteTimedBatch() {
+	s.writeTimedBatch()
+}
+
+// IsBatchEmpty -
+func (s *DB) IsBatchEmpty() bool {
+	s.mutBatch.RLock()
+	defer s.mutBatch.RUnlock()
+
+	return s.sizeBatch == 0
+}
@@ -0,0 +1,374 @@
+package diskusage
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+var log = logger.GetOrCreate("storage/diskusage")
+
+var invalidMetricCharacters = regexp.MustCompile("[^a-zA-Z0-9_]")
+
+// ArgsCollector holds the arguments needed to create a disk usage collector. The database path is the directory
+// holding the epoch and the static directories, as generated by the path manager:
+// <DatabasePath>/<EpochDirPrefix>_<epoch>/<ShardDirPrefix>_<shard>/<unit> and
+// <DatabasePath>/<StaticDirName>/<ShardDirPrefix>_<shard>/<unit>
+type ArgsCollector struct {
+	DatabasePath     string
+	EpochDirPrefix   string
+	ShardDirPrefix   string
+	StaticDirName    string
+	AppStatusHandler core.AppStatusHandler
+}
+
+type collector struct {
+	databasePath     string
+	epochDirPrefix   string
+	shardDirPrefix   string
+	staticDirName    string
+	appStatusHandler core.AppStatusHandler
+	mutCollect       sync.Mutex
+	reportedMetrics  map[string]struct{}
+	mutUsage         sync.RWMutex
+	lastUsage        *api.StorageUsage
+	mutCancel        sync.Mutex
+	cancelFunc       context.CancelFunc
+}
+
+// NewCollector creates a new disk usage collector
+func NewCollector(args ArgsCollector) (*collector, error) {
+	if len(args.DatabasePath) == 0 {
+		return nil, ErrEmptyDatabasePath
+	}
+	if len(args.EpochDirPrefix) == 0 || len(args.ShardDirPrefix) == 0 || len(args.StaticDirName) == 0 {
+		return nil, ErrEmptyDirectoryName
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	return &collector{
+		databasePath:     args.DatabasePath,
+		epochDirPrefix:   args.EpochDirPrefix + "_",
+		shardDirPrefix:   args.ShardDirPrefix + "_",
+		staticDirName:    args.StaticDirName,
+		appStatusHandler: args.AppStatusHandler,
+		reportedMetrics:  make(map[string]struct{}),
+	}, nil
+}
+
+// Collect computes the disk space used by each storage unit, per epoch and per shard, and reports it in the status
+// metrics. The directories which do not follow the path manager layout are accounted as other bytes
+func (c *collector) Collect() (*api.StorageUsage, error) {
+	c.mutCollect.Lock()
+	defer c.mutCollect.Unlock()
+
+	usage := &api.StorageUsage{
+		Timestamp: time.Now().Unix(),
+		Shards:    make([]*api.ShardStorageUsage, 0),
+	}
+	entries, err := ioutil.ReadDir(c.databasePath)
+	if os.IsNotExist(err) {
+		c.reportMetrics(usage)
+		c.setLastUsage(usage)
+		return usage, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make(map[string]*api.ShardStorageUsage)
+	for _, entry := range entries {
+		entryPath := filepath.Join(c.databasePath, entry.Name())
+		if !entry.IsDir() {
+			usage.OtherBytes += uint64(entry.Size())
+			continue
+		}
+
+		if entry.Name() == c.staticDirName {
+			usage.OtherBytes += c.collectShards(entryPath, shards, addStaticUnit)
+			continue
+		}
+
+		epoch, isEpochDir := c.parseEpoch(entry.Name())
+		if !isEpochDir {
+			usage.OtherBytes += directorySize(entryPath)
+			continue
+		}
+
+		usage.OtherBytes += c.collectShards(entryPath, shards, func(shardUsage *api.ShardStorageUsage, unit *api.UnitStorageUsage) {
+			addEpochUnit(shardUsage, epoch, unit)
+		})
+	}
+
+	usage.TotalBytes = usage.OtherBytes
+	for _, shardUsage := range shards {
+		sortShardUsage(shardUsage)
+		usage.Shards = append(usage.Shards, shardUsage)
+		usage.TotalBytes += shardUsage.TotalBytes
+	}
+	sort.Slice(usage.Shards, func(i, j int) bool {
+		return usage.Shards[i].Shard < usage.Shards[j].Shard
+	})
+
+	c.reportMetrics(usage)
+	c.setLastUsage(usage)
+	log.Debug("storage usage collected", "total", core.ConvertBytes(usage.TotalBytes), "path", c.databasePath)
+
+	return usage, nil
+}
+
+func (c *collector) setLastUsage(usage *api.StorageUsage) {
+	c.mutUsage.Lock()
+	c.lastUsage = usage
+	c.mutUsage.Unlock()
+}
+
+// LastUsage returns the storage usage computed by the last collection, without walking the storage directories
+func (c *collector) LastUsage() (*api.StorageUsage, error) {
+	c.mutUsage.RLock()
+	defer c.mutUsage.RUnlock()
+
+	if c.lastUsage == nil {
+		return nil, ErrStorageUsageNotCollected
+	}
+
+	return c.lastUsage, nil
+}
+
+func (c *collector) parseEpoch(dirName string) (uint32, bool) {
+	if !strings.HasPrefix(dirName, c.epochDirPrefix) {
+		return 0, false
+	}
+
+	epoch, err := strconv.ParseUint(strings.TrimPrefix(dirName, c.epochDirPrefix), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint32(epoch), true
+}
+
+// collectShards walks the shard directories found in the provided path, calling the handler for each storage unit.
+// It returns the size of the entries which are not shard directories
+func (c *collector) collectShards(
+	path string,
+	shards map[string]*api.ShardStorageUsage,
+	handler func(shardUsage *api.ShardStorageUsage, unit *api.UnitStorageUsage),
+) uint64 {
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		log.Debug("cannot read storage directory", "path", path, "error", err)
+		return 0
+	}
+
+	otherBytes := uint64(0)
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), c.shardDirPrefix) {
+			otherBytes += entrySize(entryPath, entry)
+			continue
+		}
+
+		shard := strings.TrimPrefix(entry.Name(), c.shardDirPrefix)
+		shardUsage, found := shards[shard]
+		if !found {
+			shardUsage = &api.ShardStorageUsage{
+				Shard:  shard,
+				Static: make([]*api.UnitStorageUsage, 0),
+				Epochs: make([]*api.EpochStorageUsage, 0),
+			}
+			shards[shard] = shardUsage
+		}
+
+		units, errRead := ioutil.ReadDir(entryPath)
+		if errRead != nil {
+			log.Debug("cannot read storage directory", "path", entryPath, "error", errRead)
+			continue
+		}
+		for _, unitEntry := range units {
+			unit := &api.UnitStorageUsage{
+				Unit:  unitEntry.Name(),
+				Bytes: entrySize(filepath.Join(entryPath, unitEntry.Name()), unitEntry),
+			}
+			shardUsage.TotalBytes += unit.Bytes
+			handler(shardUsage, unit)
+		}
+	}
+
+	return otherBytes
+}
+
+func addStaticUnit(shardUsage *api.ShardStorageUsage, unit *api.UnitStorageUsage) {
+	shardUsage.Static = append(shardUsage.Static, unit)
+	shardUsage.StaticBytes += unit.Bytes
+}
+
+func addEpochUnit(shardUsage *api.ShardStorageUsage, epoch uint32, unit *api.UnitStorageUsage) {
+	var epochUsage *api.EpochStorageUsage
+	for _, existing := range shardUsage.Epochs {
+		if existing.Epoch == epoch {
+			epochUsage = existing
+			break
+		}
+	}
+	if epochUsage == nil {
+		epochUsage = &api.EpochStorageUsage{
+			Epoch: epoch,
+			Units: make([]*api.UnitStorageUsage, 0),
+		}
+		shardUsage.Epochs = append(shardUsage.Epochs, epochUsage)
+	}
+
+	epochUsage.Units = append(epochUsage.Units, unit)
+	epochUsage.TotalBytes += unit.Bytes
+}
+
+func sortShardUsage(shardUsage *api.ShardStorageUsage) {
+	sortUnits(shardUsage.Static)
+	sort.Slice(shardUsage.Epochs, func(i, j int) bool {
+		return shardUsage.Epochs[i].Epoch < shardUsage.Epochs[j].Epoch
+	})
+	for _, epochUsage := range shardUsage.Epochs {
+		sortUnits(epochUsage.Units)
+	}
+}
+
+func sortUnits(units []*api.UnitStorageUsage) {
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].Unit < units[j].Unit
+	})
+}
+
+// reportMetrics sets the storage usage metrics. The metrics reported by a previous collection and no longer found
+// (e.g. the ones of a removed epoch) are set to 0
+func (c *collector) reportMetrics(usage *api.StorageUsage) {
+	metrics := make(map[string]uint64)
+	metrics[core.MetricStorageTotalSize] = usage.TotalBytes
+	for _, shardUsage := range usage.Shards {
+		shardKey := metricKey(core.MetricStorageSizePrefix, "shard", shardUsage.Shard)
+		metrics[shardKey] = shardUsage.TotalBytes
+
+		staticKey := metricKey(shardKey, "static")
+		metrics[staticKey] = shardUsage.StaticBytes
+		for _, unit := range shardUsage.Static {
+			metrics[metricKey(staticKey, unit.Unit)] = unit.Bytes
+		}
+
+		for _, epochUsage := range shardUsage.Epochs {
+			epochKey := metricKey(shardKey, "epoch", fmt.Sprintf("%d", epochUsage.Epoch))
+			metrics[epochKey] = epochUsage.TotalBytes
+			for _, unit := range epochUsage.Units {
+				metrics[metricKey(epochKey, unit.Unit)] = unit.Bytes
+			}
+		}
+	}
+
+	for key := range c.reportedMetrics {
+		_, stillReported := metrics[key]
+		if !stillReported {
+			c.appStatusHandler.SetUInt64Value(key, 0)
+		}
+	}
+	for key, value := range metrics {
+		c.appStatusHandler.SetUInt64Value(key, value)
+		c.reportedMetrics[key] = struct{}{}
+	}
+}
+
+// StartCollecting will periodically collect the storage usage until Close is called
+func (c *collector) StartCollecting(interval time.Duration) error {
+	if interval <= 0 {
+		return ErrInvalidCollectInterval
+	}
+
+	c.mutCancel.Lock()
+	defer c.mutCancel.Unlock()
+
+	if c.cancelFunc != nil {
+		c.cancelFunc()
+	}
+	var ctx context.Context
+	ctx, c.cancelFunc = context.WithCancel(context.Background())
+
+	go c.collectLoop(ctx, interval)
+
+	return nil
+}
+
+func (c *collector) collectLoop(ctx context.Context, interval time.Duration) {
+	for {
+		_, err := c.Collect()
+		if err != nil {
+			log.Debug("cannot collect the storage usage", "error", err)
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			log.Debug("closing the storage usage collecting loop")
+			return
+		}
+	}
+}
+
+// Close stops the periodic collection, if started
+func (c *collector) Close() error {
+	c.mutCancel.Lock()
+	defer c.mutCancel.Unlock()
+
+	if c.cancelFunc != nil {
+		c.cancelFunc()
+		c.cancelFunc = nil
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *collector) IsInterfaceNil() bool {
+	return c == nil
+}
+
+func metricKey(parts ...string) string {
+	return invalidMetricCharacters.ReplaceAllString(strings.Join(parts, "_"), "_")
+}
+
+func entrySize(path string, info os.FileInfo) uint64 {
+	if !info.IsDir() {
+		return uint64(info.Size())
+	}
+
+	return directorySize(path)
+}
+
+func directorySize(path string) uint64 {
+	size := uint64(0)
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the databases can be removed while they are walked (e.g. the old epochs), so the errors are ignored
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size
+}
@@ -0,0 +1,254 @@
+package diskusage_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/diskusage"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsRecorder struct {
+	mut     sync.Mutex
+	metrics map[string]uint64
+}
+
+func newMetricsRecorder() *metricsRecorder {
+	return &metricsRecorder{
+		metrics: make(map[string]uint64),
+	}
+}
+
+func (mr *metricsRecorder) statusHandler() *mock.AppStatusHandlerStub {
+	return &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mr.mut.Lock()
+			mr.metrics[key] = value
+			mr.mut.Unlock()
+		},
+	}
+}
+
+func (mr *metricsRecorder) get(key string) (uint64, bool) {
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	value, ok := mr.metrics[key]
+	return value, ok
+}
+
+func createArgsCollector(databasePath string) diskusage.ArgsCollector {
+	return diskusage.ArgsCollector{
+		DatabasePath:     databasePath,
+		EpochDirPrefix:   "Epoch",
+		ShardDirPrefix:   "Shard",
+		StaticDirName:    "Static",
+		AppStatusHandler: newMetricsRecorder().statusHandler(),
+	}
+}
+
+func writeFile(t *testing.T, size int, pathElements ...string) {
+	path := filepath.Join(pathElements...)
+	require.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.Nil(t, ioutil.WriteFile(path, make([]byte, size), os.ModePerm))
+}
+
+func createDatabaseLayout(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "disk_usage_temp")
+	require.Nil(t, err)
+
+	writeFile(t, 100, dir, "Epoch_0", "Shard_0", "BlockHeaders", "000001.ldb")
+	writeFile(t, 50, dir, "Epoch_0", "Shard_0", "BlockHeaders", "LOG")
+	writeFile(t, 200, dir, "Epoch_0", "Shard_0", "Transactions", "000001.ldb")
+	writeFile(t, 300, dir, "Epoch_1", "Shard_0", "Transactions", "000002.ldb")
+	writeFile(t, 1000, dir, "Static", "Shard_0", "AccountsTrie", "MainDB", "000003.ldb")
+	writeFile(t, 10, dir, "Static", "Shard_0", "TrieSnapshot", "0", "000001.ldb")
+	writeFile(t, 5, dir, "Static", "Shard_metachain", "PeerAccountsTrie", "000001.ldb")
+	writeFile(t, 7, dir, "Epoch_x", "Shard_0", "Transactions", "000001.ldb")
+	writeFile(t, 3, dir, "Epoch_1", "stray file")
+
+	return dir
+}
+
+func TestNewCollector_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsCollector("")
+	c, err := diskusage.NewCollector(args)
+	assert.True(t, check.IfNil(c))
+	assert.Equal(t, diskusage.ErrEmptyDatabasePath, err)
+
+	args = createArgsCollector("db")
+	args.ShardDirPrefix = ""
+	c, err = diskusage.NewCollector(args)
+	assert.True(t, check.IfNil(c))
+	assert.Equal(t, diskusage.ErrEmptyDirectoryName, err)
+
+	args = createArgsCollector("db")
+	args.AppStatusHandler = nil
+	c, err = diskusage.NewCollector(args)
+	assert.True(t, check.IfNil(c))
+	assert.Equal(t, diskusage.ErrNilAppStatusHandler, err)
+}
+
+func TestCollector_CollectMissingDatabasePathShouldReturnEmptyUsage(t *testing.T) {
+	t.Parallel()
+
+	c, _ := diskusage.NewCollector(createArgsCollector(filepath.Join(os.TempDir(), "missing_disk_usage_dir")))
+
+	usage, err := c.Collect()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), usage.TotalBytes)
+	assert.Equal(t, 0, len(usage.Shards))
+}
+
+func TestCollector_LastUsageShouldReturnTheLastCollectedUsage(t *testing.T) {
+	t.Parallel()
+
+	dir := createDatabaseLayout(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	c, _ := diskusage.NewCollector(createArgsCollector(dir))
+
+	usage, err := c.LastUsage()
+	assert.Nil(t, usage)
+	assert.Equal(t, diskusage.ErrStorageUsageNotCollected, err)
+
+	collected, err := c.Collect()
+	require.Nil(t, err)
+
+	writeFile(t, 25, dir, "Epoch_2", "Shard_0", "Transactions", "000001.ldb")
+	usage, err = c.LastUsage()
+	assert.Nil(t, err)
+	assert.True(t, usage == collected)
+	assert.True(t, usage.Timestamp > 0)
+}
+
+func TestCollector_CollectShouldSplitPerShardEpochAndUnit(t *testing.T) {
+	t.Parallel()
+
+	dir := createDatabaseLayout(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	recorder := newMetricsRecorder()
+	args := createArgsCollector(dir)
+	args.AppStatusHandler = recorder.statusHandler()
+	c, _ := diskusage.NewCollector(args)
+
+	usage, err := c.Collect()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(1675), usage.TotalBytes)
+	assert.Equal(t, uint64(10), usage.OtherBytes)
+	require.Equal(t, 2, len(usage.Shards))
+
+	shard0 := usage.Shards[0]
+	assert.Equal(t, "0", shard0.Shard)
+	assert.Equal(t, uint64(1660), shard0.TotalBytes)
+	assert.Equal(t, uint64(1010), shard0.StaticBytes)
+	require.Equal(t, 2, len(shard0.Static))
+	assert.Equal(t, "AccountsTrie", shard0.Static[0].Unit)
+	assert.Equal(t, uint64(1000), shard0.Static[0].Bytes)
+	assert.Equal(t, "TrieSnapshot", shard0.Static[1].Unit)
+
+	require.Equal(t, 2, len(shard0.Epochs))
+	assert.Equal(t, uint32(0), shard0.Epochs[0].Epoch)
+	assert.Equal(t, uint64(350), shard0.Epochs[0].TotalBytes)
+	require.Equal(t, 2, len(shard0.Epochs[0].Units))
+	assert.Equal(t, "BlockHeaders", shard0.Epochs[0].Units[0].Unit)
+	assert.Equal(t, uint64(150), shard0.Epochs[0].Units[0].Bytes)
+	assert.Equal(t, uint32(1), shard0.Epochs[1].Epoch)
+	assert.Equal(t, uint64(300), shard0.Epochs[1].TotalBytes)
+
+	shardMeta := usage.Shards[1]
+	assert.Equal(t, "metachain", shardMeta.Shard)
+	assert.Equal(t, uint64(5), shardMeta.TotalBytes)
+	assert.Equal(t, 0, len(shardMeta.Epochs))
+
+	value, _ := recorder.get(core.MetricStorageTotalSize)
+	assert.Equal(t, uint64(1675), value)
+	value, _ = recorder.get("erd_storage_size_shard_0")
+	assert.Equal(t, uint64(1660), value)
+	value, _ = recorder.get("erd_storage_size_shard_0_static_AccountsTrie")
+	assert.Equal(t, uint64(1000), value)
+	value, _ = recorder.get("erd_storage_size_shard_0_epoch_0")
+	assert.Equal(t, uint64(350), value)
+	value, _ = recorder.get("erd_storage_size_shard_0_epoch_1_Transactions")
+	assert.Equal(t, uint64(300), value)
+	value, _ = recorder.get("erd_storage_size_shard_metachain_static_PeerAccountsTrie")
+	assert.Equal(t, uint64(5), value)
+}
+
+func TestCollector_CollectRemovedEpochShouldResetItsMetrics(t *testing.T) {
+	t.Parallel()
+
+	dir := createDatabaseLayout(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	recorder := newMetricsRecorder()
+	args := createArgsCollector(dir)
+	args.AppStatusHandler = recorder.statusHandler()
+	c, _ := diskusage.NewCollector(args)
+
+	_, err := c.Collect()
+	require.Nil(t, err)
+	value, _ := recorder.get("erd_storage_size_shard_0_epoch_0_BlockHeaders")
+	assert.Equal(t, uint64(150), value)
+
+	require.Nil(t, os.RemoveAll(filepath.Join(dir, "Epoch_0")))
+	usage, err := c.Collect()
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(usage.Shards[0].Epochs))
+
+	value, found := recorder.get("erd_storage_size_shard_0_epoch_0_BlockHeaders")
+	assert.True(t, found)
+	assert.Equal(t, uint64(0), value)
+	value, _ = recorder.get("erd_storage_size_shard_0_epoch_0")
+	assert.Equal(t, uint64(0), value)
+}
+
+func TestCollector_StartCollectingShouldReportPeriodically(t *testing.T) {
+	t.Parallel()
+
+	dir := createDatabaseLayout(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	recorder := newMetricsRecorder()
+	args := createArgsCollector(dir)
+	args.AppStatusHandler = recorder.statusHandler()
+	c, _ := diskusage.NewCollector(args)
+
+	err := c.StartCollecting(0)
+	assert.Equal(t, diskusage.ErrInvalidCollectInterval, err)
+
+	err = c.StartCollecting(time.Millisecond * 50)
+	require.Nil(t, err)
+	defer func() {
+		_ = c.Close()
+	}()
+
+	time.Sleep(time.Millisecond * 100)
+	_, found := recorder.get(core.MetricStorageTotalSize)
+	assert.True(t, found)
+
+	writeFile(t, 25, dir, "Epoch_2", "Shard_0", "Transactions", "000001.ldb")
+	time.Sleep(time.Millisecond * 200)
+	value, _ := recorder.get("erd_storage_size_shard_0_epoch_2_Transactions")
+	assert.Equal(t, uint64(25), value)
+}
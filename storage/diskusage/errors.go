@@ -0,0 +1,18 @@
+package diskusage
+
+import "errors"
+
+// ErrEmptyDatabasePath signals that an empty database path has been provided
+var ErrEmptyDatabasePath = errors.New("empty database path")
+
+// ErrEmptyDirectoryName signals that an empty directory name or prefix has been provided
+var ErrEmptyDirectoryName = errors.New("empty directory name")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidCollectInterval signals that an invalid collect interval has been provided
+var ErrInvalidCollectInterval = errors.New("invalid collect interval")
+
+// ErrStorageUsageNotCollected signals that the storage usage was not collected yet
+var ErrStorageUsageNotCollected = errors.New("storage usage not collected yet")
@@ -103,6 +103,9 @@ var ErrImmuneItemsCapacityReached = errors.New("capacity reached for immune item
 // ErrItemAlreadyInCache signals that an item is already in cache
 var ErrItemAlreadyInCache = errors.New("item already in cache")
 
+// ErrTxReplacementUnderpriced signals that a transaction cannot replace a pending one (same sender and nonce) due to an insufficient gas price
+var ErrTxReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
 // ErrCacheSizeInvalid signals that size of cache is less than 1
 var ErrCacheSizeInvalid = errors.New("cache size is less than 1")
 
@@ -124,3 +127,11 @@ var ErrNilTimeCache = errors.New("nil time cache")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler")
 
+// ErrNilHandler signals that a nil handler has been provided
+var ErrNilHandler = errors.New("nil handler")
+
+// ErrInvalidEpochRange signals that the first epoch of a range is greater than the last one
+var ErrInvalidEpochRange = errors.New("invalid epoch range")
+
+// ErrCompactionNotSupported signals that the persister does not support the compaction of its data
+var ErrCompactionNotSupported = errors.New("compaction is not supported by the persister")
@@ -17,6 +17,9 @@ func GetCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 		SizeInBytesPerSender: cfg.SizeInBytesPerSender,
 		Type:                 storageUnit.CacheType(cfg.Type),
 		Shards:               cfg.Shards,
+
+		MinGasPriceBumpPercentForReplacement: cfg.MinGasPriceBumpPercentForReplacement,
+		SelectionPolicy:                      cfg.SelectionPolicy,
 	}
 }
 
@@ -236,6 +236,11 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	err = psf.setupTxPoolPersistence(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
@@ -407,6 +412,11 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	err = psf.setupTxPoolPersistence(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
@@ -467,6 +477,47 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.TxHashesByAddressIndexEnabled {
+		return nil
+	}
+
+	// Create the txHashesByAddress (STATIC) storer
+	txHashesByAddressConfig := psf.generalConfig.DbLookupExtensions.TxHashesByAddressStorageConfig
+	txHashesByAddressDbConfig := GetDBFromConfig(txHashesByAddressConfig.DB)
+	txHashesByAddressDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, txHashesByAddressConfig.DB.FilePath)
+	txHashesByAddressCacherConfig := GetCacherFromConfig(txHashesByAddressConfig.Cache)
+	txHashesByAddressBloomFilter := GetBloomFromConfig(txHashesByAddressConfig.Bloom)
+	txHashesByAddressUnit, err := storageUnit.NewStorageUnitFromConf(txHashesByAddressCacherConfig, txHashesByAddressDbConfig, txHashesByAddressBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, txHashesByAddressUnit)
+	chainStorer.AddStorer(dataRetriever.TxHashesByAddressUnit, txHashesByAddressUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setupTxPoolPersistence(chainStorer *dataRetriever.ChainStorer, createdStorers *[]storage.Storer) error {
+	if !psf.generalConfig.TxPoolPersistence.Enabled {
+		return nil
+	}
+
+	// Create the txPool (STATIC) storer
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	txPoolConfig := psf.generalConfig.TxPoolPersistence.StorageConfig
+	txPoolDbConfig := GetDBFromConfig(txPoolConfig.DB)
+	txPoolDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, txPoolConfig.DB.FilePath)
+	txPoolCacherConfig := GetCacherFromConfig(txPoolConfig.Cache)
+	txPoolBloomFilter := GetBloomFromConfig(txPoolConfig.Bloom)
+	txPoolUnit, err := storageUnit.NewStorageUnitFromConf(txPoolCacherConfig, txPoolDbConfig, txPoolBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, txPoolUnit)
+	chainStorer.AddStorer(dataRetriever.TxPoolUnit, txPoolUnit)
+
 	return nil
 }
 
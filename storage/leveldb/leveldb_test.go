@@ -318,3 +318,27 @@ func TestDB_PutGetLargeValue(t *testing.T) {
 
 	assert.Equal(t, buffLargeValue, recovered)
 }
+
+func TestDB_CompactShouldKeepData(t *testing.T) {
+	t.Parallel()
+
+	ldb := createLevelDb(t, 1, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	for i := 0; i < 100; i++ {
+		_ = ldb.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	for i := 0; i < 50; i++ {
+		_ = ldb.Remove([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	err := ldb.Compact()
+	assert.Nil(t, err)
+
+	assert.NotNil(t, ldb.Has([]byte("key0")))
+	val, err := ldb.Get([]byte("key99"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value99"), val)
+}
@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"runtime/debug"
+	"sort"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -387,6 +387,35 @@ func (ps *PruningStorer) Close() error {
 	return storage.ErrClosingPersisters
 }
 
+// Compact will compact the active persisters that support compaction, one at a time
+func (ps *PruningStorer) Compact() error {
+	ps.lock.RLock()
+	persisters := make([]*persisterData, len(ps.activePersisters))
+	copy(persisters, ps.activePersisters)
+	ps.lock.RUnlock()
+
+	numCompacted := 0
+	for _, pd := range persisters {
+		compacter, ok := pd.persister.(storage.Compacter)
+		if !ok || pd.getIsClosed() {
+			continue
+		}
+
+		log.Debug("compacting persister", "path", pd.path, "epoch", pd.epoch)
+		err := compacter.Compact()
+		if err != nil {
+			return fmt.Errorf("%w while compacting persister for epoch %d", err, pd.epoch)
+		}
+		numCompacted++
+	}
+
+	if numCompacted == 0 {
+		return storage.ErrCompactionNotSupported
+	}
+
+	return nil
+}
+
 // GetFromEpoch will search a key only in the persister for the given epoch
 func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	// TODO: this will be used when requesting from resolvers
@@ -886,11 +915,89 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	return nil
 }
 
-// RangeKeys does nothing as it is unable to iterate over multiple persisters
-// RangeKeys -
-func (ps *PruningStorer) RangeKeys(_ func(key []byte, val []byte) bool) {
-	log.Error("improper use of PruningStorer.RangeKeys()")
-	debug.PrintStack()
+// RangeKeys iterates over the (key, value) pairs held by all the active persisters, epoch by epoch,
+// from the oldest to the newest. The iteration stops as soon as the handler returns false
+func (ps *PruningStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	err := ps.RangeKeysInEpochs(handler, 0, math.MaxUint32, false)
+	if err != nil {
+		log.Warn("PruningStorer.RangeKeys", "identifier", ps.identifier, "error", err.Error())
+	}
+}
+
+// RangeKeysInEpochs iterates over the (key, value) pairs held by the persisters of the epochs between firstEpoch and
+// lastEpoch (both inclusive), epoch by epoch, from the oldest to the newest. If includeClosed is set, the closed persisters
+// which are still present are also iterated, being reopened only for the duration of their iteration.
+// The iteration stops as soon as the handler returns false. The persisters cannot be closed or destroyed during the
+// iteration, so the handler must not change the epoch of the storer
+func (ps *PruningStorer) RangeKeysInEpochs(
+	handler func(key []byte, val []byte) bool,
+	firstEpoch uint32,
+	lastEpoch uint32,
+	includeClosed bool,
+) error {
+	if handler == nil {
+		return storage.ErrNilHandler
+	}
+	if firstEpoch > lastEpoch {
+		return storage.ErrInvalidEpochRange
+	}
+
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	persistersToRange := ps.getPersistersInEpochs(firstEpoch, lastEpoch, includeClosed)
+
+	shouldContinue := true
+	rangeHandler := func(key []byte, val []byte) bool {
+		shouldContinue = handler(key, val)
+		return shouldContinue
+	}
+
+	for _, pd := range persistersToRange {
+		persister, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
+		if err != nil {
+			return fmt.Errorf("%w for epoch %d", err, pd.epoch)
+		}
+
+		persister.RangeKeys(rangeHandler)
+		closePersister()
+
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// getPersistersInEpochs must be called under the read lock
+func (ps *PruningStorer) getPersistersInEpochs(firstEpoch uint32, lastEpoch uint32, includeClosed bool) []*persisterData {
+	persistersByEpoch := make(map[uint32]*persisterData)
+	for _, pd := range ps.activePersisters {
+		persistersByEpoch[pd.epoch] = pd
+	}
+	if includeClosed {
+		for epoch, pd := range ps.persistersMapByEpoch {
+			if _, isActive := persistersByEpoch[epoch]; !isActive {
+				persistersByEpoch[epoch] = pd
+			}
+		}
+	}
+
+	persisters := make([]*persisterData, 0, len(persistersByEpoch))
+	for epoch, pd := range persistersByEpoch {
+		if epoch < firstEpoch || epoch > lastEpoch {
+			continue
+		}
+
+		persisters = append(persisters, pd)
+	}
+
+	sort.Slice(persisters, func(i, j int) bool {
+		return persisters[i].epoch < persisters[j].epoch
+	})
+
+	return persisters
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
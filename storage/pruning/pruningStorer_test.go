@@ -556,6 +556,70 @@ func TestNewPruningStorer_ChangeEpochDbsShouldNotBeDeletedIfPruningIsDisabled(t
 	assert.Equal(t, 1, len(persistersByPath))
 }
 
+func TestPruningStorer_CompactNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	ps, _ := pruning.NewPruningStorer(args)
+
+	err := ps.Compact()
+	assert.Equal(t, storage.ErrCompactionNotSupported, err)
+}
+
+func TestPruningStorer_CompactShouldCompactAllActivePersisters(t *testing.T) {
+	t.Parallel()
+
+	numCompacted := 0
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return &compacterPersister{
+				Persister: memorydb.New(),
+				compactCalled: func() error {
+					numCompacted++
+					return nil
+				},
+			}, nil
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+	_ = ps.ChangeEpochSimple(1)
+
+	err := ps.Compact()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numCompacted)
+}
+
+func TestPruningStorer_CompactErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return &compacterPersister{
+				Persister: memorydb.New(),
+				compactCalled: func() error {
+					return expectedErr
+				},
+			}, nil
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	err := ps.Compact()
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+type compacterPersister struct {
+	storage.Persister
+	compactCalled func() error
+}
+
+func (cp *compacterPersister) Compact() error {
+	return cp.compactCalled()
+}
+
 func TestNewPruningStorer_ChangeEpochConcurrentPut(t *testing.T) {
 	t.Parallel()
 
@@ -852,6 +916,153 @@ func TestPruningStorer_ChangeEpochWithExisting(t *testing.T) {
 	require.Equal(t, val2, restauredVal2)
 }
 
+func createPruningStorerWithDataInEpochs(t *testing.T) *pruning.PruningStorer {
+	persistersByPath := make(map[string]storage.Persister)
+	args := getDefaultArgs()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return fmt.Sprintf("Epoch_%d/Shard_%s/%s", epoch, shardId, identifier)
+	}}
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		// simulate an opening of an existing database from the file path by saving activePersisters in a map based on their path
+		CreateCalled: func(path string) (storage.Persister, error) {
+			if _, ok := persistersByPath[path]; ok {
+				return persistersByPath[path], nil
+			}
+			newPers := memorydb.New()
+			persistersByPath[path] = newPers
+
+			return newPers, nil
+		},
+	}
+	args.StartingEpoch = 3
+	args.NumOfEpochsToKeep = 4
+	args.NumOfActivePersisters = 2
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	// epochs 2 and 3 are active, while epochs 0 and 1 are closed
+	for epoch := uint32(0); epoch <= 3; epoch++ {
+		for i := 0; i < 2; i++ {
+			err = ps.PutInEpoch([]byte(fmt.Sprintf("key_%d_%d", epoch, i)), []byte(fmt.Sprintf("value_%d_%d", epoch, i)), epoch)
+			require.Nil(t, err)
+		}
+	}
+
+	return ps
+}
+
+func rangeKeysEpochs(ps *pruning.PruningStorer, firstEpoch uint32, lastEpoch uint32, includeClosed bool) ([]string, error) {
+	epochs := make([]string, 0)
+	err := ps.RangeKeysInEpochs(func(key []byte, val []byte) bool {
+		epochs = append(epochs, strings.Split(string(key), "_")[1])
+		return true
+	}, firstEpoch, lastEpoch, includeClosed)
+
+	return epochs, err
+}
+
+func TestPruningStorer_RangeKeysInEpochsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	err := ps.RangeKeysInEpochs(nil, 0, 3, false)
+	assert.Equal(t, storage.ErrNilHandler, err)
+
+	_, err = rangeKeysEpochs(ps, 3, 2, false)
+	assert.Equal(t, storage.ErrInvalidEpochRange, err)
+}
+
+func TestPruningStorer_RangeKeysShouldIterateActivePersistersFromOldestEpoch(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	values := make(map[string]string)
+	epochs := make([]string, 0)
+	ps.RangeKeys(func(key []byte, val []byte) bool {
+		values[string(key)] = string(val)
+		epochs = append(epochs, strings.Split(string(key), "_")[1])
+		return true
+	})
+
+	assert.Equal(t, []string{"2", "2", "3", "3"}, epochs)
+	assert.Equal(t, "value_2_1", values["key_2_1"])
+	assert.Equal(t, "value_3_0", values["key_3_0"])
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldReopenClosedPersisters(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	epochs, err := rangeKeysEpochs(ps, 0, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0", "0", "1", "1", "2", "2", "3", "3"}, epochs)
+
+	epochs, err = rangeKeysEpochs(ps, 0, 3, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2", "2", "3", "3"}, epochs)
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldRestrictToEpochRange(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	epochs, err := rangeKeysEpochs(ps, 1, 2, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "1", "2", "2"}, epochs)
+
+	epochs, err = rangeKeysEpochs(ps, 5, 10, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(epochs))
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldStopEarly(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	numHandled := 0
+	err := ps.RangeKeysInEpochs(func(key []byte, val []byte) bool {
+		numHandled++
+		return numHandled < 3
+	}, 0, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numHandled)
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldBlockEpochChangesUntilTheIterationEnds(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	epochChanged := make(chan struct{})
+	numHandled := 0
+	err := ps.RangeKeysInEpochs(func(key []byte, val []byte) bool {
+		numHandled++
+		if numHandled == 1 {
+			go func() {
+				ps.SetEpochForPutOperation(3)
+				close(epochChanged)
+			}()
+		}
+
+		select {
+		case <-epochChanged:
+			assert.Fail(t, "the storer changed during the iteration")
+		case <-time.After(time.Millisecond * 10):
+		}
+
+		return true
+	}, 0, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 8, numHandled)
+
+	<-epochChanged
+}
+
 func TestRegex(t *testing.T) {
 	t.Parallel()
 
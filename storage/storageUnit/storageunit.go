@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -43,12 +44,12 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
-// More to be added
+// LvlDB, LvlDBSerial and BoltDB are the supported persistent DBs, MemoryDB is the in-memory one
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
 	MemoryDB    DBType = "MemoryDB"
+	BoltDB      DBType = "BoltDB"
 )
 
 const (
@@ -78,6 +79,9 @@ type CacheConfig struct {
 	Capacity             uint32
 	SizePerSender        uint32
 	Shards               uint32
+
+	MinGasPriceBumpPercentForReplacement uint32
+	SelectionPolicy                      string
 }
 
 // String returns a readable representation of the object
@@ -155,6 +159,16 @@ func (u *Unit) RangeKeys(handler func(key []byte, value []byte) bool) {
 	u.persister.RangeKeys(handler)
 }
 
+// Compact will compact the underlying persister if it supports compaction
+func (u *Unit) Compact() error {
+	compacter, ok := u.persister.(storage.Compacter)
+	if !ok {
+		return storage.ErrCompactionNotSupported
+	}
+
+	return compacter.Compact()
+}
+
 // Get searches the key in the cache. In case it is not found, it searches
 // for the key in bloom filter first and if found
 // it further searches it in the associated database.
@@ -440,6 +454,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
+		case BoltDB:
+			db, err = boltdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		default:
 			return nil, storage.ErrNotSupportedDBType
 		}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
@@ -20,35 +21,71 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var allDBTypes = []storageUnit.DBType{
+	storageUnit.LvlDB,
+	storageUnit.LvlDBSerial,
+	storageUnit.MemoryDB,
+	storageUnit.BoltDB,
+}
+
 func logError(err error) {
 	if err != nil {
 		fmt.Println(err.Error())
 	}
 }
 
-func initStorageUnitWithBloomFilter(t *testing.T, cSize int) *storageUnit.Unit {
-	mdb := memorydb.New()
+func runForAllDBTypes(t *testing.T, test func(t *testing.T, dbType storageUnit.DBType, dir string)) {
+	for _, dbType := range allDBTypes {
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			dir, _ := ioutil.TempDir("", "storage_unit_temp")
+			defer func() {
+				_ = os.RemoveAll(dir)
+			}()
+
+			test(t, dbType, dir)
+		})
+	}
+}
+
+func createPersisterWithDBType(t *testing.T, dbType storageUnit.DBType, dir string) storage.Persister {
+	// a batch size of 1 writes every operation to the persister before returning
+	persister, err := storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            dbType,
+		Path:              dir,
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	})
+	require.Nil(t, err)
+
+	return persister
+}
+
+func initStorageUnitWithBloomFilter(t *testing.T, dbType storageUnit.DBType, dir string, cSize int) *storageUnit.Unit {
+	persister := createPersisterWithDBType(t, dbType, dir)
 	cache, err2 := lrucache.NewCache(cSize)
 	bf := bloom.NewDefaultFilter()
 
 	assert.Nil(t, err2, "no error expected but got %s", err2)
 
-	sUnit, err := storageUnit.NewStorageUnitWithBloomFilter(cache, mdb, bf)
+	sUnit, err := storageUnit.NewStorageUnitWithBloomFilter(cache, persister, bf)
 
 	assert.Nil(t, err, "failed to create storage unit")
 
 	return sUnit
 }
 
-func initStorageUnitWithNilBloomFilter(t *testing.T, cSize int) *storageUnit.Unit {
-	mdb := memorydb.New()
+func initStorageUnitWithNilBloomFilter(t *testing.T, dbType storageUnit.DBType, dir string, cSize int) *storageUnit.Unit {
+	persister := createPersisterWithDBType(t, dbType, dir)
 	cache, err2 := lrucache.NewCache(cSize)
 
 	assert.Nil(t, err2, "no error expected but got %s", err2)
 
-	sUnit, err := storageUnit.NewStorageUnit(cache, mdb)
+	sUnit, err := storageUnit.NewStorageUnit(cache, persister)
 
 	assert.Nil(t, err, "failed to create storage unit")
 
@@ -98,348 +135,477 @@ func TestStorageUnit_NilBloomFilterShouldErr(t *testing.T) {
 }
 
 func TestPutNotPresent(t *testing.T) {
-	key, val := []byte("key0"), []byte("value0")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key0"), []byte("value0")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
+	})
 }
 
 func TestPutNotPresentWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key0"), []byte("value0")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key0"), []byte("value0")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
+	})
 }
 
 func TestPutNotPresentCache(t *testing.T) {
-	key, val := []byte("key1"), []byte("value1")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key1"), []byte("value1")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
+	})
 }
 
 func TestPutNotPresentCacheWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key1"), []byte("value1")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key1"), []byte("value1")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected to find key %s, but not found", key)
+		assert.Nil(t, err, "expected to find key %s, but not found", key)
+	})
 }
 
 func TestPutPresentShouldOverwriteValue(t *testing.T) {
-	key, val := []byte("key2"), []byte("value2")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
-
-	assert.Nil(t, err, "no error expected but got %s", err)
-
-	newVal := []byte("value5")
-	err = s.Put(key, newVal)
-	assert.Nil(t, err, "no error expected but got %s", err)
-
-	returnedVal, err := s.Get(key)
-	assert.Nil(t, err)
-	assert.Equal(t, newVal, returnedVal)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key2"), []byte("value2")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+
+		assert.Nil(t, err, "no error expected but got %s", err)
+
+		newVal := []byte("value5")
+		err = s.Put(key, newVal)
+		assert.Nil(t, err, "no error expected but got %s", err)
+
+		returnedVal, err := s.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, newVal, returnedVal)
+	})
 }
 
 func TestPutPresentWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key2"), []byte("value2")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key2"), []byte("value2")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	// put again same value, no error expected
-	err = s.Put(key, val)
+		// put again same value, no error expected
+		err = s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
+	})
 }
 
 func TestGetNotPresent(t *testing.T) {
-	key := []byte("key3")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	v, err := s.Get(key)
-
-	assert.NotNil(t, err, "expected to find no value, but found %s", v)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key3")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		v, err := s.Get(key)
+
+		assert.NotNil(t, err, "expected to find no value, but found %s", v)
+	})
 }
 
 func TestGetNotPresentWithNilBloomFilter(t *testing.T) {
-	key := []byte("key3")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	v, err := s.Get(key)
-
-	assert.NotNil(t, err, "expected to find no value, but found %s", v)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key3")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		v, err := s.Get(key)
+
+		assert.NotNil(t, err, "expected to find no value, but found %s", v)
+	})
 }
 
 func TestGetNotPresentCache(t *testing.T) {
-	key, val := []byte("key4"), []byte("value4")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key4"), []byte("value4")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	v, err := s.Get(key)
+		v, err := s.Get(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
-	assert.Equal(t, val, v, "expected %s but got %s", val, v)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Equal(t, val, v, "expected %s but got %s", val, v)
+	})
 }
 
 func TestGetNotPresentCacheWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key4"), []byte("value4")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key4"), []byte("value4")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	v, err := s.Get(key)
+		v, err := s.Get(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
-	assert.Equal(t, val, v, "expected %s but got %s", val, v)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Equal(t, val, v, "expected %s but got %s", val, v)
+	})
 }
 
 func TestGetPresent(t *testing.T) {
-	key, val := []byte("key5"), []byte("value4")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key5"), []byte("value4")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	v, err := s.Get(key)
+		v, err := s.Get(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
-	assert.Equal(t, val, v, "expected %s but got %s", val, v)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Equal(t, val, v, "expected %s but got %s", val, v)
+	})
 }
 
 func TestGetPresentWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key5"), []byte("value4")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key5"), []byte("value4")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	v, err := s.Get(key)
+		v, err := s.Get(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
-	assert.Equal(t, val, v, "expected %s but got %s", val, v)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Equal(t, val, v, "expected %s but got %s", val, v)
+	})
 }
 
 func TestHasNotPresent(t *testing.T) {
-	key := []byte("key6")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Has(key)
-
-	assert.NotNil(t, err)
-	assert.Equal(t, err, storage.ErrKeyNotFound)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key6")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Has(key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, err, storage.ErrKeyNotFound)
+	})
 }
 
 func TestHasNotPresentWithNilBloomFilter(t *testing.T) {
-	key := []byte("key6")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Has(key)
-
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "key not found")
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key6")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Has(key)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "key not found")
+	})
 }
 
 func TestHasNotPresentCache(t *testing.T) {
-	key, val := []byte("key7"), []byte("value7")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key7"), []byte("value7")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestHasNotPresentCacheWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key7"), []byte("value7")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key7"), []byte("value7")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestHasPresent(t *testing.T) {
-	key, val := []byte("key8"), []byte("value8")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key8"), []byte("value8")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestHasPresentWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key8"), []byte("value8")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key8"), []byte("value8")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
 
-	assert.Nil(t, err, "no error expected but got %s", err)
+		assert.Nil(t, err, "no error expected but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestDeleteNotPresent(t *testing.T) {
-	key := []byte("key12")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Remove(key)
-
-	assert.Nil(t, err, "expected no error, but got %s", err)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key12")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Remove(key)
+
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestDeleteNotPresentWithNilBloomFilter(t *testing.T) {
-	key := []byte("key12")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Remove(key)
-
-	assert.Nil(t, err, "expected no error, but got %s", err)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key := []byte("key12")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Remove(key)
+
+		assert.Nil(t, err, "expected no error, but got %s", err)
+	})
 }
 
 func TestDeleteNotPresentCache(t *testing.T) {
-	key, val := []byte("key13"), []byte("value13")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
-	assert.Nil(t, err, "Could not put value in storage unit")
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key13"), []byte("value13")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+		assert.Nil(t, err, "Could not put value in storage unit")
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Remove(key)
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		err = s.Remove(key)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "key not found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "key not found")
+	})
 }
 
 func TestDeleteNotPresentCacheWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key13"), []byte("value13")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
-	assert.Nil(t, err, "Could not put value in storage unit")
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key13"), []byte("value13")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+		assert.Nil(t, err, "Could not put value in storage unit")
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	s.ClearCache()
+		s.ClearCache()
 
-	err = s.Remove(key)
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		err = s.Remove(key)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "key not found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "key not found")
+	})
 }
 
 func TestDeletePresent(t *testing.T) {
-	key, val := []byte("key14"), []byte("value14")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
-	assert.Nil(t, err, "Could not put value in storage unit")
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key14"), []byte("value14")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+		assert.Nil(t, err, "Could not put value in storage unit")
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Remove(key)
+		err = s.Remove(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "key not found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "key not found")
+	})
 }
 
 func TestDeletePresentWithNilBloomFilter(t *testing.T) {
-	key, val := []byte("key14"), []byte("value14")
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.Put(key, val)
-	assert.Nil(t, err, "Could not put value in storage unit")
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key14"), []byte("value14")
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+		assert.Nil(t, err, "Could not put value in storage unit")
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Remove(key)
+		err = s.Remove(key)
 
-	assert.Nil(t, err, "expected no error, but got %s", err)
+		assert.Nil(t, err, "expected no error, but got %s", err)
 
-	err = s.Has(key)
+		err = s.Has(key)
 
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "key not found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "key not found")
+	})
 }
 
 func TestClearCacheNotAffectPersist(t *testing.T) {
-	key, val := []byte("key15"), []byte("value15")
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.Put(key, val)
-	assert.Nil(t, err, "Could not put value in storage unit")
-	s.ClearCache()
-
-	err = s.Has(key)
-
-	assert.Nil(t, err, "no error expected, but got %s", err)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		key, val := []byte("key15"), []byte("value15")
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		defer func() {
+			_ = s.DestroyUnit()
+		}()
+		err := s.Put(key, val)
+		assert.Nil(t, err, "Could not put value in storage unit")
+		s.ClearCache()
+
+		err = s.Has(key)
+
+		assert.Nil(t, err, "no error expected, but got %s", err)
+	})
 }
 
 func TestDestroyUnitNoError(t *testing.T) {
-	s := initStorageUnitWithBloomFilter(t, 10)
-	err := s.DestroyUnit()
-	assert.Nil(t, err, "no error expected, but got %s", err)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		s := initStorageUnitWithBloomFilter(t, dbType, dir, 10)
+		err := s.DestroyUnit()
+		assert.Nil(t, err, "no error expected, but got %s", err)
+	})
 }
 
 func TestDestroyUnitWithNilBloomFilterNoError(t *testing.T) {
-	s := initStorageUnitWithNilBloomFilter(t, 10)
-	err := s.DestroyUnit()
-	assert.Nil(t, err, "no error expected, but got %s", err)
+	runForAllDBTypes(t, func(t *testing.T, dbType storageUnit.DBType, dir string) {
+		s := initStorageUnitWithNilBloomFilter(t, dbType, dir, 10)
+		err := s.DestroyUnit()
+		assert.Nil(t, err, "no error expected, but got %s", err)
+	})
 }
 
 func TestCreateCacheFromConfWrongType(t *testing.T) {
@@ -506,6 +672,144 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfAllTypesOk(t *testing.T) {
+	for _, dbType := range allDBTypes {
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			dir, _ := ioutil.TempDir("", "storage_unit_temp")
+			arg := storageUnit.ArgDB{
+				DBType:            dbType,
+				Path:              dir,
+				BatchDelaySeconds: 10,
+				MaxBatchSize:      10,
+				MaxOpenFiles:      10,
+			}
+			persister, err := storageUnit.NewDB(arg)
+			require.Nil(t, err)
+			assert.False(t, check.IfNil(persister))
+
+			err = persister.Destroy()
+			assert.Nil(t, err)
+			_ = os.RemoveAll(dir)
+		})
+	}
+}
+
+func TestStorageUnit_AllDBTypesOperations(t *testing.T) {
+	for _, dbType := range allDBTypes {
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			testStorageUnitOperations(t, dbType)
+		})
+	}
+}
+
+func TestStorageUnit_PersistentDBTypesReopenShouldKeepData(t *testing.T) {
+	for _, dbType := range allDBTypes {
+		if dbType == storageUnit.MemoryDB {
+			continue
+		}
+
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			dir, _ := ioutil.TempDir("", "storage_unit_temp")
+			defer func() {
+				_ = os.RemoveAll(dir)
+			}()
+
+			s := createStorageUnitWithDBType(t, dbType, dir)
+			for i := 0; i < 20; i++ {
+				err := s.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+				require.Nil(t, err)
+			}
+			err := s.Remove([]byte("key0"))
+			require.Nil(t, err)
+			err = s.Close()
+			require.Nil(t, err)
+
+			s = createStorageUnitWithDBType(t, dbType, dir)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			assert.Equal(t, storage.ErrKeyNotFound, s.Has([]byte("key0")))
+			for i := 1; i < 20; i++ {
+				val, errGet := s.Get([]byte(fmt.Sprintf("key%d", i)))
+				assert.Nil(t, errGet)
+				assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+			}
+		})
+	}
+}
+
+func createStorageUnitWithDBType(t *testing.T, dbType storageUnit.DBType, dir string) *storageUnit.Unit {
+	persister := createPersisterWithDBType(t, dbType, dir)
+
+	cache, err := lrucache.NewCache(10)
+	require.Nil(t, err)
+
+	// no bloom filter as it would not know about the keys already persisted when reopening the persister
+	s, err := storageUnit.NewStorageUnit(cache, persister)
+	require.Nil(t, err)
+
+	return s
+}
+
+func testStorageUnitOperations(t *testing.T, dbType storageUnit.DBType) {
+	dir, _ := ioutil.TempDir("", "storage_unit_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	s := createStorageUnitWithDBType(t, dbType, dir)
+
+	numKeys := 30
+	for i := 0; i < numKeys; i++ {
+		err := s.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	err := s.Put([]byte("key1"), []byte("overwritten"))
+	require.Nil(t, err)
+	err = s.Remove([]byte("key2"))
+	require.Nil(t, err)
+	s.ClearCache()
+
+	val, err := s.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("overwritten"), val)
+	assert.Nil(t, s.Has([]byte("key3")))
+	assert.Equal(t, storage.ErrKeyNotFound, s.Has([]byte("key2")))
+	_, err = s.Get([]byte("key2"))
+	assert.NotNil(t, err)
+	_, err = s.Get([]byte("missing key"))
+	assert.NotNil(t, err)
+
+	values, err := s.GetFromEpoch([]byte("key4"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value4"), values)
+
+	recovered := make(map[string][]byte)
+	s.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+	assert.Equal(t, numKeys-1, len(recovered))
+	assert.Equal(t, []byte("overwritten"), recovered["key1"])
+	_, found := recovered["key2"]
+	assert.False(t, found)
+
+	numIterated := 0
+	s.RangeKeys(func(key []byte, val []byte) bool {
+		numIterated++
+		return false
+	})
+	assert.Equal(t, 1, numIterated)
+
+	err = s.DestroyUnit()
+	assert.Nil(t, err)
+}
+
 func TestCreateBloomFilterFromConfWrongSize(t *testing.T) {
 	bfConfig := storageUnit.BloomConfig{
 		Size:     2,
@@ -869,3 +1173,32 @@ func BenchmarkStorageUnit_GetNotPresentWithBloomFilter(b *testing.B) {
 		logError(err)
 	}
 }
+
+func TestStorageUnit_CompactNotSupportedShouldErr(t *testing.T) {
+	s := initStorageUnitWithNilBloomFilter(t, storageUnit.MemoryDB, "", 10)
+
+	err := s.Compact()
+	assert.Equal(t, storage.ErrCompactionNotSupported, err)
+}
+
+func TestStorageUnit_CompactShouldWork(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "storage_unit_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	s := createStorageUnitWithDBType(t, storageUnit.LvlDBSerial, dir)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	err := s.Put([]byte("key"), []byte("value"))
+	require.Nil(t, err)
+
+	err = s.Compact()
+	assert.Nil(t, err)
+
+	val, err := s.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
@@ -1,2 +1,5 @@
 #!/bin/bash
 go test -bench="BenchmarkSendersMap_GetSnapshotAscending$" -benchtime=1x
+
+# Compares the selection policies (throughput, fairness, average gas price) on deterministic scenarios
+go test -run=^$ -bench="BenchmarkSelectionPolicies" -benchtime=10x
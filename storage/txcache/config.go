@@ -28,11 +28,17 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	// MinGasPriceBumpPercentForReplacement is the minimum gas price increase (in percents) required for a transaction
+	// to replace a pending transaction with the same sender and nonce. Zero disables the replacement.
+	MinGasPriceBumpPercentForReplacement uint32
+	// SelectionPolicy is the name of the transactions selection policy (empty means the default one, "score")
+	SelectionPolicy string
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                            uint32
+	maxNumBytes                          uint32
+	minGasPriceBumpPercentForReplacement uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -49,6 +55,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if !IsSelectionPolicySupported(config.SelectionPolicy) {
+		return fmt.Errorf("%w: config.SelectionPolicy is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -66,8 +75,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                          config.NumBytesPerSenderThreshold,
+		maxNumTxs:                            config.CountPerSenderThreshold,
+		minGasPriceBumpPercentForReplacement: config.MinGasPriceBumpPercentForReplacement,
 	}
 }
 
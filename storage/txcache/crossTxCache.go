@@ -60,6 +60,12 @@ func (cache *CrossTxCache) AddTx(tx *WrappedTransaction) (has, added bool) {
 	return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
 }
 
+// AddTxWithReplacement adds a transaction in the cache. The cross-shard transactions are already executed in their
+// source shard, so they never replace each other
+func (cache *CrossTxCache) AddTxWithReplacement(tx *WrappedTransaction) (has, added bool) {
+	return cache.AddTx(tx)
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *CrossTxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	item, ok := cache.ImmunityCache.Get(txHash)
@@ -55,6 +55,23 @@ func TestCrossTxCache_Get(t *testing.T) {
 	require.Nil(t, xTx)
 }
 
+func TestCrossTxCache_AddTxWithReplacementShouldKeepSameNonceTransactions(t *testing.T) {
+	cache := newCrossTxCacheToTest(1, 8, math.MaxUint16)
+
+	has, added := cache.AddTxWithReplacement(createTxWithParams([]byte("a"), "alice", 1, 128, 42, 100))
+	require.False(t, has)
+	require.True(t, added)
+
+	has, added = cache.AddTxWithReplacement(createTxWithParams([]byte("a+"), "alice", 1, 128, 42, 105))
+	require.False(t, has)
+	require.True(t, added)
+
+	has, added = cache.AddTxWithReplacement(createTxWithParams([]byte("a++"), "alice", 1, 128, 42, 200))
+	require.False(t, has)
+	require.True(t, added)
+	require.ElementsMatch(t, []string{"a", "a+", "a++"}, hashesAsStrings(cache.Keys()))
+}
+
 func newCrossTxCacheToTest(numChunks uint32, maxNumItems uint32, numMaxBytes uint32) *CrossTxCache {
 	cache, err := NewCrossTxCache(ConfigDestinationMe{
 		Name:                        "test",
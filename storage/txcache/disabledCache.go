@@ -20,6 +20,11 @@ func (cache *DisabledCache) AddTx(_ *WrappedTransaction) (ok bool, added bool) {
 	return false, false
 }
 
+// AddTxWithReplacement does nothing
+func (cache *DisabledCache) AddTxWithReplacement(_ *WrappedTransaction) (ok bool, added bool) {
+	return false, false
+}
+
 // GetByTxHash returns no transaction
 func (cache *DisabledCache) GetByTxHash(_ []byte) (*WrappedTransaction, bool) {
 	return nil, false
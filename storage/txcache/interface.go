@@ -8,6 +8,10 @@ type scoreComputer interface {
 	computeScore(scoreParams senderScoreParams) uint32
 }
 
+type selectionPolicy interface {
+	selectTransactions(cache *TxCache, numRequested int, batchSizePerSender int) []*WrappedTransaction
+}
+
 // TxGasHandler handles a transaction gas and gas cost
 type TxGasHandler interface {
 	SplitTxGasInCategories(tx process.TransactionWithFeeHandler) (uint64, uint64)
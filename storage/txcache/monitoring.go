@@ -19,6 +19,11 @@ func (cache *TxCache) monitorEvictionWrtSenderLimit(sender []byte, evicted [][]b
 	}
 }
 
+func (cache *TxCache) monitorTxReplacement(tx *WrappedTransaction, replacedTxHash []byte) {
+	cache.numTxsReplaced.Increment()
+	log.Trace("TxCache.AddTx() replaced transaction", "name", cache.name, "sender", tx.Tx.GetSndAddr(), "nonce", tx.Tx.GetNonce(), "tx", tx.TxHash, "replaced", replacedTxHash)
+}
+
 func (cache *TxCache) monitorEvictionStart() *core.StopWatch {
 	log.Debug("TxCache: eviction started", "name", cache.name, "numBytes", cache.NumBytes(), "txs", cache.CountTx(), "senders", cache.CountSenders())
 	cache.displaySendersHistogram()
@@ -154,6 +159,7 @@ func (cache *TxCache) diagnoseShallowly() {
 	log.Debug("TxCache.NumSenders:", "estimate", numSendersEstimate, "inChunks", numSendersInChunks, "inScoreChunks", numSendersInScoreChunks)
 	log.Debug("TxCache.NumSenders (continued):", "keys", len(sendersKeys), "keysSorted", len(sendersKeysSorted), "snapshot", len(sendersSnapshot))
 	log.Debug("TxCache.NumTxs:", "estimate", numTxsEstimate, "inChunks", numTxsInChunks, "keys", len(txsKeys))
+	log.Debug("TxCache.NumTxsReplaced:", "total", cache.numTxsReplaced.Get())
 }
 
 func (cache *TxCache) diagnoseDeeply() {
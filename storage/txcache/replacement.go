@@ -0,0 +1,27 @@
+package txcache
+
+import (
+	"math/big"
+)
+
+const oneHundredPercent = 100
+
+// isReplacementEnabled returns true if same-nonce transactions should replace each other (instead of being kept side by side)
+func isReplacementEnabled(minGasPriceBumpPercent uint32) bool {
+	return minGasPriceBumpPercent > 0
+}
+
+// isGasPriceBumpSufficient returns true if the incoming gas price exceeds the current one
+// by at least "minGasPriceBumpPercent" percents (the computation is overflow-safe)
+func isGasPriceBumpSufficient(currentGasPrice uint64, incomingGasPrice uint64, minGasPriceBumpPercent uint32) bool {
+	if incomingGasPrice <= currentGasPrice {
+		return false
+	}
+
+	minIncoming := big.NewInt(0).SetUint64(currentGasPrice)
+	minIncoming.Mul(minIncoming, big.NewInt(int64(oneHundredPercent+uint64(minGasPriceBumpPercent))))
+	incoming := big.NewInt(0).SetUint64(incomingGasPrice)
+	incoming.Mul(incoming, big.NewInt(oneHundredPercent))
+
+	return incoming.Cmp(minIncoming) >= 0
+}
@@ -0,0 +1,30 @@
+package txcache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isReplacementEnabled(t *testing.T) {
+	require.False(t, isReplacementEnabled(0))
+	require.True(t, isReplacementEnabled(1))
+	require.True(t, isReplacementEnabled(10))
+}
+
+func Test_isGasPriceBumpSufficient(t *testing.T) {
+	require.False(t, isGasPriceBumpSufficient(100, 100, 10))
+	require.False(t, isGasPriceBumpSufficient(100, 99, 10))
+	require.False(t, isGasPriceBumpSufficient(100, 109, 10))
+	require.True(t, isGasPriceBumpSufficient(100, 110, 10))
+	require.True(t, isGasPriceBumpSufficient(100, 250, 10))
+	require.True(t, isGasPriceBumpSufficient(100, 101, 1))
+	require.False(t, isGasPriceBumpSufficient(1000000000, 1099999999, 10))
+	require.True(t, isGasPriceBumpSufficient(1000000000, 1100000000, 10))
+	require.True(t, isGasPriceBumpSufficient(0, 1, 10))
+
+	// No overflow
+	require.False(t, isGasPriceBumpSufficient(math.MaxUint64-1, math.MaxUint64, 10))
+	require.True(t, isGasPriceBumpSufficient(math.MaxUint64/2, math.MaxUint64, 10))
+}
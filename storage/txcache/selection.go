@@ -0,0 +1,205 @@
+package txcache
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// SelectionPolicyScore selects transactions in passes, in the descending order of the senders' score,
+// with batches proportional to the score (the default policy)
+const SelectionPolicyScore = "score"
+
+// SelectionPolicyGasPrice selects transactions in the descending order of their gas price (nonce ordering is preserved for each sender)
+const SelectionPolicyGasPrice = "gasPrice"
+
+// SelectionPolicyRoundRobin selects transactions in passes, with equal batches for all senders,
+// starting with a different sender at each selection
+const SelectionPolicyRoundRobin = "roundRobin"
+
+var _ selectionPolicy = (*scoreSelectionPolicy)(nil)
+var _ selectionPolicy = (*gasPriceSelectionPolicy)(nil)
+var _ selectionPolicy = (*roundRobinSelectionPolicy)(nil)
+
+// IsSelectionPolicySupported returns true if the provided selection policy is known (empty means the default policy)
+func IsSelectionPolicySupported(policy string) bool {
+	_, err := newSelectionPolicy(policy)
+	return err == nil
+}
+
+func newSelectionPolicy(policy string) (selectionPolicy, error) {
+	switch policy {
+	case "", SelectionPolicyScore:
+		return &scoreSelectionPolicy{}, nil
+	case SelectionPolicyGasPrice:
+		return &gasPriceSelectionPolicy{}, nil
+	case SelectionPolicyRoundRobin:
+		return &roundRobinSelectionPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown selection policy %s", storage.ErrInvalidConfig, policy)
+	}
+}
+
+// selectBatchFromSender copies a batch of transactions of a sender to the destination, and updates the selection journals
+func (cache *TxCache) selectBatchFromSender(txList *txListForSender, isFirstBatch bool, destination []*WrappedTransaction, batchSize int) int {
+	journal := txList.selectBatchTo(isFirstBatch, destination, batchSize)
+	cache.monitorBatchSelectionEnd(journal)
+
+	if isFirstBatch {
+		cache.collectSweepable(txList)
+	}
+
+	return journal.copied
+}
+
+// selectInPasses iterates over the senders (in the given order) multiple times, until the result is full or nothing else can be selected
+func (cache *TxCache) selectInPasses(senders []*txListForSender, numRequested int, batchSizeOf func(txList *txListForSender) int) []*WrappedTransaction {
+	result := make([]*WrappedTransaction, numRequested)
+	resultFillIndex := 0
+	resultIsFull := false
+
+	for pass := 0; !resultIsFull; pass++ {
+		copiedInThisPass := 0
+
+		for _, txList := range senders {
+			// Reset happens on first pass only
+			isFirstBatch := pass == 0
+			copied := cache.selectBatchFromSender(txList, isFirstBatch, result[resultFillIndex:], batchSizeOf(txList))
+
+			resultFillIndex += copied
+			copiedInThisPass += copied
+			resultIsFull = resultFillIndex == numRequested
+			if resultIsFull {
+				break
+			}
+		}
+
+		nothingCopiedThisPass := copiedInThisPass == 0
+
+		// No more passes needed
+		if nothingCopiedThisPass {
+			break
+		}
+	}
+
+	return result[:resultFillIndex]
+}
+
+type scoreSelectionPolicy struct {
+}
+
+func (policy *scoreSelectionPolicy) selectTransactions(cache *TxCache, numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	senders := cache.txListBySender.getSnapshotDescending()
+
+	return cache.selectInPasses(senders, numRequested, func(txList *txListForSender) int {
+		return batchSizePerSender * int(txList.getLastComputedScore()+1)
+	})
+}
+
+type roundRobinSelectionPolicy struct {
+	numSelections atomic.Counter
+}
+
+func (policy *roundRobinSelectionPolicy) selectTransactions(cache *TxCache, numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	senders := cache.txListBySender.getSnapshotAscending()
+	if len(senders) == 0 {
+		return make([]*WrappedTransaction, 0)
+	}
+
+	// Senders are sorted so that the rotation is deterministic
+	sort.Slice(senders, func(i, j int) bool {
+		return senders[i].sender < senders[j].sender
+	})
+
+	startIndex := int((policy.numSelections.Increment() - 1) % int64(len(senders)))
+	rotated := make([]*txListForSender, 0, len(senders))
+	rotated = append(rotated, senders[startIndex:]...)
+	rotated = append(rotated, senders[:startIndex]...)
+
+	return cache.selectInPasses(rotated, numRequested, func(_ *txListForSender) int {
+		return batchSizePerSender
+	})
+}
+
+type gasPriceSelectionPolicy struct {
+}
+
+// selectTransactions ignores "batchSizePerSender", since each transaction competes only by its gas price
+func (policy *gasPriceSelectionPolicy) selectTransactions(cache *TxCache, numRequested int, _ int) []*WrappedTransaction {
+	senders := cache.txListBySender.getSnapshotAscending()
+	result := make([]*WrappedTransaction, 0, numRequested)
+	buffer := make([]*WrappedTransaction, 1)
+
+	candidates := make(selectionCandidates, 0, len(senders))
+	for _, txList := range senders {
+		copied := cache.selectBatchFromSender(txList, true, buffer, 1)
+		if copied > 0 {
+			candidates = append(candidates, &selectionCandidate{txList: txList, tx: buffer[0]})
+		}
+	}
+
+	heap.Init(&candidates)
+
+	for len(result) < numRequested && candidates.Len() > 0 {
+		best := candidates[0]
+		result = append(result, best.tx)
+
+		// The next transaction of the same sender becomes a candidate (nonce ordering is preserved)
+		copied := cache.selectBatchFromSender(best.txList, false, buffer, 1)
+		if copied > 0 {
+			best.tx = buffer[0]
+			heap.Fix(&candidates, 0)
+		} else {
+			heap.Pop(&candidates)
+		}
+	}
+
+	return result
+}
+
+type selectionCandidate struct {
+	txList *txListForSender
+	tx     *WrappedTransaction
+}
+
+// selectionCandidates is a max-heap of candidates, by gas price (ties are broken by sender, for determinism)
+type selectionCandidates []*selectionCandidate
+
+// Len returns the number of candidates
+func (candidates selectionCandidates) Len() int {
+	return len(candidates)
+}
+
+// Less returns true if the candidate at index "i" should be selected before the one at index "j"
+func (candidates selectionCandidates) Less(i, j int) bool {
+	gasPriceI := candidates[i].tx.Tx.GetGasPrice()
+	gasPriceJ := candidates[j].tx.Tx.GetGasPrice()
+	if gasPriceI != gasPriceJ {
+		return gasPriceI > gasPriceJ
+	}
+
+	return candidates[i].txList.sender < candidates[j].txList.sender
+}
+
+// Swap swaps the candidates at the given indices
+func (candidates selectionCandidates) Swap(i, j int) {
+	candidates[i], candidates[j] = candidates[j], candidates[i]
+}
+
+// Push adds a candidate
+func (candidates *selectionCandidates) Push(x interface{}) {
+	*candidates = append(*candidates, x.(*selectionCandidate))
+}
+
+// Pop removes the last candidate
+func (candidates *selectionCandidates) Pop() interface{} {
+	old := *candidates
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*candidates = old[:n-1]
+	return item
+}
@@ -0,0 +1,307 @@
+package txcache
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSelectionPolicy(t *testing.T) {
+	policy, err := newSelectionPolicy("")
+	require.Nil(t, err)
+	require.IsType(t, &scoreSelectionPolicy{}, policy)
+
+	policy, err = newSelectionPolicy(SelectionPolicyScore)
+	require.Nil(t, err)
+	require.IsType(t, &scoreSelectionPolicy{}, policy)
+
+	policy, err = newSelectionPolicy(SelectionPolicyGasPrice)
+	require.Nil(t, err)
+	require.IsType(t, &gasPriceSelectionPolicy{}, policy)
+
+	policy, err = newSelectionPolicy(SelectionPolicyRoundRobin)
+	require.Nil(t, err)
+	require.IsType(t, &roundRobinSelectionPolicy{}, policy)
+
+	policy, err = newSelectionPolicy("dummy")
+	require.Nil(t, policy)
+	require.NotNil(t, err)
+
+	require.True(t, IsSelectionPolicySupported(SelectionPolicyGasPrice))
+	require.False(t, IsSelectionPolicySupported("dummy"))
+}
+
+func TestSelectionPolicies_PreserveNonceOrderAndStopAtGaps(t *testing.T) {
+	for _, policy := range []string{SelectionPolicyScore, SelectionPolicyGasPrice, SelectionPolicyRoundRobin} {
+		cache := newCacheWithSelectionPolicyToTest(policy)
+
+		cache.AddTx(createTxWithParams([]byte("hash-alice-3"), "alice", 3, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-5"), "alice", 5, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-bob-8"), "bob", 8, 128, 50000, 2*oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-bob-7"), "bob", 7, 128, 50000, 2*oneBillion))
+
+		selected := cache.doSelectTransactions(100, 1)
+		require.Len(t, selected, 5, policy)
+		requireNonceOrderPerSender(t, selected)
+	}
+}
+
+func TestSelectionPolicies_RespectNumRequested(t *testing.T) {
+	for _, policy := range []string{SelectionPolicyScore, SelectionPolicyGasPrice, SelectionPolicyRoundRobin} {
+		cache := newCacheWithSelectionPolicyToTest(policy)
+		addScenarioTxs(cache, 10, 10, uniformGasPrice)
+
+		require.Len(t, cache.doSelectTransactions(0, 2), 0, policy)
+		require.Len(t, cache.doSelectTransactions(42, 2), 42, policy)
+		require.Len(t, cache.doSelectTransactions(1000, 2), 100, policy)
+	}
+}
+
+func TestGasPriceSelectionPolicy_PrefersHigherGasPrice(t *testing.T) {
+	cache := newCacheWithSelectionPolicyToTest(SelectionPolicyGasPrice)
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 50000, 5*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-bob-1"), "bob", 1, 128, 50000, 3*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-bob-2"), "bob", 2, 128, 50000, 2*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-carol-1"), "carol", 1, 128, 50000, 2*oneBillion))
+
+	selected := cache.doSelectTransactions(100, 1)
+
+	// "alice-2" is held back by "alice-1", which has the lowest price
+	// "bob-2" and "carol-1" have the same price, thus the sender decides
+	expected := []string{"hash-bob-1", "hash-bob-2", "hash-carol-1", "hash-alice-1", "hash-alice-2"}
+	require.Equal(t, expected, selectedHashesAsStrings(selected))
+
+	selected = cache.doSelectTransactions(2, 1)
+	require.Equal(t, []string{"hash-bob-1", "hash-bob-2"}, selectedHashesAsStrings(selected))
+}
+
+func TestRoundRobinSelectionPolicy_RotatesSenders(t *testing.T) {
+	cache := newCacheWithSelectionPolicyToTest(SelectionPolicyRoundRobin)
+
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+	cache.AddTx(createTx([]byte("hash-bob-2"), "bob", 2))
+	cache.AddTx(createTx([]byte("hash-carol-1"), "carol", 1))
+
+	require.Equal(t, []string{"hash-alice-1", "hash-bob-1", "hash-carol-1", "hash-alice-2"}, selectedHashesAsStrings(cache.doSelectTransactions(4, 1)))
+	require.Equal(t, []string{"hash-bob-1", "hash-carol-1", "hash-alice-1", "hash-bob-2"}, selectedHashesAsStrings(cache.doSelectTransactions(4, 1)))
+	require.Equal(t, []string{"hash-carol-1", "hash-alice-1", "hash-bob-1", "hash-alice-2"}, selectedHashesAsStrings(cache.doSelectTransactions(4, 1)))
+	require.Equal(t, []string{"hash-alice-1", "hash-alice-2", "hash-bob-1", "hash-bob-2"}, selectedHashesAsStrings(cache.doSelectTransactions(4, 2)))
+}
+
+func TestSelectionScenarios_AreDeterministic(t *testing.T) {
+	// The "score" policy isn't included, since the order of the senders within a score chunk isn't deterministic
+	for _, policy := range []string{SelectionPolicyGasPrice, SelectionPolicyRoundRobin} {
+		for _, scenario := range selectionScenarios {
+			first := runSelectionScenario(policy, scenario)
+			second := runSelectionScenario(policy, scenario)
+			require.Equal(t, first, second, fmt.Sprintf("%s / %s", policy, scenario.name))
+		}
+	}
+}
+
+// The scenarios below are deterministic (no randomness involved), so that the policies can be compared
+// with respect to throughput and fairness (see benchmarks.sh)
+
+type selectionScenario struct {
+	name               string
+	numSenders         int
+	numTxsPerSender    int
+	numRequested       int
+	batchSizePerSender int
+	gasPriceOf         func(senderIndex int, nonce int) uint64
+	numTxsForSenderOf  func(senderIndex int, numTxsPerSender int) int
+}
+
+var selectionScenarios = []selectionScenario{
+	{
+		// all senders have the same number of transactions, with the same gas price
+		name:               "uniform",
+		numSenders:         1000,
+		numTxsPerSender:    100,
+		numRequested:       30000,
+		batchSizePerSender: 10,
+		gasPriceOf:         uniformGasPrice,
+	},
+	{
+		// one sender in ten pays ten times the minimum gas price
+		name:               "skewedGasPrice",
+		numSenders:         1000,
+		numTxsPerSender:    100,
+		numRequested:       30000,
+		batchSizePerSender: 10,
+		gasPriceOf: func(senderIndex int, _ int) uint64 {
+			if senderIndex%10 == 0 {
+				return 10 * oneBillion
+			}
+			return oneBillion
+		},
+	},
+	{
+		// one sender in a hundred has a lot of transactions, the others only a few
+		name:               "whales",
+		numSenders:         1000,
+		numTxsPerSender:    1000,
+		numRequested:       30000,
+		batchSizePerSender: 10,
+		gasPriceOf: func(_ int, nonce int) uint64 {
+			return oneBillion + uint64(nonce%7)*oneMilion
+		},
+		numTxsForSenderOf: func(senderIndex int, numTxsPerSender int) int {
+			if senderIndex%100 == 0 {
+				return numTxsPerSender
+			}
+			return 5
+		},
+	},
+}
+
+type selectionScenarioResult struct {
+	selectedHashes  []string
+	numSelected     int
+	numSenders      int
+	fairness        float64
+	averageGasPrice float64
+}
+
+func uniformGasPrice(_ int, _ int) uint64 {
+	return oneBillion
+}
+
+func addScenarioTxs(cache *TxCache, numSenders int, numTxsPerSender int, gasPriceOf func(senderIndex int, nonce int) uint64) {
+	addScenarioTxsWithCounts(cache, numSenders, numTxsPerSender, gasPriceOf, nil)
+}
+
+func addScenarioTxsWithCounts(
+	cache *TxCache,
+	numSenders int,
+	numTxsPerSender int,
+	gasPriceOf func(senderIndex int, nonce int) uint64,
+	numTxsForSenderOf func(senderIndex int, numTxsPerSender int) int,
+) {
+	for senderIndex := 0; senderIndex < numSenders; senderIndex++ {
+		sender := fmt.Sprintf("sender:%d", senderIndex)
+		numTxs := numTxsPerSender
+		if numTxsForSenderOf != nil {
+			numTxs = numTxsForSenderOf(senderIndex, numTxsPerSender)
+		}
+
+		for nonce := 1; nonce <= numTxs; nonce++ {
+			txHash := fmt.Sprintf("hash:%d:%d", senderIndex, nonce)
+			cache.AddTx(createTxWithParams([]byte(txHash), sender, uint64(nonce), 128, 50000, gasPriceOf(senderIndex, nonce)))
+		}
+	}
+}
+
+func runSelectionScenario(policy string, scenario selectionScenario) selectionScenarioResult {
+	cache := newCacheWithSelectionPolicyToTest(policy)
+	addScenarioTxsWithCounts(cache, scenario.numSenders, scenario.numTxsPerSender, scenario.gasPriceOf, scenario.numTxsForSenderOf)
+
+	selected := cache.doSelectTransactions(scenario.numRequested, scenario.batchSizePerSender)
+	return evaluateSelection(selected, scenario.numSenders)
+}
+
+// evaluateSelection computes Jain's fairness index over the number of transactions selected for each sender
+// (1 means that all senders got the same number of transactions), along with a few other indicators
+func evaluateSelection(selected []*WrappedTransaction, numSenders int) selectionScenarioResult {
+	countBySender := make(map[string]int)
+	sumGasPrice := float64(0)
+	for _, tx := range selected {
+		countBySender[string(tx.Tx.GetSndAddr())]++
+		sumGasPrice += float64(tx.Tx.GetGasPrice())
+	}
+
+	sum := float64(0)
+	sumOfSquares := float64(0)
+	for _, count := range countBySender {
+		sum += float64(count)
+		sumOfSquares += float64(count * count)
+	}
+
+	result := selectionScenarioResult{
+		selectedHashes: selectedHashesAsStrings(selected),
+		numSelected:    len(selected),
+		numSenders:     len(countBySender),
+	}
+	if sumOfSquares > 0 {
+		result.fairness = sum * sum / (float64(numSenders) * sumOfSquares)
+	}
+	if len(selected) > 0 {
+		result.averageGasPrice = sumGasPrice / float64(len(selected))
+	}
+
+	return result
+}
+
+func BenchmarkSelectionPolicies(b *testing.B) {
+	for _, policy := range []string{SelectionPolicyScore, SelectionPolicyGasPrice, SelectionPolicyRoundRobin} {
+		for _, scenario := range selectionScenarios {
+			b.Run(fmt.Sprintf("%s/%s", policy, scenario.name), func(b *testing.B) {
+				benchmarkSelectionPolicy(b, policy, scenario)
+			})
+		}
+	}
+}
+
+func benchmarkSelectionPolicy(b *testing.B, policy string, scenario selectionScenario) {
+	cache := newCacheWithSelectionPolicyToTest(policy)
+	addScenarioTxsWithCounts(cache, scenario.numSenders, scenario.numTxsPerSender, scenario.gasPriceOf, scenario.numTxsForSenderOf)
+
+	var selected []*WrappedTransaction
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		selected = cache.doSelectTransactions(scenario.numRequested, scenario.batchSizePerSender)
+	}
+
+	b.StopTimer()
+	result := evaluateSelection(selected, scenario.numSenders)
+	b.ReportMetric(float64(result.numSelected), "txs/selection")
+	b.ReportMetric(float64(result.numSenders), "senders/selection")
+	b.ReportMetric(result.fairness, "fairness")
+	b.ReportMetric(result.averageGasPrice/oneBillion, "avgGasPrice/min")
+}
+
+func newCacheWithSelectionPolicyToTest(policy string) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		SelectionPolicy:            policy,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithSelectionPolicyToTest(): %s", err))
+	}
+
+	return cache
+}
+
+func selectedHashesAsStrings(selected []*WrappedTransaction) []string {
+	result := make([]string, len(selected))
+	for i, tx := range selected {
+		result[i] = string(tx.TxHash)
+	}
+
+	return result
+}
+
+func requireNonceOrderPerSender(t *testing.T, selected []*WrappedTransaction) {
+	nonces := make(map[string]uint64)
+	for _, tx := range selected {
+		sender := string(tx.Tx.GetSndAddr())
+		previousNonce, ok := nonces[sender]
+		if ok {
+			require.Equal(t, previousNonce+1, tx.Tx.GetNonce())
+		}
+		nonces[sender] = tx.Tx.GetNonce()
+	}
+}
@@ -24,6 +24,8 @@ type TxCache struct {
 	numSendersWithInitialGap  atomic.Counter
 	numSendersWithMiddleGap   atomic.Counter
 	numSendersInGracePeriod   atomic.Counter
+	numTxsReplaced            atomic.Counter
+	selectionPolicy           selectionPolicy
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 }
@@ -44,6 +46,11 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 	// Note: for simplicity, we use the same "numChunks" for both internal concurrent maps
 	numChunks := config.NumChunks
 	senderConstraintsObj := config.getSenderConstraints()
+	selectionPolicyObj, err := newSelectionPolicy(config.SelectionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	txFeeHelper := newFeeComputationHelper(txGasHandler.MinGasPrice(), txGasHandler.MinGasLimit(), txGasHandler.MinGasPriceForProcessing())
 	scoreComputerObj := newDefaultScoreComputer(txFeeHelper)
 
@@ -53,15 +60,30 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		selectionPolicy: selectionPolicyObj,
 	}
 
 	txCache.initSweepable()
 	return txCache, nil
 }
 
-// AddTx adds a transaction in the cache
+// AddTx adds a transaction in the cache, the transactions with the same sender and nonce being kept side by side
 // Eviction happens if maximum capacity is reached
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
+	return cache.addTx(tx, cache.txListBySender.addTx)
+}
+
+// AddTxWithReplacement adds a transaction in the cache, like AddTx does
+// If replacement is enabled, a transaction with the same sender and nonce as an existing one replaces the latter,
+// provided that its gas price is high enough. Otherwise, the transaction is rejected
+func (cache *TxCache) AddTxWithReplacement(tx *WrappedTransaction) (ok bool, added bool) {
+	return cache.addTx(tx, cache.txListBySender.addTxWithReplacement)
+}
+
+func (cache *TxCache) addTx(
+	tx *WrappedTransaction,
+	addTxBySender func(tx *WrappedTransaction) (bool, []byte, [][]byte),
+) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
 	}
@@ -71,7 +93,7 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	}
 
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, replaced, evicted := addTxBySender(tx)
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -81,6 +103,18 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
+	rejectedBySender := addedInByHash && !addedInBySender && !cache.txListBySender.hasTx(tx)
+	if rejectedBySender {
+		// The transaction isn't a duplicate, but it has been rejected by the sender's list (e.g. underpriced replacement)
+		cache.txByHash.removeTx(string(tx.TxHash))
+		return true, false
+	}
+
+	if len(replaced) > 0 {
+		cache.monitorTxReplacement(tx, replaced)
+		cache.txByHash.removeTx(string(replaced))
+	}
+
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
 		cache.txByHash.RemoveTxsBulk(evicted)
@@ -99,7 +133,8 @@ func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 
 // SelectTransactions selects a reasonably fair list of transactions to be included in the next miniblock
 // It returns at most "numRequested" transactions
-// Each sender gets the chance to give at least "batchSizePerSender" transactions, unless "numRequested" limit is reached before iterating over all senders
+// The transactions are selected according to the configured selection policy (see selection.go). With the default policy,
+// each sender gets the chance to give at least "batchSizePerSender" transactions, unless "numRequested" limit is reached before iterating over all senders
 func (cache *TxCache) SelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
 	result := cache.doSelectTransactions(numRequested, batchSizePerSender)
 	go cache.doAfterSelection()
@@ -108,52 +143,11 @@ func (cache *TxCache) SelectTransactions(numRequested int, batchSizePerSender in
 
 func (cache *TxCache) doSelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
 	stopWatch := cache.monitorSelectionStart()
-
-	result := make([]*WrappedTransaction, numRequested)
-	resultFillIndex := 0
-	resultIsFull := false
-
-	snapshotOfSenders := cache.getSendersEligibleForSelection()
-
-	for pass := 0; !resultIsFull; pass++ {
-		copiedInThisPass := 0
-
-		for _, txList := range snapshotOfSenders {
-			batchSizeWithScoreCoefficient := batchSizePerSender * int(txList.getLastComputedScore()+1)
-			// Reset happens on first pass only
-			isFirstBatch := pass == 0
-			journal := txList.selectBatchTo(isFirstBatch, result[resultFillIndex:], batchSizeWithScoreCoefficient)
-			cache.monitorBatchSelectionEnd(journal)
-
-			if isFirstBatch {
-				cache.collectSweepable(txList)
-			}
-
-			resultFillIndex += journal.copied
-			copiedInThisPass += journal.copied
-			resultIsFull = resultFillIndex == numRequested
-			if resultIsFull {
-				break
-			}
-		}
-
-		nothingCopiedThisPass := copiedInThisPass == 0
-
-		// No more passes needed
-		if nothingCopiedThisPass {
-			break
-		}
-	}
-
-	result = result[:resultFillIndex]
+	result := cache.selectionPolicy.selectTransactions(cache, numRequested, batchSizePerSender)
 	cache.monitorSelectionEnd(result, stopWatch)
 	return result
 }
 
-func (cache *TxCache) getSendersEligibleForSelection() []*txListForSender {
-	return cache.txListBySender.getSnapshotDescending()
-}
-
 func (cache *TxCache) doAfterSelection() {
 	cache.sweepSweepable()
 	cache.Diagnose(false)
@@ -182,6 +176,27 @@ func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
 	return true
 }
 
+// GetTransactionsForSender returns the transactions of the provided sender, sorted by nonce
+func (cache *TxCache) GetTransactionsForSender(sender []byte) []*WrappedTransaction {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return make([]*WrappedTransaction, 0)
+	}
+
+	return listForSender.getTxs()
+}
+
+// GetNonceGapsForSender returns the intervals of nonces missing from the transactions of the provided sender,
+// starting with the given account nonce
+func (cache *TxCache) GetNonceGapsForSender(sender []byte, accountNonce uint64) []NonceGap {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return make([]NonceGap, 0)
+	}
+
+	return listForSender.getNonceGaps(accountNonce)
+}
+
 // NumBytes gets the approximate number of bytes stored in the cache
 func (cache *TxCache) NumBytes() int {
 	return int(cache.txByHash.numBytes.GetUint64())
@@ -202,6 +217,11 @@ func (cache *TxCache) CountSenders() uint64 {
 	return cache.txListBySender.counter.GetUint64()
 }
 
+// NumTxsReplaced gets the number of transactions replaced by same-nonce transactions with a higher gas price
+func (cache *TxCache) NumTxsReplaced() uint64 {
+	return cache.numTxsReplaced.GetUint64()
+}
+
 // ForEachTransaction iterates over the transactions in the cache
 func (cache *TxCache) ForEachTransaction(function ForEachTransaction) {
 	cache.txByHash.forEach(function)
@@ -55,6 +55,10 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold", txGasHandler)
 
+	badConfig = config
+	badConfig.SelectionPolicy = "dummy"
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.SelectionPolicy", txGasHandler)
+
 	badConfig = config
 	cache, err = NewTxCache(config, nil)
 	require.Nil(t, cache)
@@ -153,6 +157,71 @@ func Test_AddTx_AppliesSizeConstraintsPerSenderForNumBytes(t *testing.T) {
 	require.True(t, cache.areInternalMapsConsistent())
 }
 
+func Test_AddTxWithReplacement_ReplacesSameNonceTransaction(t *testing.T) {
+	cache := newCacheWithReplacementToTest(10)
+
+	cache.AddTxWithReplacement(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 42, 100))
+	cache.AddTxWithReplacement(createTxWithParams([]byte("hash-2"), "alice", 2, 128, 42, 100))
+
+	ok, added := cache.AddTxWithReplacement(createTxWithParams([]byte("hash-2++"), "alice", 2, 128, 42, 150))
+	require.True(t, ok)
+	require.True(t, added)
+
+	_, foundReplaced := cache.GetByTxHash([]byte("hash-2"))
+	require.False(t, foundReplaced)
+	_, foundReplacement := cache.GetByTxHash([]byte("hash-2++"))
+	require.True(t, foundReplacement)
+
+	require.Equal(t, []string{"hash-1", "hash-2++"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.Equal(t, 2*128, cache.NumBytes())
+	require.Equal(t, uint64(1), cache.NumTxsReplaced())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func Test_AddTxWithReplacement_RejectsUnderpricedReplacement(t *testing.T) {
+	cache := newCacheWithReplacementToTest(10)
+
+	cache.AddTxWithReplacement(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 42, 100))
+
+	ok, added := cache.AddTxWithReplacement(createTxWithParams([]byte("hash-1+"), "alice", 1, 128, 42, 105))
+	require.True(t, ok)
+	require.False(t, added)
+
+	_, found := cache.GetByTxHash([]byte("hash-1+"))
+	require.False(t, found)
+	require.Equal(t, []string{"hash-1"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Equal(t, 128, cache.NumBytes())
+	require.Equal(t, uint64(0), cache.NumTxsReplaced())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func Test_AddTx_KeepsSameNonceTransactionsWhenReplacementEnabled(t *testing.T) {
+	cache := newCacheWithReplacementToTest(10)
+
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 42, 100))
+	ok, added := cache.AddTx(createTxWithParams([]byte("hash-1-"), "alice", 1, 128, 42, 50))
+	require.True(t, ok)
+	require.True(t, added)
+
+	require.Equal(t, []string{"hash-1", "hash-1-"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.Equal(t, uint64(0), cache.NumTxsReplaced())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func Test_AddTx_KeepsSameNonceTransactionsWhenReplacementDisabled(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 42, 100))
+	cache.AddTx(createTxWithParams([]byte("hash-1++"), "alice", 1, 128, 42, 200))
+
+	require.Equal(t, []string{"hash-1++", "hash-1"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.Equal(t, uint64(0), cache.NumTxsReplaced())
+}
+
 func Test_RemoveByTxHash(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -256,6 +325,33 @@ func Test_ForEachTransaction(t *testing.T) {
 	require.Equal(t, 2, counter)
 }
 
+func Test_GetTransactionsForSender(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-bob-7"), "bob", 7))
+
+	txs := cache.GetTransactionsForSender([]byte("alice"))
+	require.Len(t, txs, 2)
+	require.Equal(t, []byte("hash-alice-1"), txs[0].TxHash)
+	require.Equal(t, []byte("hash-alice-2"), txs[1].TxHash)
+
+	require.Len(t, cache.GetTransactionsForSender([]byte("bob")), 1)
+	require.Len(t, cache.GetTransactionsForSender([]byte("carol")), 0)
+}
+
+func Test_GetNonceGapsForSender(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+
+	require.Equal(t, []NonceGap{{From: 0, To: 0}, {From: 2, To: 2}}, cache.GetNonceGapsForSender([]byte("alice"), 0))
+	require.Equal(t, []NonceGap{{From: 2, To: 2}}, cache.GetNonceGapsForSender([]byte("alice"), 1))
+	require.Len(t, cache.GetNonceGapsForSender([]byte("bob"), 0), 0)
+}
+
 func Test_SelectTransactions_Dummy(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -579,6 +675,22 @@ func newUnconstrainedCacheToTest() *TxCache {
 	return cache
 }
 
+func newCacheWithReplacementToTest(minGasPriceBumpPercent uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                                 "test",
+		NumChunks:                            16,
+		NumBytesPerSenderThreshold:           maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:              math.MaxUint32,
+		MinGasPriceBumpPercentForReplacement: minGasPriceBumpPercent,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithReplacementToTest(): %s", err))
+	}
+
+	return cache
+}
+
 func newCacheToTest(numBytesPerSenderThreshold uint32, countPerSenderThreshold uint32) *TxCache {
 	txGasHandler, _ := dummyParams()
 	cache, err := NewTxCache(ConfigSourceMe{
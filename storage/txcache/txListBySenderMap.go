@@ -40,12 +40,19 @@ func newTxListBySenderMap(
 }
 
 // addTx adds a transaction in the map, in the corresponding list (selected by its sender)
-func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte) {
+func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, []byte, [][]byte) {
 	sender := string(tx.Tx.GetSndAddr())
 	listForSender := txMap.getOrAddListForSender(sender)
 	return listForSender.AddTx(tx, txMap.txGasHandler, txMap.txFeeHelper)
 }
 
+// addTxWithReplacement adds a transaction in the map, like addTx does, applying the replacement rules of the sender's list
+func (txMap *txListBySenderMap) addTxWithReplacement(tx *WrappedTransaction) (bool, []byte, [][]byte) {
+	sender := string(tx.Tx.GetSndAddr())
+	listForSender := txMap.getOrAddListForSender(sender)
+	return listForSender.AddTxWithReplacement(tx, txMap.txGasHandler, txMap.txFeeHelper)
+}
+
 // getOrAddListForSender gets or lazily creates a list (using double-checked locking pattern)
 func (txMap *txListBySenderMap) getOrAddListForSender(sender string) *txListForSender {
 	listForSender, ok := txMap.getListForSender(sender)
@@ -111,6 +118,16 @@ func (txMap *txListBySenderMap) removeTx(tx *WrappedTransaction) bool {
 	return isFound
 }
 
+// hasTx checks whether a transaction is held in the list of its sender
+func (txMap *txListBySenderMap) hasTx(tx *WrappedTransaction) bool {
+	listForSender, ok := txMap.getListForSender(string(tx.Tx.GetSndAddr()))
+	if !ok {
+		return false
+	}
+
+	return listForSender.hasTx(tx)
+}
+
 func (txMap *txListBySenderMap) removeSender(sender string) bool {
 	_, removed := txMap.backingMap.Remove(sender)
 	if removed {
@@ -37,6 +37,12 @@ type txListForSender struct {
 
 type scoreChangeCallback func(value *txListForSender, scoreParams senderScoreParams)
 
+// NonceGap represents an interval of nonces (both ends included) missing from the transactions of a sender
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
 // newTxListForSender creates a new (sorted) list of transactions
 func newTxListForSender(sender string, constraints *senderConstraints, onScoreChange scoreChangeCallback) *txListForSender {
 	return &txListForSender{
@@ -48,27 +54,58 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 }
 
 // AddTx adds a transaction in sender's list
-// This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
+// This is a "sorted" insert, the transactions with the same nonce being kept side by side
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, []byte, [][]byte) {
+	return listForSender.addTx(tx, gasHandler, txFeeHelper, false)
+}
+
+// AddTxWithReplacement adds a transaction in sender's list, like AddTx does
+// If replacement is enabled, a transaction with the same nonce as an existing one replaces the latter
+// (provided that its gas price is high enough), and the hash of the replaced transaction is returned.
+func (listForSender *txListForSender) AddTxWithReplacement(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, []byte, [][]byte) {
+	return listForSender.addTx(tx, gasHandler, txFeeHelper, listForSender.isReplacementEnabled())
+}
+
+func (listForSender *txListForSender) addTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper, withReplacement bool) (bool, []byte, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
-	insertionPlace, err := listForSender.findInsertionPlace(tx)
+	insertionPlace, err := listForSender.findInsertionPlace(tx, withReplacement)
 	if err != nil {
-		return false, nil
+		return false, nil, nil
 	}
 
+	var replacedTxHash []byte
 	if insertionPlace == nil {
 		listForSender.items.PushFront(tx)
 	} else {
 		listForSender.items.InsertAfter(tx, insertionPlace)
+		if withReplacement {
+			replacedTxHash = listForSender.removeIfReplacedBy(insertionPlace, tx)
+		}
 	}
 
 	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, replacedTxHash, evicted
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) removeIfReplacedBy(element *list.Element, incomingTx *WrappedTransaction) []byte {
+	currentTx := element.Value.(*WrappedTransaction)
+	if currentTx.Tx.GetNonce() != incomingTx.Tx.GetNonce() {
+		return nil
+	}
+
+	listForSender.items.Remove(element)
+	listForSender.onRemovedListElement(element)
+	return currentTx.TxHash
+}
+
+func (listForSender *txListForSender) isReplacementEnabled() bool {
+	return isReplacementEnabled(listForSender.constraints.minGasPriceBumpPercentForReplacement)
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -122,7 +159,7 @@ func (listForSender *txListForSender) getScoreParams() senderScoreParams {
 }
 
 // This function should only be used in critical section (listForSender.mutex)
-func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTransaction) (*list.Element, error) {
+func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTransaction, withReplacement bool) (*list.Element, error) {
 	incomingNonce := incomingTx.Tx.GetNonce()
 	incomingGasPrice := incomingTx.Tx.GetGasPrice()
 
@@ -136,6 +173,16 @@ func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTran
 			return nil, storage.ErrItemAlreadyInCache
 		}
 
+		if currentTxNonce == incomingNonce && withReplacement {
+			// The incoming transaction will replace the existing one (thus it is placed right after it, then the existing one is removed),
+			// but only if its gas price is sufficiently higher.
+			if !isGasPriceBumpSufficient(currentTxGasPrice, incomingGasPrice, listForSender.constraints.minGasPriceBumpPercentForReplacement) {
+				return nil, storage.ErrTxReplacementUnderpriced
+			}
+
+			return element, nil
+		}
+
 		if currentTxNonce == incomingNonce && currentTxGasPrice > incomingGasPrice {
 			// The incoming transaction will be placed right after the existing one, which has same nonce but higher price.
 			// If the nonces are the same, but the incoming gas price is higher or equal, the search loop continues.
@@ -199,6 +246,13 @@ func (listForSender *txListForSender) findListElementWithTx(txToFind *WrappedTra
 	return nil
 }
 
+func (listForSender *txListForSender) hasTx(tx *WrappedTransaction) bool {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	return listForSender.findListElementWithTx(tx) != nil
+}
+
 // IsEmpty checks whether the list is empty
 func (listForSender *txListForSender) IsEmpty() bool {
 	return listForSender.countTxWithLock() == 0
@@ -284,6 +338,46 @@ func (listForSender *txListForSender) getTxHashes() [][]byte {
 	return result
 }
 
+// getTxs returns the transactions in the list, sorted by nonce
+func (listForSender *txListForSender) getTxs() []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0, listForSender.countTx())
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// getNonceGaps returns the intervals of nonces missing from the list, starting with the provided account nonce.
+// Transactions with nonces lower than the account nonce are ignored, as they can no longer be executed
+func (listForSender *txListForSender) getNonceGaps(accountNonce uint64) []NonceGap {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	gaps := make([]NonceGap, 0)
+	expectedNonce := accountNonce
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		txNonce := value.Tx.GetNonce()
+		if txNonce < expectedNonce {
+			continue
+		}
+
+		if txNonce > expectedNonce {
+			gaps = append(gaps, NonceGap{From: expectedNonce, To: txNonce - 1})
+		}
+		expectedNonce = txNonce + 1
+	}
+
+	return gaps
+}
+
 // This function should only be used in critical section (listForSender.mutex)
 func (listForSender *txListForSender) countTx() uint64 {
 	return uint64(listForSender.items.Len())
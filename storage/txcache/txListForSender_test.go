@@ -49,17 +49,83 @@ func TestListForSender_AddTx_SortsCorrectlyWhenSameNonceSamePrice(t *testing.T)
 	require.Equal(t, []string{"a", "f", "e", "c", "b", "g", "d"}, list.getTxHashesAsStrings())
 }
 
+func TestListForSender_AddTxWithReplacement_ReplacesWhenGasPriceBumpIsSufficient(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTxWithReplacement(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTxWithReplacement(createTxWithParams([]byte("b"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTxWithReplacement(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	added, replaced, evicted := list.AddTxWithReplacement(createTxWithParams([]byte("b++"), ".", 2, 256, 42, 110), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, []byte("b"), replaced)
+	require.Len(t, evicted, 0)
+	require.Equal(t, []string{"a", "b++", "c"}, list.getTxHashesAsStrings())
+	require.Equal(t, int64(128+256+128), list.totalBytes.Get())
+	require.Equal(t, uint64(3), list.countTx())
+
+	// The first transaction can be replaced, as well
+	added, replaced, _ = list.AddTxWithReplacement(createTxWithParams([]byte("a++"), ".", 1, 128, 42, 200), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, []byte("a"), replaced)
+	require.Equal(t, []string{"a++", "b++", "c"}, list.getTxHashesAsStrings())
+
+	// Transactions with new nonces are simply added
+	added, replaced, _ = list.AddTxWithReplacement(createTxWithParams([]byte("d"), ".", 4, 128, 42, 100), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Nil(t, replaced)
+	require.Equal(t, []string{"a++", "b++", "c", "d"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_AddTxWithReplacement_RejectsUnderpricedReplacement(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTxWithReplacement(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	added, replaced, _ := list.AddTxWithReplacement(createTxWithParams([]byte("a+"), ".", 1, 128, 42, 109), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Nil(t, replaced)
+
+	added, replaced, _ = list.AddTxWithReplacement(createTxWithParams([]byte("a-"), ".", 1, 128, 42, 50), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Nil(t, replaced)
+
+	added, _, _ = list.AddTxWithReplacement(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	require.False(t, added)
+
+	require.Equal(t, []string{"a"}, list.getTxHashesAsStrings())
+	require.Equal(t, int64(128), list.totalBytes.Get())
+}
+
+func TestListForSender_AddTx_KeepsSameNonceTransactionsWhenReplacementEnabled(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	added, replaced, _ := list.AddTx(createTxWithParams([]byte("a-"), ".", 1, 128, 42, 50), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Nil(t, replaced)
+
+	added, replaced, _ = list.AddTx(createTxWithParams([]byte("a++"), ".", 1, 128, 42, 200), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Nil(t, replaced)
+	require.Equal(t, []string{"a++", "a", "a-"}, list.getTxHashesAsStrings())
+}
+
 func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
+	added, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.False(t, added)
 }
 
@@ -73,17 +139,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	_, _, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -95,20 +161,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42), txGasHandler, txFeeHelper)
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
@@ -355,6 +421,38 @@ func TestListForSender_getTxHashes(t *testing.T) {
 	require.Len(t, list.getTxHashes(), 3)
 }
 
+func TestListForSender_getTxs(t *testing.T) {
+	list := newUnconstrainedListToTest()
+	require.Len(t, list.getTxs(), 0)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTx([]byte("B"), ".", 2), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("A"), ".", 1), txGasHandler, txFeeHelper)
+
+	txs := list.getTxs()
+	require.Len(t, txs, 2)
+	require.Equal(t, []byte("A"), txs[0].TxHash)
+	require.Equal(t, []byte("B"), txs[1].TxHash)
+}
+
+func TestListForSender_getNonceGaps(t *testing.T) {
+	list := newUnconstrainedListToTest()
+	require.Len(t, list.getNonceGaps(0), 0)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTx([]byte("tx-40"), ".", 40), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("tx-42"), ".", 42), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx-42-bis"), ".", 42, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("tx-43"), ".", 43), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("tx-47"), ".", 47), txGasHandler, txFeeHelper)
+
+	require.Equal(t, []NonceGap{{From: 41, To: 41}, {From: 44, To: 46}}, list.getNonceGaps(40))
+	require.Equal(t, []NonceGap{{From: 38, To: 39}, {From: 41, To: 41}, {From: 44, To: 46}}, list.getNonceGaps(38))
+	// Transactions with nonces lower than the account nonce are ignored
+	require.Equal(t, []NonceGap{{From: 44, To: 46}}, list.getNonceGaps(43))
+	require.Len(t, list.getNonceGaps(48), 0)
+}
+
 func TestListForSender_DetectRaceConditions(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
@@ -396,6 +494,14 @@ func newUnconstrainedListToTest() *txListForSender {
 	}, func(_ *txListForSender, _ senderScoreParams) {})
 }
 
+func newListWithReplacementToTest(minGasPriceBumpPercent uint32) *txListForSender {
+	return newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                          math.MaxUint32,
+		maxNumTxs:                            math.MaxUint32,
+		minGasPriceBumpPercentForReplacement: minGasPriceBumpPercent,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+}
+
 func newListToTest(maxNumBytes uint32, maxNumTxs uint32) *txListForSender {
 	return newTxListForSender(".", &senderConstraints{
 		maxNumBytes: maxNumBytes,
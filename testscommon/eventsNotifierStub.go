@@ -0,0 +1,69 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/eventsNotifier"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// EventsNotifierStub -
+type EventsNotifierStub struct {
+	HasSubscribersCalled       func() bool
+	HasHandlersCalled          func() bool
+	NotifyCommittedBlockCalled func(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler)
+	NotifyRevertedBlockCalled  func(headerHash []byte, header data.HeaderHandler)
+	SubscribeCalled            func(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error)
+	UnsubscribeCalled          func(subscription *eventsNotifier.Subscription)
+}
+
+// HasSubscribers -
+func (ens *EventsNotifierStub) HasSubscribers() bool {
+	if ens.HasSubscribersCalled != nil {
+		return ens.HasSubscribersCalled()
+	}
+
+	return false
+}
+
+// HasHandlers -
+func (ens *EventsNotifierStub) HasHandlers() bool {
+	if ens.HasHandlersCalled != nil {
+		return ens.HasHandlersCalled()
+	}
+
+	return false
+}
+
+// NotifyCommittedBlock -
+func (ens *EventsNotifierStub) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, txs map[string]data.TransactionHandler) {
+	if ens.NotifyCommittedBlockCalled != nil {
+		ens.NotifyCommittedBlockCalled(headerHash, header, txs)
+	}
+}
+
+// NotifyRevertedBlock -
+func (ens *EventsNotifierStub) NotifyRevertedBlock(headerHash []byte, header data.HeaderHandler) {
+	if ens.NotifyRevertedBlockCalled != nil {
+		ens.NotifyRevertedBlockCalled(headerHash, header)
+	}
+}
+
+// Subscribe -
+func (ens *EventsNotifierStub) Subscribe(filter eventsNotifier.SubscriptionFilter) (*eventsNotifier.Subscription, error) {
+	if ens.SubscribeCalled != nil {
+		return ens.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (ens *EventsNotifierStub) Unsubscribe(subscription *eventsNotifier.Subscription) {
+	if ens.UnsubscribeCalled != nil {
+		ens.UnsubscribeCalled(subscription)
+	}
+}
+
+// IsInterfaceNil -
+func (ens *EventsNotifierStub) IsInterfaceNil() bool {
+	return ens == nil
+}
@@ -10,6 +10,11 @@ type ChainStorerMock struct {
 	Transactions *StorerMock
 	Rewards      *StorerMock
 	Unsigned     *StorerMock
+	Logs         *StorerMock
+	MiniBlocks   *StorerMock
+	BlockHeaders *StorerMock
+	MetaBlocks   *StorerMock
+	MetaHdrNonce *StorerMock
 }
 
 // NewChainStorerMock -
@@ -18,6 +23,11 @@ func NewChainStorerMock(epoch uint32) *ChainStorerMock {
 		Transactions: NewStorerMock("Transactions", epoch),
 		Rewards:      NewStorerMock("Rewards", epoch),
 		Unsigned:     NewStorerMock("Unsigned", epoch),
+		Logs:         NewStorerMock("Logs", epoch),
+		MiniBlocks:   NewStorerMock("MiniBlocks", epoch),
+		BlockHeaders: NewStorerMock("BlockHeaders", epoch),
+		MetaBlocks:   NewStorerMock("MetaBlocks", epoch),
+		MetaHdrNonce: NewStorerMock("MetaHdrNonce", epoch),
 	}
 }
 
@@ -42,6 +52,21 @@ func (sm *ChainStorerMock) GetStorer(unitType dataRetriever.UnitType) storage.St
 	if unitType == dataRetriever.UnsignedTransactionUnit {
 		return sm.Unsigned
 	}
+	if unitType == dataRetriever.TxLogsUnit {
+		return sm.Logs
+	}
+	if unitType == dataRetriever.MiniBlockUnit {
+		return sm.MiniBlocks
+	}
+	if unitType == dataRetriever.BlockHeaderUnit {
+		return sm.BlockHeaders
+	}
+	if unitType == dataRetriever.MetaBlockUnit {
+		return sm.MetaBlocks
+	}
+	if unitType == dataRetriever.MetaHdrNonceHashDataUnit {
+		return sm.MetaHdrNonce
+	}
 
 	panic("storer missing, add it")
 }
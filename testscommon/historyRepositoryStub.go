@@ -9,11 +9,13 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RevertBlockCalled                  func(blockHeaderHash []byte)
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetTxHashesByAddressCalled         func(address []byte, skip uint64, maxSize uint64) ([]*dblookupext.TxHashByAddressEntry, uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -22,15 +24,23 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts)
 	}
 	return nil
 }
 
+// RevertBlock -
+func (hp *HistoryRepositoryStub) RevertBlock(blockHeaderHash []byte) {
+	if hp.RevertBlockCalled != nil {
+		hp.RevertBlockCalled(blockHeaderHash)
+	}
+}
+
 // OnNotarizedBlocks -
 func (hp *HistoryRepositoryStub) OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
 	if hp.OnNotarizedBlocksCalled != nil {
@@ -67,6 +77,14 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetTxHashesByAddress -
+func (hp *HistoryRepositoryStub) GetTxHashesByAddress(address []byte, skip uint64, maxSize uint64) ([]*dblookupext.TxHashByAddressEntry, uint64, error) {
+	if hp.GetTxHashesByAddressCalled != nil {
+		return hp.GetTxHashesByAddressCalled(address, skip, maxSize)
+	}
+	return nil, 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
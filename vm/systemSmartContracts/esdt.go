@@ -52,6 +52,8 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	nftEnabledEpoch        uint32
+	flagNFT                atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -67,6 +69,7 @@ type ArgsNewESDTSmartContract struct {
 	EpochNotifier          vm.EpochNotifier
 	EndOfEpochSCAddress    []byte
 	AddressPubKeyConverter core.PubkeyConverter
+	ESDTNFTEnableEpoch     uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -101,6 +104,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
+		nftEnabledEpoch:        args.ESDTNFTEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
@@ -130,6 +134,14 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	switch args.Function {
 	case "issue":
 		return e.issue(args)
+	case "issueNonFungible":
+		return e.issueNonFungible(args, core.NonFungibleESDT)
+	case "issueSemiFungible":
+		return e.issueNonFungible(args, core.SemiFungibleESDT)
+	case "setSpecialRole":
+		return e.setSpecialRole(args)
+	case "unSetSpecialRole":
+		return e.unSetSpecialRole(args)
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -324,6 +336,80 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 	return nil
 }
 
+// format: issueNonFungible@tokenName@ticker@optional-list-of-properties
+// the token instances are created afterwards by the addresses which were given the create role
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput, tokenType string) vmcommon.ReturnCode {
+	if !e.flagNFT.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 2 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[0]) < minLengthForTokenName ||
+		len(args.Arguments[0]) > int(esdtConfig.MaxTokenNameLength) {
+		e.eei.AddReturnMessage("token name length not in parameters")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
+		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
+		return vmcommon.OutOfFunds
+	}
+
+	tokenName := args.Arguments[0]
+	if !isTokenNameHumanReadable(tokenName) {
+		e.eei.AddReturnMessage(vm.ErrTokenNameNotHumanReadable.Error())
+		return vmcommon.UserError
+	}
+	tickerName := args.Arguments[1]
+	if !isTickerValid(tickerName) {
+		e.eei.AddReturnMessage(vm.ErrTickerNameNotValid.Error())
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier, err := e.createNewTokenIdentifier(args.CallerAddr, tickerName)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	newESDTToken := &ESDTData{
+		OwnerAddress: args.CallerAddr,
+		TokenName:    tokenName,
+		TickerName:   tickerName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+		TokenType:    []byte(tokenType),
+	}
+	err = upgradeProperties(newESDTToken, args.Arguments[2:])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = e.saveToken(tokenIdentifier, newESDTToken)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.addToIssuedTokens(string(tokenIdentifier))
+	e.eei.Finish(tokenIdentifier)
+
+	return vmcommon.Ok
+}
+
 func upgradeProperties(token *ESDTData, args [][]byte) error {
 	if len(args) == 0 {
 		return nil
@@ -444,6 +530,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
 	}
+	if !isFungible(token) {
+		e.eei.AddReturnMessage("cannot mint non fungible tokens")
+		return vmcommon.UserError
+	}
 
 	token.MintedValue.Add(token.MintedValue, mintValue)
 	err := e.saveToken(args.Arguments[0], token)
@@ -513,6 +603,10 @@ func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("cannot wipe")
 		return vmcommon.UserError
 	}
+	if !isFungible(token) {
+		e.eei.AddReturnMessage("cannot wipe non fungible tokens")
+		return vmcommon.UserError
+	}
 	if !e.isAddressValid(args.Arguments[1]) {
 		e.eei.AddReturnMessage("invalid address to wipe")
 		return vmcommon.UserError
@@ -705,6 +799,197 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+// format: setSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, address, roles, returnCode := e.checkSpecialRolesArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for _, role := range roles {
+		if role == core.ESDTRoleNFTCreate && isRoleSetForAnotherAddress(token, address, role) {
+			e.eei.AddReturnMessage("NFT create role can be set for a single address")
+			return vmcommon.UserError
+		}
+	}
+
+	specialRoles := getOrCreateSpecialRoles(token, address)
+	for _, role := range roles {
+		if !isRoleSet(specialRoles, role) {
+			specialRoles.Roles = append(specialRoles.Roles, []byte(role))
+		}
+	}
+
+	return e.saveTokenAndSendRoles(args, token, core.BuiltInFunctionSetESDTRole)
+}
+
+// format: unSetSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) unSetSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, address, roles, returnCode := e.checkSpecialRolesArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	// the nonces of the created token instances are tracked by the address holding the create role
+	if isRoleInList(roles, core.ESDTRoleNFTCreate) {
+		e.eei.AddReturnMessage("NFT create role cannot be unset")
+		return vmcommon.UserError
+	}
+
+	specialRoles := getOrCreateSpecialRoles(token, address)
+	for _, role := range roles {
+		if !isRoleSet(specialRoles, role) {
+			e.eei.AddReturnMessage("special role " + role + " is not set for the address")
+			return vmcommon.UserError
+		}
+	}
+
+	remainingRoles := make([][]byte, 0, len(specialRoles.Roles))
+	for _, currentRole := range specialRoles.Roles {
+		if !isRoleInList(roles, string(currentRole)) {
+			remainingRoles = append(remainingRoles, currentRole)
+		}
+	}
+	specialRoles.Roles = remainingRoles
+	removeEmptySpecialRoles(token)
+
+	return e.saveTokenAndSendRoles(args, token, core.BuiltInFunctionUnSetESDTRole)
+}
+
+func (e *esdt) checkSpecialRolesArguments(args *vmcommon.ContractCallInput) (*ESDTData, []byte, []string, vmcommon.ReturnCode) {
+	if !e.flagNFT.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return nil, nil, nil, vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, nil, nil, vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, nil, nil, returnCode
+	}
+	if isFungible(token) {
+		e.eei.AddReturnMessage("special roles can be set only for non fungible and semi fungible tokens")
+		return nil, nil, nil, vmcommon.UserError
+	}
+
+	address := args.Arguments[1]
+	if !e.isAddressValid(address) {
+		e.eei.AddReturnMessage("invalid address")
+		return nil, nil, nil, vmcommon.UserError
+	}
+
+	roles := make([]string, 0, len(args.Arguments)-2)
+	for _, role := range args.Arguments[2:] {
+		if !isRoleValidForTokenType(string(role), string(token.TokenType)) {
+			e.eei.AddReturnMessage("invalid special role " + string(role) + " for the token type")
+			return nil, nil, nil, vmcommon.UserError
+		}
+		if isRoleInList(roles, string(role)) {
+			e.eei.AddReturnMessage("duplicated special role " + string(role))
+			return nil, nil, nil, vmcommon.UserError
+		}
+		roles = append(roles, string(role))
+	}
+
+	return token, address, roles, vmcommon.Ok
+}
+
+func (e *esdt) saveTokenAndSendRoles(args *vmcommon.ContractCallInput, token *ESDTData, builtInFunc string) vmcommon.ReturnCode {
+	err := e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtRolesData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	if builtInFunc == core.BuiltInFunctionSetESDTRole {
+		// the holder of the roles needs the token type to know what kind of token instances it creates
+		esdtRolesData += "@" + hex.EncodeToString(token.TokenType)
+	}
+	for _, role := range args.Arguments[2:] {
+		esdtRolesData += "@" + hex.EncodeToString(role)
+	}
+
+	err = e.eei.Transfer(args.Arguments[1], e.eSDTSCAddress, big.NewInt(0), []byte(esdtRolesData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func isFungible(token *ESDTData) bool {
+	return len(token.TokenType) == 0 || string(token.TokenType) == core.FungibleESDT
+}
+
+func isRoleValidForTokenType(role string, tokenType string) bool {
+	switch role {
+	case core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn:
+		return tokenType == core.NonFungibleESDT || tokenType == core.SemiFungibleESDT
+	case core.ESDTRoleNFTAddQuantity:
+		return tokenType == core.SemiFungibleESDT
+	}
+
+	return false
+}
+
+func getOrCreateSpecialRoles(token *ESDTData, address []byte) *ESDTRoles {
+	for _, specialRoles := range token.SpecialRoles {
+		if bytes.Equal(specialRoles.Address, address) {
+			return specialRoles
+		}
+	}
+
+	specialRoles := &ESDTRoles{Address: address, Roles: make([][]byte, 0)}
+	token.SpecialRoles = append(token.SpecialRoles, specialRoles)
+
+	return specialRoles
+}
+
+func removeEmptySpecialRoles(token *ESDTData) {
+	specialRolesList := make([]*ESDTRoles, 0, len(token.SpecialRoles))
+	for _, specialRoles := range token.SpecialRoles {
+		if len(specialRoles.Roles) > 0 {
+			specialRolesList = append(specialRolesList, specialRoles)
+		}
+	}
+
+	token.SpecialRoles = specialRolesList
+}
+
+func isRoleSet(specialRoles *ESDTRoles, role string) bool {
+	for _, currentRole := range specialRoles.Roles {
+		if string(currentRole) == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRoleSetForAnotherAddress(token *ESDTData, address []byte, role string) bool {
+	for _, specialRoles := range token.SpecialRoles {
+		if !bytes.Equal(specialRoles.Address, address) && isRoleSet(specialRoles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRoleInList(roles []string, role string) bool {
+	for _, currentRole := range roles {
+		if currentRole == role {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -846,6 +1131,9 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagNFT.Toggle(epoch >= e.nftEnabledEpoch)
+	log.Debug("esdt contract NFT", "enabled", e.flagNFT.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
@@ -2267,3 +2267,202 @@ func getAddress() []byte {
 	_, _ = rand.Read(key)
 	return key
 }
+
+func TestEsdt_ExecuteNFTFunctionsDisabledShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTNFTEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	for _, function := range []string{"issueNonFungible", "issueSemiFungible", "setSpecialRole", "unSetSpecialRole"} {
+		eei.returnMessage = ""
+		vmInput := getDefaultVmInputForFunc(function, [][]byte{[]byte("esdtToken"), getAddress(), []byte(core.ESDTRoleNFTBurn)})
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.FunctionNotFound, output)
+		assert.True(t, strings.Contains(eei.returnMessage, "invalid method to call"))
+	}
+}
+
+func TestEsdt_ExecuteIssueNonFungibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("name")},
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueSemiFungible",
+	}
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER"), []byte(canFreeze), []byte("true")}
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 1, len(eei.output))
+
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(eei.output[0]))
+	assert.Equal(t, []byte(core.SemiFungibleESDT), esdtData.TokenType)
+	assert.Equal(t, big.NewInt(0), esdtData.MintedValue)
+	assert.Equal(t, vmInput.CallerAddr, esdtData.OwnerAddress)
+	assert.True(t, esdtData.CanFreeze)
+
+	// non fungible tokens can not be minted
+	mintInput := getDefaultVmInputForFunc("mint", [][]byte{eei.output[0], {200}})
+	mintInput.CallerAddr = vmInput.CallerAddr
+	eei.returnMessage = ""
+	output = e.Execute(mintInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteSetSpecialRoleErrors(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.NonFungibleESDT),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	fungibleData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    []byte("fungible"),
+		OwnerAddress: []byte("owner"),
+	})
+	tokensMap["fungible"] = fungibleData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	address := getAddress()
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{[]byte("fungible"), address, []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "special roles can be set only for non fungible"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTBurn)})
+	vmInput.CallerAddr = []byte("caller")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by owner only"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, []byte("invalid"), []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid address"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTAddQuantity)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid special role"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTBurn), []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "duplicated special role"))
+}
+
+func TestEsdt_ExecuteSetAndUnSetSpecialRoleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.SemiFungibleESDT),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	address := getAddress()
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTAddQuantity)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc := vmOutput.OutputAccounts[string(address)]
+	assert.Equal(t, 1, len(destAcc.OutputTransfers))
+	expectedInput := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.SemiFungibleESDT)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) + "@" + hex.EncodeToString([]byte(core.ESDTRoleNFTAddQuantity))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenName))
+	assert.Equal(t, 1, len(esdtData.SpecialRoles))
+	assert.Equal(t, address, esdtData.SpecialRoles[0].Address)
+	assert.Equal(t, 2, len(esdtData.SpecialRoles[0].Roles))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, getAddress(), []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "NFT create role can be set for a single address"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "NFT create role cannot be unset"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "is not set for the address"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTAddQuantity)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	esdtData = &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenName))
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleNFTCreate)}, esdtData.SpecialRoles[0].Roles)
+}